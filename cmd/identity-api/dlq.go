@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/services"
+	"github.com/DIMO-Network/identity-api/internal/services/dlq"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/rs/zerolog"
+)
+
+// dlqRetryInterval is how often the running service checks for dead letters whose backoff has
+// elapsed.
+const dlqRetryInterval = time.Minute
+
+const dlqUsage = "usage: identity-api dlq list | retry <id|due> | discard <id>"
+
+func runDLQCommand(ctx context.Context, logger zerolog.Logger, settings *config.Settings, dbs db.Store, args []string) {
+	if len(args) == 0 {
+		logger.Fatal().Msg(dlqUsage)
+	}
+
+	cevConsumer := services.NewContractsEventsConsumer(dbs, &logger, settings)
+	queue := dlq.New(dbs, cevConsumer.Process, cevConsumer.EntityKey, &logger)
+
+	switch args[0] {
+	case "list":
+		dls, err := queue.List(ctx)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Failed to list dead letters.")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tEVENT ID\tENTITY\tATTEMPTS\tLAST ATTEMPT\tNEXT ATTEMPT\tERROR")
+		for _, dl := range dls {
+			next := "-"
+			if dl.NextAttemptAt.Valid {
+				next = dl.NextAttemptAt.Time.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n", dl.ID, dl.CloudEventID, dl.EntityKey, dl.Attempts, dl.LastAttemptedAt.Format(time.RFC3339), next, dl.Error)
+		}
+		if err := w.Flush(); err != nil {
+			logger.Fatal().Err(err).Msg("Failed to write output.")
+		}
+	case "retry":
+		if len(args) < 2 {
+			logger.Fatal().Msg(dlqUsage)
+		}
+
+		if args[1] == "due" {
+			n, err := queue.RetryDue(ctx)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("Failed to retry dead letters.")
+			}
+			logger.Info().Int("succeeded", n).Msg("Retried due dead letters.")
+			return
+		}
+
+		id := parseDeadLetterID(logger, args[1])
+		if err := queue.Retry(ctx, id); err != nil {
+			logger.Fatal().Err(err).Int64("deadLetterId", id).Msg("Retry failed.")
+		}
+		logger.Info().Int64("deadLetterId", id).Msg("Retry succeeded, removed from queue.")
+	case "discard":
+		if len(args) < 2 {
+			logger.Fatal().Msg(dlqUsage)
+		}
+
+		id := parseDeadLetterID(logger, args[1])
+		if err := queue.Discard(ctx, id); err != nil {
+			logger.Fatal().Err(err).Int64("deadLetterId", id).Msg("Failed to discard dead letter.")
+		}
+		logger.Info().Int64("deadLetterId", id).Msg("Discarded dead letter.")
+	default:
+		logger.Fatal().Msg(dlqUsage)
+	}
+}
+
+func parseDeadLetterID(logger zerolog.Logger, s string) int64 {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("Invalid dead letter id %q.", s)
+	}
+	return id
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/services"
+	"github.com/DIMO-Network/identity-api/internal/services/dlq"
+	"github.com/DIMO-Network/identity-api/internal/services/indexer"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rs/zerolog"
+)
+
+func runIndexCommand(ctx context.Context, logger zerolog.Logger, settings *config.Settings, dbs db.Store, args []string) {
+	fs := flag.NewFlagSet("index", flag.ExitOnError)
+	fromBlock := fs.Int64("from-block", -1, "First block to index. Defaults to the block after the stored checkpoint.")
+	toBlock := fs.Int64("to-block", -1, "Last block to index. Without it the indexer keeps following the chain head.")
+	batchSize := fs.Uint64("batch-size", 1000, "Maximum number of blocks per eth_getLogs request.")
+	confirmations := fs.Uint64("confirmations", 0, "Number of blocks to stay behind the chain head.")
+	pollInterval := fs.Duration("poll-interval", 5*time.Second, "How often to check for new blocks once caught up.")
+
+	if err := fs.Parse(args); err != nil {
+		logger.Fatal().Err(err).Msg("Couldn't parse flags.")
+	}
+
+	if settings.EthereumRPCURL == "" {
+		logger.Fatal().Msg("ETHEREUM_RPC_URL must be set to index from a node.")
+	}
+
+	client, err := ethclient.DialContext(ctx, settings.EthereumRPCURL)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to connect to the Ethereum node.")
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to get chain id.")
+	}
+	if chainID.Int64() != settings.DIMORegistryChainID {
+		logger.Fatal().Msgf("Node is on chain %d, but DIMO_REGISTRY_CHAIN_ID is %d.", chainID, settings.DIMORegistryChainID)
+	}
+
+	cevConsumer := services.NewContractsEventsConsumer(dbs, &logger, settings)
+	deadLetters := dlq.New(dbs, cevConsumer.Process, cevConsumer.EntityKey, &logger)
+
+	ix, err := indexer.New(client, dbs, deadLetters.Process, settings, &logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to create indexer.")
+	}
+
+	opts := indexer.Options{
+		BatchSize:     *batchSize,
+		Confirmations: *confirmations,
+		PollInterval:  *pollInterval,
+	}
+	if *fromBlock >= 0 {
+		n := uint64(*fromBlock)
+		opts.FromBlock = &n
+	}
+	if *toBlock >= 0 {
+		n := uint64(*toBlock)
+		opts.ToBlock = &n
+	}
+
+	go deadLetters.Run(ctx, dlqRetryInterval)
+
+	if err := ix.Run(ctx, opts); err != nil {
+		logger.Fatal().Err(err).Msg("Indexing stopped.")
+	}
+
+	logger.Info().Msg("Indexing finished.")
+}
@@ -17,12 +17,13 @@ import (
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/DIMO-Network/identity-api/graph"
 	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
 	"github.com/DIMO-Network/identity-api/internal/loader"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/internal/services"
+	"github.com/DIMO-Network/identity-api/internal/services/dlq"
 	"github.com/DIMO-Network/server-garage/pkg/mcpserver"
 	"github.com/DIMO-Network/shared/pkg/db"
-	"github.com/DIMO-Network/shared/pkg/kafka"
 	"github.com/DIMO-Network/shared/pkg/settings"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
@@ -64,6 +65,20 @@ func main() {
 	dbs := db.NewDbConnectionFromSettings(context.Background(), &settings.DB, true)
 	dbs.WaitForDB(logger)
 
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "dlq":
+			runDLQCommand(ctx, logger, &settings, dbs, os.Args[2:])
+			return
+		case "replay":
+			runReplayCommand(ctx, logger, &settings, dbs, os.Args[2:])
+			return
+		case "index":
+			runIndexCommand(ctx, logger, &settings, dbs, os.Args[2:])
+			return
+		}
+	}
+
 	startContractEventsConsumer(ctx, &logger, &settings, dbs)
 
 	repoLogger := logger.With().Str("component", "repository").Logger()
@@ -111,11 +126,28 @@ func startContractEventsConsumer(ctx context.Context, logger *zerolog.Logger, se
 	}
 
 	cevConsumer := services.NewContractsEventsConsumer(dbs, logger, settings)
+	deadLetters := dlq.New(dbs, cevConsumer.Process, cevConsumer.EntityKey, logger)
+
+	handler := deadLetters.Process
+	switch {
+	case settings.BlockTransactions && settings.ConsumerWorkers > 1:
+		logger.Fatal().Msg("BLOCK_TRANSACTIONS can't be combined with more than one consumer worker.")
+	case settings.ReorgHandling && settings.ConsumerWorkers > 1:
+		logger.Fatal().Msg("REORG_HANDLING can't be combined with more than one consumer worker.")
+	case settings.BlockTransactions:
+		handler = services.NewBlockBatcher(cevConsumer, deadLetters.Process, deadLetters.Blocked).Process
+	case settings.ConsumerWorkers > 1:
+		sharded := services.NewShardedProcessor(cevConsumer, deadLetters.Process, settings.ConsumerWorkers, max(settings.ConsumerQueueSize, 1))
+		sharded.Start(ctx)
+		handler = sharded.Process
+	}
 
-	if err := kafka.Consume(ctx, kc, cevConsumer.Process, logger); err != nil {
+	if err := kafka.Consume(ctx, kc, dbs, handler, logger); err != nil {
 		logger.Fatal().Err(err).Msg("Couldn't start event consumer.")
 	}
 
+	go deadLetters.Run(ctx, dlqRetryInterval)
+
 	logger.Info().Msg("Contract events consumer started.")
 }
 
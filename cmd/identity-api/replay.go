@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/services"
+	"github.com/DIMO-Network/identity-api/internal/services/replay"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/rs/zerolog"
+)
+
+func runReplayCommand(ctx context.Context, logger zerolog.Logger, settings *config.Settings, dbs db.Store, args []string) {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	file := fs.String("file", "", "Newline-delimited JSON file of cloud events to replay.")
+	fromBlock := fs.Int64("from-block", -1, "Skip contract events before this block.")
+	toBlock := fs.Int64("to-block", -1, "Skip contract events after this block.")
+	fresh := fs.Bool("fresh", false, "Roll back all migrations and re-apply them before replaying. This deletes all indexed data.")
+	keepGoing := fs.Bool("keep-going", false, "Log and skip events that fail instead of stopping.")
+
+	if err := fs.Parse(args); err != nil {
+		logger.Fatal().Err(err).Msg("Couldn't parse flags.")
+	}
+
+	if *file == "" {
+		logger.Fatal().Msg("usage: identity-api replay --file events.jsonl [--from-block N] [--to-block M] [--fresh] [--keep-going]")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("Couldn't open %q.", *file)
+	}
+	defer f.Close()
+
+	if *fresh {
+		migrateDatabase(logger, settings, "reset")
+		migrateDatabase(logger, settings, "up")
+	}
+
+	opts := replay.Options{KeepGoing: *keepGoing}
+	if *fromBlock >= 0 {
+		opts.FromBlock = fromBlock
+	}
+	if *toBlock >= 0 {
+		opts.ToBlock = toBlock
+	}
+
+	cevConsumer := services.NewContractsEventsConsumer(dbs, &logger, settings)
+
+	stats, err := replay.Run(ctx, f, cevConsumer.Process, opts, &logger)
+	if err != nil {
+		logger.Fatal().Err(err).Int("processed", stats.Processed).Msg("Replay stopped.")
+	}
+
+	logger.Info().Int("read", stats.Read).Int("processed", stats.Processed).Int("skipped", stats.Skipped).Int("failed", stats.Failed).Msg("Replay finished.")
+}
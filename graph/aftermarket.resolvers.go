@@ -7,9 +7,11 @@ package graph
 
 import (
 	"context"
+	"time"
 
 	"github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/loader"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Manufacturer is the resolver for the manufacturer field.
@@ -17,6 +19,11 @@ func (r *aftermarketDeviceResolver) Manufacturer(ctx context.Context, obj *model
 	return loader.GetManufacturerID(ctx, obj.ManufacturerID)
 }
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *aftermarketDeviceResolver) OwnerAccount(ctx context.Context, obj *model.AftermarketDevice) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // Vehicle is the resolver for the vehicle field.
 func (r *aftermarketDeviceResolver) Vehicle(ctx context.Context, obj *model.AftermarketDevice) (*model.Vehicle, error) {
 	if obj.VehicleID == nil {
@@ -25,11 +32,31 @@ func (r *aftermarketDeviceResolver) Vehicle(ctx context.Context, obj *model.Afte
 	return loader.GetVehicleByID(ctx, *obj.VehicleID)
 }
 
+// BeneficiaryAccount is the resolver for the beneficiaryAccount field.
+func (r *aftermarketDeviceResolver) BeneficiaryAccount(ctx context.Context, obj *model.AftermarketDevice) (*model.Account, error) {
+	return &model.Account{Address: obj.Beneficiary}, nil
+}
+
 // Earnings is the resolver for the earnings field.
 func (r *aftermarketDeviceResolver) Earnings(ctx context.Context, obj *model.AftermarketDevice) (*model.AftermarketDeviceEarnings, error) {
 	return r.reward.GetEarningsByAfterMarketDeviceID(ctx, obj.TokenID)
 }
 
+// History is the resolver for the history field.
+func (r *aftermarketDeviceResolver) History(ctx context.Context, obj *model.AftermarketDevice, first *int, after *string, last *int, before *string) (*model.AftermarketDeviceEventConnection, error) {
+	return r.aftermarkethistory.GetEventsForDevice(ctx, obj.TokenID, first, after, last, before)
+}
+
+// BeneficiaryHistory is the resolver for the beneficiaryHistory field.
+func (r *aftermarketDeviceResolver) BeneficiaryHistory(ctx context.Context, obj *model.AftermarketDevice, first *int, after *string, last *int, before *string) (*model.BeneficiaryChangeConnection, error) {
+	return r.aftermarkethistory.GetBeneficiaryHistory(ctx, obj.TokenID, first, after, last, before)
+}
+
+// BeneficiaryAt is the resolver for the beneficiaryAt field.
+func (r *aftermarketDeviceResolver) BeneficiaryAt(ctx context.Context, obj *model.AftermarketDevice, time time.Time) (*common.Address, error) {
+	return r.aftermarkethistory.GetBeneficiaryAt(ctx, obj.TokenID, time)
+}
+
 // History is the resolver for the history field.
 func (r *aftermarketDeviceEarningsResolver) History(ctx context.Context, obj *model.AftermarketDeviceEarnings, first *int, after *string, last *int, before *string) (*model.EarningsConnection, error) {
 	return r.reward.PaginateAftermarketDeviceEarningsByID(ctx, obj, first, after, last, before)
@@ -14,9 +14,15 @@ import (
 	"github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
 	"github.com/DIMO-Network/identity-api/internal/repositories"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *connectionResolver) OwnerAccount(ctx context.Context, obj *model.Connection) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // Sacds is the resolver for the sacds field.
 func (r *connectionResolver) Sacds(ctx context.Context, obj *model.Connection, first *int, after *string, last *int, before *string) (*model.SacdConnection, error) {
 	connectionID, err := helpers.ConvertTokenIDToID(obj.TokenID)
@@ -27,6 +33,16 @@ func (r *connectionResolver) Sacds(ctx context.Context, obj *model.Connection, f
 	return r.connectionsacd.GetSacdsForConnection(ctx, connectionID, first, after, last, before)
 }
 
+// SacdHistory is the resolver for the sacdHistory field.
+func (r *connectionResolver) SacdHistory(ctx context.Context, obj *model.Connection, grantee *common.Address, first *int, after *string, last *int, before *string) (*model.SacdHistoryEntryConnection, error) {
+	connectionID, err := helpers.ConvertTokenIDToID(obj.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.sacdhistory.GetHistoryForConnection(ctx, connectionID, grantee, first, after, last, before)
+}
+
 // Connections is the resolver for the connections field.
 func (r *queryResolver) Connections(ctx context.Context, first *int, after *string, last *int, before *string) (*model.ConnectionConnection, error) {
 	return r.connection.GetConnections(ctx, first, after, last, before)
@@ -0,0 +1,17 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.89
+
+import (
+	"context"
+
+	"github.com/DIMO-Network/identity-api/graph/model"
+)
+
+// ContractEvents is the resolver for the contractEvents field.
+func (r *queryResolver) ContractEvents(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.ContractEventsFilter) (*model.ContractEventConnection, error) {
+	return r.contractEvent.GetContractEvents(ctx, first, after, last, before, filterBy)
+}
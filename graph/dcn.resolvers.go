@@ -12,6 +12,11 @@ import (
 	"github.com/DIMO-Network/identity-api/internal/loader"
 )
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *dCNResolver) OwnerAccount(ctx context.Context, obj *model.Dcn) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // Vehicle is the resolver for the vehicle field.
 func (r *dCNResolver) Vehicle(ctx context.Context, obj *model.Dcn) (*model.Vehicle, error) {
 	if obj.VehicleID == nil {
@@ -20,9 +25,14 @@ func (r *dCNResolver) Vehicle(ctx context.Context, obj *model.Dcn) (*model.Vehic
 	return loader.GetVehicleByID(ctx, *obj.VehicleID)
 }
 
+// OwnershipHistory is the resolver for the ownershipHistory field.
+func (r *dCNResolver) OwnershipHistory(ctx context.Context, obj *model.Dcn, first *int, after *string, last *int, before *string) (*model.DCNTransferConnection, error) {
+	return r.dcntransfer.GetTransfersForDCN(ctx, obj.Node, first, after, last, before)
+}
+
 // Dcn is the resolver for the dcn field.
-func (r *queryResolver) Dcn(ctx context.Context, by model.DCNBy) (*model.Dcn, error) {
-	return r.dcn.GetDCN(ctx, by)
+func (r *queryResolver) Dcn(ctx context.Context, by model.DCNBy, includeBurned *bool) (*model.Dcn, error) {
+	return r.dcn.GetDCN(ctx, by, includeBurned != nil && *includeBurned)
 }
 
 // Dcns is the resolver for the dcns field.
@@ -30,6 +40,11 @@ func (r *queryResolver) Dcns(ctx context.Context, first *int, after *string, las
 	return r.dcn.GetDCNs(ctx, first, after, last, before, filterBy)
 }
 
+// BurnedDCNs is the resolver for the burnedDCNs field.
+func (r *queryResolver) BurnedDCNs(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.DCNFilter) (*model.DCNConnection, error) {
+	return r.dcn.GetBurnedDCNs(ctx, first, after, last, before, filterBy)
+}
+
 // DCN returns DCNResolver implementation.
 func (r *Resolver) DCN() DCNResolver { return &dCNResolver{r} }
 
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/DIMO-Network/identity-api/graph/model"
@@ -16,14 +17,24 @@ import (
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *developerLicenseResolver) OwnerAccount(ctx context.Context, obj *model.DeveloperLicense) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // Signers is the resolver for the signers field.
-func (r *developerLicenseResolver) Signers(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.SignerConnection, error) {
-	return r.developerLicense.GetSignersForLicense(ctx, obj, first, after, last, before)
+func (r *developerLicenseResolver) Signers(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*model.SignerConnection, error) {
+	return r.developerLicense.GetSignersForLicense(ctx, obj, first, after, last, before, includeDisabled, activeAt)
 }
 
 // RedirectURIs is the resolver for the redirectURIs field.
-func (r *developerLicenseResolver) RedirectURIs(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.RedirectURIConnection, error) {
-	return r.developerLicense.GetRedirectURIsForLicense(ctx, obj, first, after, last, before)
+func (r *developerLicenseResolver) RedirectURIs(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*model.RedirectURIConnection, error) {
+	return r.developerLicense.GetRedirectURIsForLicense(ctx, obj, first, after, last, before, includeDisabled, activeAt)
+}
+
+// OwnershipHistory is the resolver for the ownershipHistory field.
+func (r *developerLicenseResolver) OwnershipHistory(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.DeveloperLicenseTransferConnection, error) {
+	return r.developerLicense.GetTransfersForLicense(ctx, obj, first, after, last, before)
 }
 
 // DeveloperLicenses is the resolver for the developerLicenses field.
@@ -11,6 +11,11 @@ import (
 	"github.com/DIMO-Network/identity-api/graph/model"
 )
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *manufacturerResolver) OwnerAccount(ctx context.Context, obj *model.Manufacturer) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // AftermarketDevices is the resolver for the aftermarketDevices field on the manufacturer object.
 func (r *manufacturerResolver) AftermarketDevices(ctx context.Context, obj *model.Manufacturer, first *int, after *string, last *int, before *string, filterBy *model.AftermarketDevicesFilter) (*model.AftermarketDeviceConnection, error) {
 	return r.aftermarket.GetAftermarketDevicesForManufacturer(ctx, obj, first, after, last, before, filterBy)
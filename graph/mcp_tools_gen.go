@@ -29,8 +29,9 @@ var MCPTools = []mcpserver.ToolDefinition{
 		Args: []mcpserver.ArgDefinition{
 			{Name: "tokenId", Type: "integer", Description: "The token ID of the vehicle.", Required: false, ItemsType: ""},
 			{Name: "tokenDID", Type: "string", Description: "The DID of the vehicle.", Required: false, ItemsType: ""},
+			{Name: "includeBurned", Type: "boolean", Description: "Also return the vehicle if it has been burned. Otherwise a burned vehicle is not found.", Required: false, ItemsType: ""},
 		},
-		Query: "query($tokenId: Int, $tokenDID: String) { vehicle(tokenId: $tokenId, tokenDID: $tokenDID) { tokenId name owner mintedAt definition { make model year } aftermarketDevice { serial } syntheticDevice { tokenId } } }",
+		Query: "query($tokenId: Int, $tokenDID: String, $includeBurned: Boolean) { vehicle(tokenId: $tokenId, tokenDID: $tokenDID, includeBurned: $includeBurned) { tokenId name owner mintedAt definition { make model year } aftermarketDevice { serial } syntheticDevice { tokenId } } }",
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    true,
 			DestructiveHint: boolPtr(false),
@@ -58,4 +59,4 @@ var MCPTools = []mcpserver.ToolDefinition{
 	},
 }
 
-var CondensedSchema = "scalar Address  # A 20-byte Ethereum address, encoded as a checksummed hex string with 0x prefix.\nscalar BigDecimal  # BigDecimal decimal floating-point number, per the General Decimal Arithmetic specification.\nscalar BigInt  # An integer of arbitrary precision, decimal-encoded.\nscalar Bytes  # An array of byte, encoded as a lowercase hex string with 0x prefix.\nscalar Time  # A point in time, encoded per RFC-3999.\n\n# All tokenDID fields use the format did:erc721:<chainID>:<contractAddress>:<tokenId>\n# All *Edge types: { node: T!, cursor: String! }\n# All *Connection types: { totalCount: Int!, edges: [TEdge!]!, nodes: [T!]!, pageInfo: PageInfo! }\n\ntype Query {\n  node(id: ID!): Node\n  aftermarketDevice(by: AftermarketDeviceBy!): AftermarketDevice\n  # Example - Get aftermarket device by serial:\n  #   { aftermarketDevice(by: { serial: \"abc-123\" }) { tokenId owner serial manufacturer { name } vehicle { tokenId definition { make model year } } earnings { totalTokens } } }\n\n  aftermarketDevices(first: Int, after: String, last: Int, before: String, filterBy: AftermarketDevicesFilter): AftermarketDeviceConnection!\n  connections(first: Int, after: String, last: Int, before: String): ConnectionConnection!\n  connection(by: ConnectionBy!): Connection\n  dcn(by: DCNBy!): DCN\n  dcns(first: Int, after: String, last: Int, before: String, filterBy: DCNFilter): DCNConnection!\n  developerLicenses(first: Int, after: String, last: Int, before: String, filterBy: DeveloperLicenseFilterBy): DeveloperLicenseConnection!\n  developerLicense(by: DeveloperLicenseBy!): DeveloperLicense\n  deviceDefinition(by: DeviceDefinitionBy!): DeviceDefinition\n  manufacturer(by: ManufacturerBy!): Manufacturer\n  manufacturers: ManufacturerConnection!\n  rewards(user: Address!): UserRewards\n  stakes(first: Int, after: String, last: Int, before: String, filterBy: StakeFilterBy): StakeConnection\n  syntheticDevice(by: SyntheticDeviceBy!): SyntheticDevice\n  syntheticDevices(first: Int, last: Int, after: String, before: String, filterBy: SyntheticDevicesFilter): SyntheticDeviceConnection!\n  template(by: TemplateBy!): Template\n  templates(first: Int, after: String, last: Int, before: String): TemplateConnection!\n  account(by: AccountBy!): Account\n  vehicle(tokenId: Int, tokenDID: String): Vehicle\n  # Example - Get vehicle by tokenId:\n  #   { vehicle(tokenId: 123) { tokenId name owner definition { make model year } aftermarketDevice { serial } syntheticDevice { tokenId } dcn { name } stake { level amount } } }\n  # Example - Get vehicle SACDs (permission grants):\n  #   { vehicle(tokenId: 123) { sacds { nodes { grantee permissions expiresAt template { cid } } } } }\n  # Example - Get vehicle privileges:\n  #   { vehicle(tokenId: 123) { privileges(filterBy: { user: \"0x...\" }) { nodes { id user setAt expiresAt } } } }\n\n  vehicles(first: Int, after: String, last: Int, before: String, filterBy: VehiclesFilter): VehicleConnection!\n  # Example - List vehicles user has access to (via privileges or SACDs):\n  #   { vehicles(first: 100, filterBy: { privileged: \"0x...\" }) { nodes { tokenId name owner } } }\n}\n\ntype Account { address: Address!, sacds(first: Int, after: String, last: Int, before: String): SacdConnection! }\n\ninput AccountBy @oneOf { address: Address }\n\ntype AftermarketDevice implements Node { id: ID!, tokenId: Int!, tokenDID: String!, manufacturer: Manufacturer!, address: Address!, owner: Address!, serial: String, imei: String, devEUI: String, hardwareRevision: String, mintedAt: Time!, claimedAt: Time, vehicle: Vehicle, beneficiary: Address!, name: String!, image: String!, earnings: AftermarketDeviceEarnings, pairedAt: Time }\n\ninput AftermarketDeviceBy @oneOf {\n  \"token id of the aftermarket device NFT\"\n  tokenId: Int\n  tokenDID: String\n  address: Address\n  \"serial number of the aftermarket device\"\n  serial: String\n  \"The International Mobile Equipment Identity (IMEI) for the device if available\"\n  imei: String\n  \"Extended Unique Identifier (EUI) for LoRa devices if available\"\n  devEUI: String\n}\n\ntype AftermarketDeviceEarnings { totalTokens: BigDecimal!, history(first: Int, after: String, last: Int, before: String): EarningsConnection! }\n\ninput AftermarketDevicesFilter { owner: Address, beneficiary: Address, manufacturerId: Int }\n\ntype Connection { name: String!, address: Address!, owner: Address!, tokenId: BigInt!, tokenDID: String!, mintedAt: Time! }\n\ninput ConnectionBy { name: String, address: Address, tokenId: BigInt, tokenDID: String }\n\ntype DCN implements Node { id: ID!, node: Bytes!, tokenId: BigInt!, tokenDID: String!, owner: Address!, expiresAt: Time, mintedAt: Time!, name: String, vehicle: Vehicle }\n\ninput DCNBy @oneOf { node: Bytes, tokenDID: String, name: String }\n\ninput DCNFilter { owner: Address }\n\ntype Definition { id: String, make: String, model: String, year: Int }\n\ntype DeveloperLicense { tokenId: Int!, tokenDID: String!, owner: Address!, clientId: Address!, alias: String, mintedAt: Time!, signers(first: Int, after: String, last: Int, before: String): SignerConnection!, redirectURIs(first: Int, after: String, last: Int, before: String): RedirectURIConnection! }\n\ninput DeveloperLicenseBy { clientId: Address, alias: String, tokenId: Int, tokenDID: String }\n\ninput DeveloperLicenseFilterBy { signer: Address, owner: Address }\n\ntype DeviceDefinition { deviceDefinitionId: String!, legacyId: String, manufacturer: Manufacturer, model: String!, year: Int!, deviceType: String, imageURI: String, attributes: [DeviceDefinitionAttribute!]! }\n\ntype DeviceDefinitionAttribute { name: String!, value: String! }\n\ninput DeviceDefinitionBy @oneOf { id: String! }\n\ninput DeviceDefinitionFilter {\n  \"Model filters for device definition that are of the given model. This filter performs a case insensitive match.\"\n  model: String\n  \"Year filters for device definition that are of the given year.\"\n  year: Int\n}\n\ntype Earning { week: Int!, beneficiary: Address!, connectionStreak: Int, streakTokens: BigDecimal!, aftermarketDevice: AftermarketDevice, aftermarketDeviceTokens: BigDecimal!, syntheticDevice: SyntheticDevice, syntheticDeviceTokens: BigDecimal!, vehicle: Vehicle, sentAt: Time! }\n\ntype Manufacturer implements Node { id: ID!, tokenId: Int!, tokenDID: String!, name: String!, owner: Address!, tableId: Int, mintedAt: Time!, aftermarketDevices(first: Int, after: String, last: Int, before: String, filterBy: AftermarketDevicesFilter): AftermarketDeviceConnection!, deviceDefinitions(first: Int, after: String, last: Int, before: String, filterBy: DeviceDefinitionFilter): DeviceDefinitionConnection! }\n\ninput ManufacturerBy @oneOf { name: String, tokenId: Int, slug: String, tokenDID: String }\n\ninterface Node { id: ID! }\n\ntype PageInfo { startCursor: String, endCursor: String, hasPreviousPage: Boolean!, hasNextPage: Boolean! }\n\ntype Privilege { id: Int!, user: Address!, setAt: Time!, expiresAt: Time! }\n\ninput PrivilegeFilterBy { user: Address, privilegeId: Int }\n\ntype RedirectURI { uri: String!, enabledAt: Time! }\n\ntype Sacd { grantee: Address!, permissions: String!, source: String!, createdAt: Time!, expiresAt: Time!, template: Template }\n\ntype Signer { address: Address!, enabledAt: Time! }\n\ntype Stake { tokenId: Int!, tokenDID: String!, owner: Address!, level: Int!, points: Int!, amount: BigDecimal!, stakedAt: Time!, endsAt: Time!, withdrawnAt: Time, vehicle: Vehicle }\n\ninput StakeFilterBy {\n  owner: Address\n  \"Filter stakes based on attachability. A stake is considered attachable if it is not presently attached to a vehicle and has not yet ended.\"\n  attachable: Boolean\n}\n\ntype StorageNode { label: String!, address: Address!, owner: Address!, tokenId: BigInt!, uri: String!, tokenDID: String!, mintedAt: Time! }\n\ntype SyntheticDevice implements Node { id: ID!, name: String!, tokenId: Int!, tokenDID: String!, address: Address!, mintedAt: Time!, vehicle: Vehicle!, connection: Connection! }\n\ninput SyntheticDeviceBy @oneOf {\n  tokenId: Int\n  tokenDID: String\n  \"The Ethereum address for the synthetic device.\"\n  address: Address\n}\n\ninput SyntheticDevicesFilter { owner: Address, integrationId: Int }\n\ntype Template { tokenId: BigInt!, creator: Address!, asset: Address!, permissions: String!, cid: String!, createdAt: Time! }\n\ninput TemplateBy { tokenId: BigInt, cid: String }\n\ntype UserRewards { totalTokens: BigDecimal!, history(first: Int, after: String, last: Int, before: String): EarningsConnection! }\n\ntype Vehicle implements Node { id: ID!, tokenId: Int!, tokenDID: String!, manufacturer: Manufacturer!, owner: Address!, mintedAt: Time!, aftermarketDevice: AftermarketDevice, privileges(first: Int, after: String, last: Int, before: String, filterBy: PrivilegeFilterBy): PrivilegesConnection!, sacds(first: Int, after: String, last: Int, before: String): SacdConnection!, sacd(grantee: Address!): Sacd, syntheticDevice: SyntheticDevice, definition: Definition, dcn: DCN, name: String!, imageURI: String!, earnings: VehicleEarnings, dataURI: String!, stake: Stake, storageNode: StorageNode }\n\ntype VehicleEarnings { totalTokens: BigDecimal!, history(first: Int, after: String, last: Int, before: String): EarningsConnection! }\n\ninput VehiclesFilter {\n  \"Privileged filters for vehicles to which the given address has access. This includes vehicles that this address owns.\"\n  privileged: Address\n  owner: Address\n  \"Make filters for vehicles that are of the given make.\"\n  make: String\n  \"Model filters for vehicles that are of the given model.\"\n  model: String\n  \"Year filters for vehicles that are of the given year.\"\n  year: Int\n  \"Filter for vehicles produced by a particular manufacturer, specified by manufacturer token id.\"\n  manufacturerTokenId: Int\n  deviceDefinitionId: String\n}\n"
+var CondensedSchema = "scalar Address  # A 20-byte Ethereum address, encoded as a checksummed hex string with 0x prefix.\nscalar BigDecimal  # BigDecimal decimal floating-point number, per the General Decimal Arithmetic specification.\nscalar BigInt  # An integer of arbitrary precision, decimal-encoded.\nscalar Bytes  # An array of byte, encoded as a lowercase hex string with 0x prefix.\nscalar Time  # A point in time, encoded per RFC-3999.\n\n# All tokenDID fields use the format did:erc721:<chainID>:<contractAddress>:<tokenId>\n# All *Edge types: { node: T!, cursor: String! }\n# All *Connection types: { totalCount: Int!, edges: [TEdge!]!, nodes: [T!]!, pageInfo: PageInfo! }\n\ntype Query {\n  node(id: ID!): Node\n  aftermarketDevice(by: AftermarketDeviceBy!): AftermarketDevice\n  # Example - Get aftermarket device by serial:\n  #   { aftermarketDevice(by: { serial: \"abc-123\" }) { tokenId owner serial manufacturer { name } vehicle { tokenId definition { make model year } } earnings { totalTokens } } }\n\n  aftermarketDevices(first: Int, after: String, last: Int, before: String, filterBy: AftermarketDevicesFilter): AftermarketDeviceConnection!\n  connections(first: Int, after: String, last: Int, before: String): ConnectionConnection!\n  connection(by: ConnectionBy!): Connection\n  contractEvents(first: Int, after: String, last: Int, before: String, filterBy: ContractEventsFilter): ContractEventConnection!\n  dcn(by: DCNBy!): DCN\n  dcns(first: Int, after: String, last: Int, before: String, filterBy: DCNFilter): DCNConnection!\n  developerLicenses(first: Int, after: String, last: Int, before: String, filterBy: DeveloperLicenseFilterBy): DeveloperLicenseConnection!\n  developerLicense(by: DeveloperLicenseBy!): DeveloperLicense\n  deviceDefinition(by: DeviceDefinitionBy!): DeviceDefinition\n  manufacturer(by: ManufacturerBy!): Manufacturer\n  manufacturers: ManufacturerConnection!\n  rewards(user: Address!): UserRewards\n  stakes(first: Int, after: String, last: Int, before: String, filterBy: StakeFilterBy): StakeConnection\n  syntheticDevice(by: SyntheticDeviceBy!): SyntheticDevice\n  syntheticDevices(first: Int, last: Int, after: String, before: String, filterBy: SyntheticDevicesFilter): SyntheticDeviceConnection!\n  template(by: TemplateBy!): Template\n  templates(first: Int, after: String, last: Int, before: String): TemplateConnection!\n  account(by: AccountBy!): Account\n  # Example - The other vehicles held by a vehicle's owner:\n  #   { vehicle(tokenId: 123) { ownerAccount { vehicles(first: 10) { nodes { tokenId definition { make model year } } } } } }\n  # Example - Everything an address holds, in one request:\n  #   { account(by: { address: \"0x...\" }) { counts { vehicles aftermarketDevices syntheticDevices dcns stakes developerLicenses connections storageNodes } vehicles(first: 10) { nodes { tokenId definition { make model year } } } rewards { totalTokens } } }\n  # Example - List the vehicles, accounts and connections that granted an app access:\n  #   { account(by: { address: \"0x...\" }) { receivedSacds(first: 50) { totalCount nodes { scope vehicleTokenId account connectionTokenId sacd { permissionList { name } expiresAt } } } } }\n  vehicle(tokenId: Int, tokenDID: String, includeBurned: Boolean): Vehicle\n  # Example - Get vehicle by tokenId:\n  #   { vehicle(tokenId: 123) { tokenId name owner definition { make model year } aftermarketDevice { serial } syntheticDevice { tokenId } dcn { name } stake { level amount } } }\n  # Example - Get vehicle SACDs (permission grants):\n  #   { vehicle(tokenId: 123) { sacds { nodes { grantee permissions expiresAt template { cid } } } } }\n  # Example - Check SACD agreement documents against the on-chain grants:\n  #   { vehicle(tokenId: 123) { sacds { nodes { grantee source document { grantor signatureValid agreements { asset permissions } mismatches { field document onChain } } } } } }\n  # Example - Get vehicle privileges:\n  #   { vehicle(tokenId: 123) { privileges(filterBy: { user: \"0x...\" }) { nodes { id user setAt expiresAt } } } }\n\n  vehicles(first: Int, after: String, last: Int, before: String, filterBy: VehiclesFilter): VehicleConnection!\n  # Example - List vehicles user has access to (via privileges or SACDs):\n  #   { vehicles(first: 100, filterBy: { privileged: \"0x...\" }) { nodes { tokenId name owner } } }\n  # Example - List vehicles on which an address can read location:\n  #   { vehicles(first: 100, filterBy: { privilegedWith: { address: \"0x...\", permissions: [CURRENT_LOCATION] } }) { totalCount nodes { tokenId } } }\n  burnedVehicles(first: Int, after: String, last: Int, before: String, filterBy: VehiclesFilter): VehicleConnection!\n  access(vehicleTokenId: Int!, grantee: Address!, atTime: Time): VehicleAccess!\n  # Example - Check which permissions an address holds on a vehicle, and through which grants:\n  #   { access(vehicleTokenId: 123, grantee: \"0x...\") { isOwner permissions grants { permission expiresAt sources { type privilegeId templateId } } } }\n  permissionCatalog: [Permission!]!\n}\n\ntype Account { address: Address!, sacds(first: Int, after: String, last: Int, before: String): SacdConnection!, sacdHistory(grantee: Address, first: Int, after: String, last: Int, before: String): SacdHistoryEntryConnection!, receivedSacds(first: Int, after: String, last: Int, before: String, filterBy: ReceivedSacdsFilter): ReceivedSacdConnection!, receivedPrivileges(first: Int, after: String, last: Int, before: String, filterBy: ReceivedPrivilegesFilter): ReceivedPrivilegeConnection!, vehicles(first: Int, after: String, last: Int, before: String): VehicleConnection!, aftermarketDevices(first: Int, after: String, last: Int, before: String): AftermarketDeviceConnection!, syntheticDevices(first: Int, after: String, last: Int, before: String): SyntheticDeviceConnection!, dcns(first: Int, after: String, last: Int, before: String): DCNConnection!, stakes(first: Int, after: String, last: Int, before: String): StakeConnection!, developerLicenses(first: Int, after: String, last: Int, before: String): DeveloperLicenseConnection!, connections(first: Int, after: String, last: Int, before: String): ConnectionConnection!, storageNodes(first: Int, after: String, last: Int, before: String): StorageNodeConnection!, rewards: UserRewards, counts: AccountCounts! }\n\ninput AccountBy @oneOf { address: Address }\n\ntype AccountCounts { vehicles: Int!, aftermarketDevices: Int!, syntheticDevices: Int!, dcns: Int!, stakes: Int!, developerLicenses: Int!, connections: Int!, storageNodes: Int! }\n\ntype AftermarketDevice implements Node { id: ID!, tokenId: Int!, tokenDID: String!, manufacturer: Manufacturer!, address: Address!, owner: Address!, ownerAccount: Account!, serial: String, imei: String, devEUI: String, hardwareRevision: String, mintedAt: Time!, claimedAt: Time, vehicle: Vehicle, beneficiary: Address!, beneficiaryAccount: Account!, name: String!, image: String!, earnings: AftermarketDeviceEarnings, pairedAt: Time, history(first: Int, after: String, last: Int, before: String): AftermarketDeviceEventConnection!, beneficiaryHistory(first: Int, after: String, last: Int, before: String): BeneficiaryChangeConnection!, beneficiaryAt(time: Time!): Address }\n\ninput AftermarketDeviceBy @oneOf {\n  \"token id of the aftermarket device NFT\"\n  tokenId: Int\n  tokenDID: String\n  address: Address\n  \"serial number of the aftermarket device\"\n  serial: String\n  \"The International Mobile Equipment Identity (IMEI) for the device if available\"\n  imei: String\n  \"Extended Unique Identifier (EUI) for LoRa devices if available\"\n  devEUI: String\n}\n\ntype AftermarketDeviceEarnings { totalTokens: BigDecimal!, history(first: Int, after: String, last: Int, before: String): EarningsConnection! }\n\ntype AftermarketDeviceEvent { eventName: String!, aftermarketDeviceTokenId: Int!, vehicleTokenId: Int, owner: Address, address: Address, blockNumber: Int!, blockTimestamp: Time!, transactionHash: Bytes! }\n\ninput AftermarketDevicesFilter { owner: Address, beneficiary: Address, manufacturerId: Int }\n\ntype BeneficiaryChange { eventName: String!, beneficiary: Address!, explicit: Boolean!, blockNumber: Int!, blockTimestamp: Time!, transactionHash: Bytes! }\n\ninput BlockRange { from: Int, to: Int }\n\ntype Connection { name: String!, address: Address!, owner: Address!, ownerAccount: Account!, tokenId: BigInt!, tokenDID: String!, mintedAt: Time!, sacds(first: Int, after: String, last: Int, before: String): SacdConnection!, sacdHistory(grantee: Address, first: Int, after: String, last: Int, before: String): SacdHistoryEntryConnection! }\n\ninput ConnectionBy { name: String, address: Address, tokenId: BigInt, tokenDID: String }\n\ntype ContractEvent { chainId: Int!, contract: Address!, eventName: String!, eventSignature: Bytes!, transactionHash: Bytes!, blockNumber: Int!, blockHash: Bytes!, blockTimestamp: Time!, arguments: String! }\n\ninput ContractEventsFilter {\n  \"Filter for events emitted by the contract at this address.\"\n  contract: Address\n  \"Filter for events with this name, e.g., \\\"Transfer\\\".\"\n  eventName: String\n  \"Filter for events emitted in the transaction with this hash.\"\n  txHash: Bytes\n  \"Filter for events emitted in the given range of blocks.\"\n  blockRange: BlockRange\n  \"Filter for events with a `tokenId` argument equal to this value.\"\n  tokenId: BigInt\n}\n\ntype DCN implements Node { id: ID!, node: Bytes!, tokenId: BigInt!, tokenDID: String!, owner: Address!, ownerAccount: Account!, expiresAt: Time, mintedAt: Time!, name: String, vehicle: Vehicle, ownershipHistory(first: Int, after: String, last: Int, before: String): DCNTransferConnection! }\n\ninput DCNBy @oneOf { node: Bytes, tokenDID: String, name: String }\n\ninput DCNFilter { owner: Address }\n\ntype DCNTransfer { from: Address!, to: Address!, blockNumber: Int!, blockTimestamp: Time!, transactionHash: Bytes! }\n\ntype Definition { id: String, make: String, model: String, year: Int }\n\ntype DeveloperLicense { tokenId: Int!, tokenDID: String!, owner: Address!, ownerAccount: Account!, clientId: Address!, alias: String, mintedAt: Time!, signers(first: Int, after: String, last: Int, before: String, includeDisabled: Boolean, activeAt: Time): SignerConnection!, redirectURIs(first: Int, after: String, last: Int, before: String, includeDisabled: Boolean, activeAt: Time): RedirectURIConnection!, ownershipHistory(first: Int, after: String, last: Int, before: String): DeveloperLicenseTransferConnection! }\n\ninput DeveloperLicenseBy { clientId: Address, alias: String, tokenId: Int, tokenDID: String }\n\ninput DeveloperLicenseFilterBy { signer: Address, owner: Address }\n\ntype DeveloperLicenseTransfer { from: Address!, to: Address!, blockNumber: Int!, blockTimestamp: Time!, transactionHash: Bytes! }\n\ntype DeviceDefinition { deviceDefinitionId: String!, legacyId: String, manufacturer: Manufacturer, model: String!, year: Int!, deviceType: String, imageURI: String, attributes: [DeviceDefinitionAttribute!]! }\n\ntype DeviceDefinitionAttribute { name: String!, value: String! }\n\ninput DeviceDefinitionBy @oneOf { id: String! }\n\ninput DeviceDefinitionFilter {\n  \"Model filters for device definition that are of the given model. This filter performs a case insensitive match.\"\n  model: String\n  \"Year filters for device definition that are of the given year.\"\n  year: Int\n}\n\ntype Earning { week: Int!, beneficiary: Address!, connectionStreak: Int, streakTokens: BigDecimal!, aftermarketDevice: AftermarketDevice, aftermarketDeviceTokens: BigDecimal!, syntheticDevice: SyntheticDevice, syntheticDeviceTokens: BigDecimal!, vehicle: Vehicle, sentAt: Time! }\n\ntype Manufacturer implements Node { id: ID!, tokenId: Int!, tokenDID: String!, name: String!, owner: Address!, ownerAccount: Account!, tableId: Int, mintedAt: Time!, aftermarketDevices(first: Int, after: String, last: Int, before: String, filterBy: AftermarketDevicesFilter): AftermarketDeviceConnection!, deviceDefinitions(first: Int, after: String, last: Int, before: String, filterBy: DeviceDefinitionFilter): DeviceDefinitionConnection! }\n\ninput ManufacturerBy @oneOf { name: String, tokenId: Int, slug: String, tokenDID: String }\n\ninterface Node { id: ID! }\n\ntype PageInfo { startCursor: String, endCursor: String, hasPreviousPage: Boolean!, hasNextPage: Boolean! }\n\ntype Permission { name: PermissionName!, privilegeId: Int!, sacdBit: Int!, description: String! }\n\ntype PermissionAccess { permission: PermissionName!, expiresAt: Time, sources: [PermissionSource!]! }\n\ntype PermissionAgreement { type: String!, asset: String, permissions: [String!]! }\n\ntype PermissionDocument { uri: String!, type: String, grantor: Address, grantee: Address, effectiveAt: Time, expiresAt: Time, agreements: [PermissionAgreement!]!, signature: Bytes, signatureValid: Boolean!, mismatches: [PermissionDocumentMismatch!]! }\n\nenum PermissionDocumentField { GRANTOR, GRANTEE, ASSET, PERMISSIONS, EXPIRES_AT }\n\ntype PermissionDocumentMismatch { field: PermissionDocumentField!, document: String, onChain: String }\n\nenum PermissionName { NONLOCATION_TELEMETRY, COMMANDS, CURRENT_LOCATION, ALLTIME_LOCATION, CREDENTIALS, STREAMS, RAW_DATA, APPROXIMATE_LOCATION }\n\ntype PermissionSource { type: PermissionSourceType!, privilegeId: Int, templateId: BigInt, sacdSource: String, expiresAt: Time }\n\nenum PermissionSourceType { OWNERSHIP, PRIVILEGE, VEHICLE_SACD, ACCOUNT_SACD }\n\ntype Privilege { id: Int!, user: Address!, setAt: Time!, expiresAt: Time!, permissionList: [Permission!]! }\n\ninput PrivilegeFilterBy { user: Address, privilegeId: Int }\n\ninput PrivilegedWithFilter { address: Address!, permissions: [PermissionName!]! }\n\ntype ReceivedPrivilege { vehicleTokenId: Int!, privilege: Privilege! }\n\ninput ReceivedPrivilegesFilter { expiresAfter: Time, expiresBefore: Time, privilegeId: Int }\n\ntype ReceivedSacd { scope: SacdScope!, vehicleTokenId: Int, account: Address, connectionTokenId: BigInt, sacd: Sacd! }\n\ninput ReceivedSacdsFilter {\n  scope: SacdScope\n  \"Filter for SACDs that expire after this time. Defaults to now.\"\n  expiresAfter: Time\n  expiresBefore: Time\n  templateId: BigInt\n}\n\ntype RedirectURI { uri: String!, enabledAt: Time!, disabledAt: Time }\n\ntype Sacd { grantee: Address!, permissions: String!, permissionList: [Permission!]!, source: String!, createdAt: Time!, expiresAt: Time!, template: Template, scope: SacdScope!, document: PermissionDocument }\n\ntype SacdHistoryEntry { cause: String!, grantee: Address!, permissions: String, privilegeId: Int, source: String, templateId: BigInt, expiresAt: Time!, timestamp: Time!, blockNumber: Int, transactionHash: Bytes }\n\nenum SacdScope { VEHICLE, ACCOUNT, CONNECTION }\n\ntype Signer { address: Address!, enabledAt: Time!, disabledAt: Time }\n\ntype Stake { tokenId: Int!, tokenDID: String!, owner: Address!, ownerAccount: Account!, level: Int!, points: Int!, amount: BigDecimal!, stakedAt: Time!, endsAt: Time!, withdrawnAt: Time, vehicle: Vehicle }\n\ninput StakeFilterBy {\n  owner: Address\n  \"Filter stakes based on attachability. A stake is considered attachable if it is not presently attached to a vehicle and has not yet ended.\"\n  attachable: Boolean\n}\n\ntype StorageNode { label: String!, address: Address!, owner: Address!, ownerAccount: Account!, tokenId: BigInt!, uri: String!, tokenDID: String!, mintedAt: Time! }\n\ntype SyntheticDevice implements Node { id: ID!, name: String!, tokenId: Int!, tokenDID: String!, address: Address!, mintedAt: Time!, vehicle: Vehicle!, connection: Connection! }\n\ninput SyntheticDeviceBy @oneOf {\n  tokenId: Int\n  tokenDID: String\n  \"The Ethereum address for the synthetic device.\"\n  address: Address\n}\n\ninput SyntheticDevicesFilter { owner: Address, integrationId: Int }\n\ntype Template { tokenId: BigInt!, creator: Address!, asset: Address!, permissions: String!, permissionList: [Permission!]!, cid: String!, createdAt: Time!, document: PermissionDocument }\n\ninput TemplateBy { tokenId: BigInt, cid: String }\n\ntype UserRewards { totalTokens: BigDecimal!, history(first: Int, after: String, last: Int, before: String): EarningsConnection! }\n\ntype Vehicle implements Node { id: ID!, tokenId: Int!, tokenDID: String!, manufacturer: Manufacturer!, owner: Address!, ownerAccount: Account!, mintedAt: Time!, aftermarketDevice: AftermarketDevice, privileges(first: Int, after: String, last: Int, before: String, filterBy: PrivilegeFilterBy): PrivilegesConnection!, sacds(first: Int, after: String, last: Int, before: String): SacdConnection!, sacd(grantee: Address!): Sacd, sacdHistory(grantee: Address, first: Int, after: String, last: Int, before: String): SacdHistoryEntryConnection!, syntheticDevice: SyntheticDevice, definition: Definition, dcn: DCN, name: String!, imageURI: String!, earnings: VehicleEarnings, dataURI: String!, stake: Stake, storageNode: StorageNode, ownershipHistory(first: Int, after: String, last: Int, before: String): VehicleTransferConnection!, aftermarketDeviceHistory(first: Int, after: String, last: Int, before: String): AftermarketDeviceEventConnection!, burnedAt: Time, burnTransactionHash: Bytes }\n\ntype VehicleAccess { vehicleTokenId: Int!, grantee: Address!, atTime: Time!, isOwner: Boolean!, permissions: [PermissionName!]!, grants: [PermissionAccess!]!, expiresAt: Time }\n\ntype VehicleEarnings { totalTokens: BigDecimal!, history(first: Int, after: String, last: Int, before: String): EarningsConnection! }\n\ntype VehicleTransfer { from: Address!, to: Address!, blockNumber: Int!, blockTimestamp: Time!, transactionHash: Bytes! }\n\ninput VehiclesFilter {\n  \"Privileged filters for vehicles to which the given address has access. This includes vehicles that this address owns, and those covered by account SACDs that their owners granted it.\"\n  privileged: Address\n  \"Filter for vehicles on which the address holds all of the given permissions, through ownership, privileges, vehicle SACDs or account SACDs.\"\n  privilegedWith: PrivilegedWithFilter\n  owner: Address\n  \"Make filters for vehicles that are of the given make.\"\n  make: String\n  \"Model filters for vehicles that are of the given model.\"\n  model: String\n  \"Year filters for vehicles that are of the given year.\"\n  year: Int\n  \"Filter for vehicles produced by a particular manufacturer, specified by manufacturer token id.\"\n  manufacturerTokenId: Int\n  deviceDefinitionId: String\n}\n"
@@ -41,19 +41,34 @@ func (m *MockDCNRepository) EXPECT() *MockDCNRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetBurnedDCNs mocks base method.
+func (m *MockDCNRepository) GetBurnedDCNs(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.DCNFilter) (*model.DCNConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurnedDCNs", ctx, first, after, last, before, filterBy)
+	ret0, _ := ret[0].(*model.DCNConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurnedDCNs indicates an expected call of GetBurnedDCNs.
+func (mr *MockDCNRepositoryMockRecorder) GetBurnedDCNs(ctx, first, after, last, before, filterBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurnedDCNs", reflect.TypeOf((*MockDCNRepository)(nil).GetBurnedDCNs), ctx, first, after, last, before, filterBy)
+}
+
 // GetDCN mocks base method.
-func (m *MockDCNRepository) GetDCN(ctx context.Context, by model.DCNBy) (*model.Dcn, error) {
+func (m *MockDCNRepository) GetDCN(ctx context.Context, by model.DCNBy, includeBurned bool) (*model.Dcn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDCN", ctx, by)
+	ret := m.ctrl.Call(m, "GetDCN", ctx, by, includeBurned)
 	ret0, _ := ret[0].(*model.Dcn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDCN indicates an expected call of GetDCN.
-func (mr *MockDCNRepositoryMockRecorder) GetDCN(ctx, by any) *gomock.Call {
+func (mr *MockDCNRepositoryMockRecorder) GetDCN(ctx, by, includeBurned any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDCN", reflect.TypeOf((*MockDCNRepository)(nil).GetDCN), ctx, by)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDCN", reflect.TypeOf((*MockDCNRepository)(nil).GetDCN), ctx, by, includeBurned)
 }
 
 // GetDCNByName mocks base method.
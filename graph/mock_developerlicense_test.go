@@ -12,6 +12,7 @@ package graph
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/DIMO-Network/identity-api/graph/model"
 	gomock "go.uber.org/mock/gomock"
@@ -72,31 +73,46 @@ func (mr *MockDeveloperLicenseRepositoryMockRecorder) GetLicense(ctx, by any) *g
 }
 
 // GetRedirectURIsForLicense mocks base method.
-func (m *MockDeveloperLicenseRepository) GetRedirectURIsForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.RedirectURIConnection, error) {
+func (m *MockDeveloperLicenseRepository) GetRedirectURIsForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*model.RedirectURIConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRedirectURIsForLicense", ctx, obj, first, after, last, before)
+	ret := m.ctrl.Call(m, "GetRedirectURIsForLicense", ctx, obj, first, after, last, before, includeDisabled, activeAt)
 	ret0, _ := ret[0].(*model.RedirectURIConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRedirectURIsForLicense indicates an expected call of GetRedirectURIsForLicense.
-func (mr *MockDeveloperLicenseRepositoryMockRecorder) GetRedirectURIsForLicense(ctx, obj, first, after, last, before any) *gomock.Call {
+func (mr *MockDeveloperLicenseRepositoryMockRecorder) GetRedirectURIsForLicense(ctx, obj, first, after, last, before, includeDisabled, activeAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirectURIsForLicense", reflect.TypeOf((*MockDeveloperLicenseRepository)(nil).GetRedirectURIsForLicense), ctx, obj, first, after, last, before)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirectURIsForLicense", reflect.TypeOf((*MockDeveloperLicenseRepository)(nil).GetRedirectURIsForLicense), ctx, obj, first, after, last, before, includeDisabled, activeAt)
 }
 
 // GetSignersForLicense mocks base method.
-func (m *MockDeveloperLicenseRepository) GetSignersForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.SignerConnection, error) {
+func (m *MockDeveloperLicenseRepository) GetSignersForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*model.SignerConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSignersForLicense", ctx, obj, first, after, last, before)
+	ret := m.ctrl.Call(m, "GetSignersForLicense", ctx, obj, first, after, last, before, includeDisabled, activeAt)
 	ret0, _ := ret[0].(*model.SignerConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSignersForLicense indicates an expected call of GetSignersForLicense.
-func (mr *MockDeveloperLicenseRepositoryMockRecorder) GetSignersForLicense(ctx, obj, first, after, last, before any) *gomock.Call {
+func (mr *MockDeveloperLicenseRepositoryMockRecorder) GetSignersForLicense(ctx, obj, first, after, last, before, includeDisabled, activeAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignersForLicense", reflect.TypeOf((*MockDeveloperLicenseRepository)(nil).GetSignersForLicense), ctx, obj, first, after, last, before)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignersForLicense", reflect.TypeOf((*MockDeveloperLicenseRepository)(nil).GetSignersForLicense), ctx, obj, first, after, last, before, includeDisabled, activeAt)
+}
+
+// GetTransfersForLicense mocks base method.
+func (m *MockDeveloperLicenseRepository) GetTransfersForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.DeveloperLicenseTransferConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfersForLicense", ctx, obj, first, after, last, before)
+	ret0, _ := ret[0].(*model.DeveloperLicenseTransferConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfersForLicense indicates an expected call of GetTransfersForLicense.
+func (mr *MockDeveloperLicenseRepositoryMockRecorder) GetTransfersForLicense(ctx, obj, first, after, last, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfersForLicense", reflect.TypeOf((*MockDeveloperLicenseRepository)(nil).GetTransfersForLicense), ctx, obj, first, after, last, before)
 }
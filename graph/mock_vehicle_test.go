@@ -41,19 +41,34 @@ func (m *MockVehicleRepository) EXPECT() *MockVehicleRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetBurnedVehicles mocks base method.
+func (m *MockVehicleRepository) GetBurnedVehicles(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.VehiclesFilter) (*model.VehicleConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurnedVehicles", ctx, first, after, last, before, filterBy)
+	ret0, _ := ret[0].(*model.VehicleConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurnedVehicles indicates an expected call of GetBurnedVehicles.
+func (mr *MockVehicleRepositoryMockRecorder) GetBurnedVehicles(ctx, first, after, last, before, filterBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurnedVehicles", reflect.TypeOf((*MockVehicleRepository)(nil).GetBurnedVehicles), ctx, first, after, last, before, filterBy)
+}
+
 // GetVehicle mocks base method.
-func (m *MockVehicleRepository) GetVehicle(ctx context.Context, tokenID *int, tokenDID *string) (*model.Vehicle, error) {
+func (m *MockVehicleRepository) GetVehicle(ctx context.Context, tokenID *int, tokenDID *string, includeBurned bool) (*model.Vehicle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVehicle", ctx, tokenID, tokenDID)
+	ret := m.ctrl.Call(m, "GetVehicle", ctx, tokenID, tokenDID, includeBurned)
 	ret0, _ := ret[0].(*model.Vehicle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVehicle indicates an expected call of GetVehicle.
-func (mr *MockVehicleRepositoryMockRecorder) GetVehicle(ctx, tokenID, tokenDID any) *gomock.Call {
+func (mr *MockVehicleRepositoryMockRecorder) GetVehicle(ctx, tokenID, tokenDID, includeBurned any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicle", reflect.TypeOf((*MockVehicleRepository)(nil).GetVehicle), ctx, tokenID, tokenDID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicle", reflect.TypeOf((*MockVehicleRepository)(nil).GetVehicle), ctx, tokenID, tokenDID, includeBurned)
 }
 
 // GetVehicles mocks base method.
@@ -3,7 +3,11 @@
 package model
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/ericlagergren/decimal"
@@ -20,12 +24,56 @@ type Account struct {
 	Address common.Address `json:"address"`
 	// Lists active account SACDs granted by this account.
 	Sacds *SacdConnection `json:"sacds"`
+	// A Relay-style connection listing every change to the permission grants on this account, including
+	// renounced and expired ones, ordered from most to least recent.
+	SacdHistory *SacdHistoryEntryConnection `json:"sacdHistory"`
+	// Lists the SACDs granted to this account, on vehicles, by other accounts and on connections,
+	// ordered from most to least recently set. Only active grants are listed unless expiresAfter is
+	// given.
+	ReceivedSacds *ReceivedSacdConnection `json:"receivedSacds"`
+	// Lists the legacy vehicle privileges held by this account, ordered from most to least recently
+	// set. Only active privileges are listed unless expiresAfter is given.
+	ReceivedPrivileges *ReceivedPrivilegeConnection `json:"receivedPrivileges"`
+	// Lists the vehicles owned by this account, ordered by token id descending.
+	Vehicles *VehicleConnection `json:"vehicles"`
+	// Lists the aftermarket devices owned by this account, ordered by token id descending.
+	AftermarketDevices *AftermarketDeviceConnection `json:"aftermarketDevices"`
+	// Lists the synthetic devices attached to vehicles owned by this account, ordered by token id
+	// descending.
+	SyntheticDevices *SyntheticDeviceConnection `json:"syntheticDevices"`
+	// Lists the DCNs owned by this account, most recently minted first.
+	Dcns *DCNConnection `json:"dcns"`
+	// Lists the stakes owned by this account, ordered by token id descending.
+	Stakes *StakeConnection `json:"stakes"`
+	// Lists the developer licenses owned by this account, ordered by token id descending.
+	DeveloperLicenses *DeveloperLicenseConnection `json:"developerLicenses"`
+	// Lists the connections owned by this account, most recently minted first.
+	Connections *ConnectionConnection `json:"connections"`
+	// Lists the storage nodes owned by this account, most recently minted first.
+	StorageNodes *StorageNodeConnection `json:"storageNodes"`
+	// The rewards earned by this account.
+	Rewards *UserRewards `json:"rewards,omitempty"`
+	// The number of assets of each type held by this account.
+	Counts *AccountCounts `json:"counts"`
 }
 
 type AccountBy struct {
 	Address *common.Address `json:"address,omitempty"`
 }
 
+// The number of assets of each type held by an account. Each count matches the totalCount of the
+// corresponding connection on Account.
+type AccountCounts struct {
+	Vehicles           int `json:"vehicles"`
+	AftermarketDevices int `json:"aftermarketDevices"`
+	SyntheticDevices   int `json:"syntheticDevices"`
+	Dcns               int `json:"dcns"`
+	Stakes             int `json:"stakes"`
+	DeveloperLicenses  int `json:"developerLicenses"`
+	Connections        int `json:"connections"`
+	StorageNodes       int `json:"storageNodes"`
+}
+
 type AftermarketDevice struct {
 	// An opaque global identifier for this aftermarket device.
 	ID string `json:"id"`
@@ -39,6 +87,9 @@ type AftermarketDevice struct {
 	Address common.Address `json:"address"`
 	// The Ethereum address of the owner of the device.
 	Owner common.Address `json:"owner"`
+	// The account of `owner`. A device can be owned by someone other than the owner of the vehicle
+	// it's paired with.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// The serial number on the side of the device. For AutoPis this is a UUID; for Macarons it is
 	// a long decimal number.
 	Serial *string `json:"serial,omitempty"`
@@ -57,6 +108,8 @@ type AftermarketDevice struct {
 	Vehicle *Vehicle `json:"vehicle,omitempty"`
 	// The beneficiary for this device, who receives any associated rewards. Defaults to the owner.
 	Beneficiary common.Address `json:"beneficiary"`
+	// The account of the beneficiary.
+	BeneficiaryAccount *Account `json:"beneficiaryAccount"`
 	// Encoded name of the device
 	Name string `json:"name"`
 	// The Image Url of the device
@@ -64,9 +117,20 @@ type AftermarketDevice struct {
 	// The earnings attached to the aftermarket device
 	Earnings *AftermarketDeviceEarnings `json:"earnings,omitempty"`
 	// The block timestamp at which this device was paired, if it is presently paired.
-	PairedAt       *time.Time `json:"pairedAt,omitempty"`
-	ManufacturerID int        `json:"-"`
-	VehicleID      *int       `json:"-"`
+	PairedAt *time.Time `json:"pairedAt,omitempty"`
+	// A Relay-style connection listing every claim, unclaim, pairing, unpairing and address reset
+	// of this device, ordered from most to least recent.
+	History *AftermarketDeviceEventConnection `json:"history"`
+	// A Relay-style connection listing every change to the beneficiary of this device, ordered from
+	// most to least recent. Besides BeneficiarySet events this includes the mint and each transfer,
+	// since both reset the beneficiary to the owner.
+	BeneficiaryHistory *BeneficiaryChangeConnection `json:"beneficiaryHistory"`
+	// The beneficiary in effect at the given time. Null if the device had no recorded beneficiary
+	// change at or before that time, which is the case for times before the device was minted and
+	// for older devices whose history begins after that time.
+	BeneficiaryAt  *common.Address `json:"beneficiaryAt,omitempty"`
+	ManufacturerID int             `json:"-"`
+	VehicleID      *int            `json:"-"`
 }
 
 func (AftermarketDevice) IsNode()            {}
@@ -108,6 +172,40 @@ type AftermarketDeviceEdge struct {
 	Node   *AftermarketDevice `json:"node"`
 }
 
+// A change to the claim, pairing or address of an aftermarket device.
+type AftermarketDeviceEvent struct {
+	// The name of the contract event: one of AftermarketDeviceClaimed, AftermarketDeviceUnclaimed,
+	// AftermarketDevicePaired, AftermarketDeviceUnpaired or AftermarketDeviceAddressReset.
+	EventName string `json:"eventName"`
+	// The token id of the aftermarket device.
+	AftermarketDeviceTokenID int `json:"aftermarketDeviceTokenId"`
+	// The token id of the vehicle the device was paired to or unpaired from. Only set for pairings
+	// and unpairings.
+	VehicleTokenID *int `json:"vehicleTokenId,omitempty"`
+	// The owner named in the event. Not set for address resets.
+	Owner *common.Address `json:"owner,omitempty"`
+	// The new address of the device. Only set for address resets.
+	Address *common.Address `json:"address,omitempty"`
+	// The number of the block containing the event.
+	BlockNumber int `json:"blockNumber"`
+	// The timestamp of the block containing the event.
+	BlockTimestamp time.Time `json:"blockTimestamp"`
+	// The hash of the transaction containing the event.
+	TransactionHash []byte `json:"transactionHash"`
+}
+
+type AftermarketDeviceEventConnection struct {
+	TotalCount int                           `json:"totalCount"`
+	Edges      []*AftermarketDeviceEventEdge `json:"edges"`
+	Nodes      []*AftermarketDeviceEvent     `json:"nodes"`
+	PageInfo   *PageInfo                     `json:"pageInfo"`
+}
+
+type AftermarketDeviceEventEdge struct {
+	Node   *AftermarketDeviceEvent `json:"node"`
+	Cursor string                  `json:"cursor"`
+}
+
 // The AftermarketDevicesFilter input is used to specify filtering criteria for querying aftermarket devices.
 // Aftermarket devices must match all of the specified criteria.
 type AftermarketDevicesFilter struct {
@@ -117,6 +215,42 @@ type AftermarketDevicesFilter struct {
 	ManufacturerID *int            `json:"manufacturerId,omitempty"`
 }
 
+// A change to the beneficiary of an aftermarket device.
+type BeneficiaryChange struct {
+	// The name of the contract event: one of AftermarketDeviceNodeMinted, BeneficiarySet or
+	// Transfer.
+	EventName string `json:"eventName"`
+	// The beneficiary after the event. When no beneficiary is set explicitly this is the owner.
+	Beneficiary common.Address `json:"beneficiary"`
+	// Whether the beneficiary was set explicitly. False for mints, transfers and BeneficiarySet
+	// events that clear the beneficiary.
+	Explicit bool `json:"explicit"`
+	// The number of the block containing the event.
+	BlockNumber int `json:"blockNumber"`
+	// The timestamp of the block containing the event.
+	BlockTimestamp time.Time `json:"blockTimestamp"`
+	// The hash of the transaction containing the event.
+	TransactionHash []byte `json:"transactionHash"`
+}
+
+type BeneficiaryChangeConnection struct {
+	TotalCount int                      `json:"totalCount"`
+	Edges      []*BeneficiaryChangeEdge `json:"edges"`
+	Nodes      []*BeneficiaryChange     `json:"nodes"`
+	PageInfo   *PageInfo                `json:"pageInfo"`
+}
+
+type BeneficiaryChangeEdge struct {
+	Node   *BeneficiaryChange `json:"node"`
+	Cursor string             `json:"cursor"`
+}
+
+// An inclusive range of block numbers. Either end may be omitted.
+type BlockRange struct {
+	From *int `json:"from,omitempty"`
+	To   *int `json:"to,omitempty"`
+}
+
 type Connection struct {
 	// The name of the connection. This can be at most 32 bytes long.
 	Name string `json:"name"`
@@ -124,6 +258,8 @@ type Connection struct {
 	Address common.Address `json:"address"`
 	// The owner of the connection. Connections are transferable, so this may change over time.
 	Owner common.Address `json:"owner"`
+	// The account that owns this connection.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// The token id of the connection as an NFT. This tends to be very large.
 	TokenID *big.Int `json:"tokenId"`
 	// The DID for this connection's token ID in the format did:erc721:<chainID>:<contractAddress>:<tokenId>
@@ -131,8 +267,11 @@ type Connection struct {
 	// The block timestamp for the mint of the connection.
 	MintedAt time.Time `json:"mintedAt"`
 	// A Relay-style connection listing any active permission grants on this connection.
-	Sacds         *SacdConnection `json:"sacds"`
-	IntegrationID *int            `json:"-"`
+	Sacds *SacdConnection `json:"sacds"`
+	// A Relay-style connection listing every change to the permission grants on this connection, including
+	// renounced and expired ones, ordered from most to least recent.
+	SacdHistory   *SacdHistoryEntryConnection `json:"sacdHistory"`
+	IntegrationID *int                        `json:"-"`
 }
 
 type ConnectionBy struct {
@@ -155,6 +294,47 @@ type ConnectionEdge struct {
 	Cursor string      `json:"cursor"`
 }
 
+// A contract event, exactly as it was received.
+type ContractEvent struct {
+	ChainID int `json:"chainId"`
+	// The address of the contract that emitted the event.
+	Contract  common.Address `json:"contract"`
+	EventName string         `json:"eventName"`
+	// The hash of the event signature; that is, the first topic of the log.
+	EventSignature  []byte    `json:"eventSignature"`
+	TransactionHash []byte    `json:"transactionHash"`
+	BlockNumber     int       `json:"blockNumber"`
+	BlockHash       []byte    `json:"blockHash"`
+	BlockTimestamp  time.Time `json:"blockTimestamp"`
+	// The decoded event arguments, as a JSON object.
+	Arguments string `json:"arguments"`
+}
+
+type ContractEventConnection struct {
+	TotalCount int                  `json:"totalCount"`
+	Edges      []*ContractEventEdge `json:"edges"`
+	Nodes      []*ContractEvent     `json:"nodes"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+}
+
+type ContractEventEdge struct {
+	Node   *ContractEvent `json:"node"`
+	Cursor string         `json:"cursor"`
+}
+
+type ContractEventsFilter struct {
+	// Filter for events emitted by the contract at this address.
+	Contract *common.Address `json:"contract,omitempty"`
+	// Filter for events with this name, e.g., "Transfer".
+	EventName *string `json:"eventName,omitempty"`
+	// Filter for events emitted in the transaction with this hash.
+	TxHash []byte `json:"txHash,omitempty"`
+	// Filter for events emitted in the given range of blocks.
+	BlockRange *BlockRange `json:"blockRange,omitempty"`
+	// Filter for events with a `tokenId` argument equal to this value.
+	TokenID *big.Int `json:"tokenId,omitempty"`
+}
+
 // Represents a DIMO Canonical Name. This is a unique identifier for a vehicle.
 type Dcn struct {
 	// An opaque global identifier for this DCN.
@@ -165,8 +345,10 @@ type Dcn struct {
 	TokenID *big.Int `json:"tokenId"`
 	// The DID for this DCN's token ID in the format did:erc721:<chainID>:<contractAddress>:<tokenId>
 	TokenDID string `json:"tokenDID"`
-	// Ethereum address of domain owner.
+	// Ethereum address of domain owner. For a burned domain, this is the last owner.
 	Owner common.Address `json:"owner"`
+	// The account that holds this name. This isn't necessarily the owner of the vehicle it resolves to.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// The block timestamp at which the domain will cease to be valid.
 	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 	// The block timestamp at which the domain was created.
@@ -174,8 +356,15 @@ type Dcn struct {
 	// Human readable name, if any, for the domain; for example, "reddy.dimo".
 	Name *string `json:"name,omitempty"`
 	// Vehicle, if any, to which the domain is attached.
-	Vehicle   *Vehicle `json:"vehicle,omitempty"`
-	VehicleID *int     `json:"-"`
+	Vehicle *Vehicle `json:"vehicle,omitempty"`
+	// A Relay-style connection listing every transfer of this domain, including the mint, ordered
+	// from most to least recent.
+	OwnershipHistory *DCNTransferConnection `json:"ownershipHistory"`
+	// The block timestamp at which this domain was burned, if it has been.
+	BurnedAt *time.Time `json:"burnedAt,omitempty"`
+	// The hash of the transaction that burned this domain, if it has been burned.
+	BurnTransactionHash []byte `json:"burnTransactionHash,omitempty"`
+	VehicleID           *int   `json:"-"`
 }
 
 func (Dcn) IsNode()            {}
@@ -215,6 +404,32 @@ type DCNFilter struct {
 	Owner *common.Address `json:"owner,omitempty"`
 }
 
+// A single transfer of a DCN NFT.
+type DCNTransfer struct {
+	// The address that held the domain before the transfer. This is the zero address for a mint.
+	From common.Address `json:"from"`
+	// The address that held the domain after the transfer. This is the zero address for a burn.
+	To common.Address `json:"to"`
+	// The number of the block containing the transfer.
+	BlockNumber int `json:"blockNumber"`
+	// The timestamp of the block containing the transfer.
+	BlockTimestamp time.Time `json:"blockTimestamp"`
+	// The hash of the transaction containing the transfer.
+	TransactionHash []byte `json:"transactionHash"`
+}
+
+type DCNTransferConnection struct {
+	TotalCount int                `json:"totalCount"`
+	Edges      []*DCNTransferEdge `json:"edges"`
+	Nodes      []*DCNTransfer     `json:"nodes"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+}
+
+type DCNTransferEdge struct {
+	Node   *DCNTransfer `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
 type Definition struct {
 	ID    *string `json:"id,omitempty"`
 	Make  *string `json:"make,omitempty"`
@@ -229,14 +444,21 @@ type DeveloperLicense struct {
 	TokenDID string `json:"tokenDID"`
 	// The owner of the license. A single owner can own multiple licenses.
 	Owner common.Address `json:"owner"`
+	// The account that owns this license and manages its signers and redirect URIs.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// Serves as the client id for OAuth as well as the address of the associated contract.
 	ClientID common.Address `json:"clientId"`
 	// A human-readable alias for this license. Unique among all licenses if present.
 	Alias *string `json:"alias,omitempty"`
 	// The block timestamp for the transaction that minted this license.
-	MintedAt     time.Time              `json:"mintedAt"`
-	Signers      *SignerConnection      `json:"signers"`
+	MintedAt time.Time `json:"mintedAt"`
+	// The license's signers. By default only those enabled now are listed.
+	Signers *SignerConnection `json:"signers"`
+	// The license's redirect URIs. By default only those enabled now are listed.
 	RedirectURIs *RedirectURIConnection `json:"redirectURIs"`
+	// A Relay-style connection listing every transfer of this license, including the mint, ordered
+	// from most to least recent.
+	OwnershipHistory *DeveloperLicenseTransferConnection `json:"ownershipHistory"`
 }
 
 type DeveloperLicenseBy struct {
@@ -264,6 +486,32 @@ type DeveloperLicenseFilterBy struct {
 	Owner  *common.Address `json:"owner,omitempty"`
 }
 
+// A single transfer of a developer license NFT.
+type DeveloperLicenseTransfer struct {
+	// The address that held the license before the transfer. This is the zero address for a mint.
+	From common.Address `json:"from"`
+	// The address that held the license after the transfer. This is the zero address for a burn.
+	To common.Address `json:"to"`
+	// The number of the block containing the transfer.
+	BlockNumber int `json:"blockNumber"`
+	// The timestamp of the block containing the transfer.
+	BlockTimestamp time.Time `json:"blockTimestamp"`
+	// The hash of the transaction containing the transfer.
+	TransactionHash []byte `json:"transactionHash"`
+}
+
+type DeveloperLicenseTransferConnection struct {
+	TotalCount int                             `json:"totalCount"`
+	Edges      []*DeveloperLicenseTransferEdge `json:"edges"`
+	Nodes      []*DeveloperLicenseTransfer     `json:"nodes"`
+	PageInfo   *PageInfo                       `json:"pageInfo"`
+}
+
+type DeveloperLicenseTransferEdge struct {
+	Node   *DeveloperLicenseTransfer `json:"node"`
+	Cursor string                    `json:"cursor"`
+}
+
 // Represents a Device Definition.
 type DeviceDefinition struct {
 	// Device definition id for this device definition.
@@ -375,6 +623,8 @@ type Manufacturer struct {
 	Name string `json:"name"`
 	// The Ethereum address of the owner of this manufacturer.
 	Owner common.Address `json:"owner"`
+	// The account that holds this manufacturer's node.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// Id of the Tableland table holding the manufacturer's device definitions.
 	TableID *int `json:"tableId,omitempty"`
 	// The block timestamp at which this manufacturer was minted.
@@ -417,6 +667,73 @@ type PageInfo struct {
 	HasNextPage     bool    `json:"hasNextPage"`
 }
 
+// An entry in the permission catalog.
+type Permission struct {
+	Name PermissionName `json:"name"`
+	// The id of the legacy privilege that grants this permission.
+	PrivilegeID int `json:"privilegeId"`
+	// The position of the lower of the pair of bits that this permission occupies in a SACD
+	// permission mask. It is granted only when both bits are set.
+	SacdBit     int    `json:"sacdBit"`
+	Description string `json:"description"`
+}
+
+type PermissionAccess struct {
+	Permission PermissionName `json:"permission"`
+	// When the permission lapses: the latest expiration among its sources. Null if held through
+	// ownership.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Every grant that carries the permission.
+	Sources []*PermissionSource `json:"sources"`
+}
+
+type PermissionAgreement struct {
+	Type string `json:"type"`
+	// The DID of the asset the agreement covers.
+	Asset *string `json:"asset,omitempty"`
+	// The permission names, as written in the document.
+	Permissions []string `json:"permissions"`
+}
+
+// A signed agreement document behind a SACD or template, fetched from IPFS.
+type PermissionDocument struct {
+	// The IPFS URI the document was fetched from.
+	URI string `json:"uri"`
+	// The document type, as declared by the document.
+	Type        *string                `json:"type,omitempty"`
+	Grantor     *common.Address        `json:"grantor,omitempty"`
+	Grantee     *common.Address        `json:"grantee,omitempty"`
+	EffectiveAt *time.Time             `json:"effectiveAt,omitempty"`
+	ExpiresAt   *time.Time             `json:"expiresAt,omitempty"`
+	Agreements  []*PermissionAgreement `json:"agreements"`
+	Signature   []byte                 `json:"signature,omitempty"`
+	// Whether the signature is a personal_sign of the document's data by its grantor.
+	SignatureValid bool `json:"signatureValid"`
+	// The fields in which the document disagrees with the grant recorded on-chain. Empty if the two
+	// agree.
+	Mismatches []*PermissionDocumentMismatch `json:"mismatches"`
+}
+
+type PermissionDocumentMismatch struct {
+	Field PermissionDocumentField `json:"field"`
+	// The value in the document.
+	Document *string `json:"document,omitempty"`
+	// The value recorded on-chain.
+	OnChain *string `json:"onChain,omitempty"`
+}
+
+type PermissionSource struct {
+	Type PermissionSourceType `json:"type"`
+	// The id of the legacy privilege. Only set for PRIVILEGE.
+	PrivilegeID *int `json:"privilegeId,omitempty"`
+	// The token id of the template the SACD was created from, if any.
+	TemplateID *big.Int `json:"templateId,omitempty"`
+	// The source document of the SACD. Only set for SACDs.
+	SacdSource *string `json:"sacdSource,omitempty"`
+	// When the grant expires. Null for OWNERSHIP.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
 type Privilege struct {
 	// The id of the privilege.
 	ID int `json:"id"`
@@ -426,6 +743,8 @@ type Privilege struct {
 	SetAt time.Time `json:"setAt"`
 	// The block timestamp at which the privilege expires.
 	ExpiresAt time.Time `json:"expiresAt"`
+	// The permission that the privilege grants, or an empty list if the id isn't in the catalog.
+	PermissionList []*Permission `json:"permissionList"`
 }
 
 type PrivilegeEdge struct {
@@ -438,6 +757,12 @@ type PrivilegeFilterBy struct {
 	PrivilegeID *int            `json:"privilegeId,omitempty"`
 }
 
+type PrivilegedWithFilter struct {
+	Address common.Address `json:"address"`
+	// The permissions that must all be held. Must not be empty.
+	Permissions []PermissionName `json:"permissions"`
+}
+
 // The Connection type for Privileges.
 type PrivilegesConnection struct {
 	TotalCount int              `json:"totalCount"`
@@ -450,9 +775,73 @@ type PrivilegesConnection struct {
 type Query struct {
 }
 
+// A legacy privilege held by an account, together with the vehicle it was set on.
+type ReceivedPrivilege struct {
+	VehicleTokenID int        `json:"vehicleTokenId"`
+	Privilege      *Privilege `json:"privilege"`
+}
+
+type ReceivedPrivilegeConnection struct {
+	TotalCount int                      `json:"totalCount"`
+	Edges      []*ReceivedPrivilegeEdge `json:"edges"`
+	Nodes      []*ReceivedPrivilege     `json:"nodes"`
+	PageInfo   *PageInfo                `json:"pageInfo"`
+}
+
+type ReceivedPrivilegeEdge struct {
+	Node   *ReceivedPrivilege `json:"node"`
+	Cursor string             `json:"cursor"`
+}
+
+type ReceivedPrivilegesFilter struct {
+	// Filter for privileges that expire after this time. Defaults to now.
+	ExpiresAfter *time.Time `json:"expiresAfter,omitempty"`
+	// Filter for privileges that expire before this time.
+	ExpiresBefore *time.Time `json:"expiresBefore,omitempty"`
+	// Filter for privileges with this id.
+	PrivilegeID *int `json:"privilegeId,omitempty"`
+}
+
+// A SACD granted to an account, together with the asset it was granted on.
+type ReceivedSacd struct {
+	Scope SacdScope `json:"scope"`
+	// The vehicle the SACD was granted on. Only set for VEHICLE.
+	VehicleTokenID *int `json:"vehicleTokenId,omitempty"`
+	// The account that granted the SACD, covering all of its vehicles. Only set for ACCOUNT.
+	Account *common.Address `json:"account,omitempty"`
+	// The connection the SACD was granted on. Only set for CONNECTION.
+	ConnectionTokenID *big.Int `json:"connectionTokenId,omitempty"`
+	Sacd              *Sacd    `json:"sacd"`
+}
+
+type ReceivedSacdConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	Edges      []*ReceivedSacdEdge `json:"edges"`
+	Nodes      []*ReceivedSacd     `json:"nodes"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+}
+
+type ReceivedSacdEdge struct {
+	Node   *ReceivedSacd `json:"node"`
+	Cursor string        `json:"cursor"`
+}
+
+type ReceivedSacdsFilter struct {
+	// Filter for SACDs granted on this kind of asset.
+	Scope *SacdScope `json:"scope,omitempty"`
+	// Filter for SACDs that expire after this time. Defaults to now.
+	ExpiresAfter *time.Time `json:"expiresAfter,omitempty"`
+	// Filter for SACDs that expire before this time.
+	ExpiresBefore *time.Time `json:"expiresBefore,omitempty"`
+	// Filter for SACDs created from the template with this token id.
+	TemplateID *big.Int `json:"templateId,omitempty"`
+}
+
 type RedirectURI struct {
 	URI       string    `json:"uri"`
 	EnabledAt time.Time `json:"enabledAt"`
+	// When the redirect URI was disabled, if it has been.
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
 }
 
 type RedirectURIConnection struct {
@@ -472,6 +861,8 @@ type Sacd struct {
 	Grantee common.Address `json:"grantee"`
 	// Hex string of permissions
 	Permissions string `json:"permissions"`
+	// The permissions granted, decoded from the permission mask.
+	PermissionList []*Permission `json:"permissionList"`
 	// Permission source
 	Source string `json:"source"`
 	// The block timestamp at which this permission was set.
@@ -480,6 +871,15 @@ type Sacd struct {
 	ExpiresAt time.Time `json:"expiresAt"`
 	// The template used for this permission grant, if any
 	Template *Template `json:"template,omitempty"`
+	// What the permission was granted on. Among the SACDs of a vehicle, ACCOUNT marks a grant made by
+	// the owner's account, which covers all of its vehicles.
+	Scope SacdScope `json:"scope"`
+	// The agreement document that the source points to. Null if the source isn't an IPFS URI or no
+	// IPFS gateway is configured.
+	Document     *PermissionDocument `json:"document,omitempty"`
+	Account      []byte              `json:"-"`
+	ConnectionID []byte              `json:"-"`
+	VehicleID    *int                `json:"-"`
 }
 
 // The Connection type for Sacds.
@@ -495,9 +895,51 @@ type SacdEdge struct {
 	Cursor string `json:"cursor"`
 }
 
+// A change to a permission grant on a vehicle, account or connection. This covers SACDs and, for
+// vehicles, legacy privileges.
+type SacdHistoryEntry struct {
+	// Why the entry was recorded: "set" for a new or updated grant, "renounced" when the grantee
+	// gave it up, "transfer" when it was dropped because the vehicle changed hands or was burned,
+	// and "expired" when it lapsed. A lapse is only recorded once the grant is next set or removed.
+	Cause string `json:"cause"`
+	// Recipient of the permission grant.
+	Grantee common.Address `json:"grantee"`
+	// Hex string of the permissions in the grant. Null for legacy privileges.
+	Permissions *string `json:"permissions,omitempty"`
+	// The legacy privilege that was granted. Null for SACDs.
+	PrivilegeID *int `json:"privilegeId,omitempty"`
+	// Permission source. Null for legacy privileges.
+	Source *string `json:"source,omitempty"`
+	// The token id of the template used for the grant, if any.
+	TemplateID *big.Int `json:"templateId,omitempty"`
+	// The time at which the grant expires or expired.
+	ExpiresAt time.Time `json:"expiresAt"`
+	// When the change took effect. This is the block timestamp, except for lapses, where it is the
+	// expiration time of the grant.
+	Timestamp time.Time `json:"timestamp"`
+	// The number of the block containing the event. Null for lapses.
+	BlockNumber *int `json:"blockNumber,omitempty"`
+	// The hash of the transaction containing the event. Null for lapses.
+	TransactionHash []byte `json:"transactionHash,omitempty"`
+}
+
+type SacdHistoryEntryConnection struct {
+	TotalCount int                     `json:"totalCount"`
+	Edges      []*SacdHistoryEntryEdge `json:"edges"`
+	Nodes      []*SacdHistoryEntry     `json:"nodes"`
+	PageInfo   *PageInfo               `json:"pageInfo"`
+}
+
+type SacdHistoryEntryEdge struct {
+	Node   *SacdHistoryEntry `json:"node"`
+	Cursor string            `json:"cursor"`
+}
+
 type Signer struct {
 	Address   common.Address `json:"address"`
 	EnabledAt time.Time      `json:"enabledAt"`
+	// When the signer was disabled, if it has been.
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
 }
 
 type SignerConnection struct {
@@ -519,6 +961,8 @@ type Stake struct {
 	TokenDID string `json:"tokenDID"`
 	// The owner of the license. A single owner can own multiple licenses.
 	Owner common.Address `json:"owner"`
+	// The account that staked these tokens.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// The level of the stake. Presently, the levels are 0, 1, and 2. These translate
 	// to Levels 2, 3, and 4 in DIP-2. See https://docs.dimo.org/governance/improvement-proposals/dip2
 	Level int `json:"level"`
@@ -564,6 +1008,8 @@ type StorageNode struct {
 	Address common.Address `json:"address"`
 	// The owner of the storage node. Nodes are transferable, so this may change over time.
 	Owner common.Address `json:"owner"`
+	// The account that owns this node.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// The token id of the storage node as an NFT. Since this is uint256(keccak256(bytes(label))),
 	// it tends to be very large.
 	TokenID *big.Int `json:"tokenId"`
@@ -576,6 +1022,18 @@ type StorageNode struct {
 	MintedAt time.Time `json:"mintedAt"`
 }
 
+type StorageNodeConnection struct {
+	TotalCount int                `json:"totalCount"`
+	Edges      []*StorageNodeEdge `json:"edges"`
+	Nodes      []*StorageNode     `json:"nodes"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+}
+
+type StorageNodeEdge struct {
+	Node   *StorageNode `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
 // The SyntheticDevice is a software connection established to connect the vehicle to the DIMO network.
 type SyntheticDevice struct {
 	// An opaque global identifier for this syntheticDevice.
@@ -650,9 +1108,13 @@ type Template struct {
 	Asset   common.Address `json:"asset"`
 	// Hex string of permissions
 	Permissions string `json:"permissions"`
-	Cid         string `json:"cid"`
+	// The permissions granted by the template, decoded from the permission mask.
+	PermissionList []*Permission `json:"permissionList"`
+	Cid            string        `json:"cid"`
 	// The block timestamp at which this template was created
 	CreatedAt time.Time `json:"createdAt"`
+	// The agreement document stored at the template's CID. Null if no IPFS gateway is configured.
+	Document *PermissionDocument `json:"document,omitempty"`
 }
 
 type TemplateBy struct {
@@ -687,18 +1149,26 @@ type Vehicle struct {
 	TokenDID string `json:"tokenDID"`
 	// The manufacturer of this vehicle.
 	Manufacturer *Manufacturer `json:"manufacturer"`
-	// The Ethereum address of the owner of this vehicle.
+	// The Ethereum address of the owner of this vehicle. For a burned vehicle, this is the last owner.
 	Owner common.Address `json:"owner"`
+	// The account of `owner`. For a burned vehicle, this is the account of its last owner.
+	OwnerAccount *Account `json:"ownerAccount"`
 	// The block timestamp at which this vehicle was minted.
 	MintedAt time.Time `json:"mintedAt"`
 	// The paired aftermarket device, if any.
 	AftermarketDevice *AftermarketDevice `json:"aftermarketDevice,omitempty"`
 	// A Relay-style connection listing any active privilege grants on this vehicle.
 	Privileges *PrivilegesConnection `json:"privileges"`
-	// A Relay-style connection listing any active SACD permission grants on this vehicle.
+	// A Relay-style connection listing any active SACD permission grants on this vehicle, including
+	// account SACDs granted by its owner.
 	Sacds *SacdConnection `json:"sacds"`
-	// The active SACD for this vehicle and the specified grantee, if there is one.
+	// The active SACD for this vehicle and the specified grantee, if there is one. A SACD set on the
+	// vehicle takes precedence over an account SACD granted by its owner.
 	Sacd *Sacd `json:"sacd,omitempty"`
+	// A Relay-style connection listing every change to the SACDs and privileges on this vehicle,
+	// including renounced and expired ones and those dropped on transfer, ordered from most to
+	// least recent.
+	SacdHistory *SacdHistoryEntryConnection `json:"sacdHistory"`
 	// The paired synthetic device, if any.
 	SyntheticDevice *SyntheticDevice `json:"syntheticDevice,omitempty"`
 	// The device definition for this vehicle; which includes make, model, and year among
@@ -716,14 +1186,40 @@ type Vehicle struct {
 	// Description of the storage node to which the vehicle's data should be sent. If this is
 	// not set, then the vehicle may be attached to the original Digital Infrastructure, Inc.
 	// node.
-	StorageNode    *StorageNode `json:"storageNode,omitempty"`
-	ManufacturerID int          `json:"-"`
-	StorageNodeID  []byte       `json:"-"`
+	StorageNode *StorageNode `json:"storageNode,omitempty"`
+	// A Relay-style connection listing every transfer of this vehicle, including the mint, ordered
+	// from most to least recent.
+	OwnershipHistory *VehicleTransferConnection `json:"ownershipHistory"`
+	// A Relay-style connection listing every pairing and unpairing of an aftermarket device with
+	// this vehicle, ordered from most to least recent.
+	AftermarketDeviceHistory *AftermarketDeviceEventConnection `json:"aftermarketDeviceHistory"`
+	// The block timestamp at which this vehicle was burned, if it has been.
+	BurnedAt *time.Time `json:"burnedAt,omitempty"`
+	// The hash of the transaction that burned this vehicle, if it has been burned.
+	BurnTransactionHash []byte `json:"burnTransactionHash,omitempty"`
+	ManufacturerID      int    `json:"-"`
+	StorageNodeID       []byte `json:"-"`
 }
 
 func (Vehicle) IsNode()            {}
 func (this Vehicle) GetID() string { return this.ID }
 
+type VehicleAccess struct {
+	VehicleTokenID int            `json:"vehicleTokenId"`
+	Grantee        common.Address `json:"grantee"`
+	// The time at which access was evaluated.
+	AtTime time.Time `json:"atTime"`
+	// Whether the grantee owned the vehicle at that time.
+	IsOwner bool `json:"isOwner"`
+	// The permissions held by the grantee, ordered as in the permission catalog.
+	Permissions []PermissionName `json:"permissions"`
+	// How each of the held permissions was granted.
+	Grants []*PermissionAccess `json:"grants"`
+	// The earliest time at which one of the held permissions lapses, unless it is renewed. Null if
+	// no permission is held or all are held through ownership.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
 // The Connection type for Vehicle.
 type VehicleConnection struct {
 	TotalCount int            `json:"totalCount"`
@@ -744,12 +1240,42 @@ type VehicleEdge struct {
 	Cursor string   `json:"cursor"`
 }
 
+// A single transfer of a vehicle NFT.
+type VehicleTransfer struct {
+	// The address that held the vehicle before the transfer. This is the zero address for a mint.
+	From common.Address `json:"from"`
+	// The address that held the vehicle after the transfer. This is the zero address for a burn.
+	To common.Address `json:"to"`
+	// The number of the block containing the transfer.
+	BlockNumber int `json:"blockNumber"`
+	// The timestamp of the block containing the transfer.
+	BlockTimestamp time.Time `json:"blockTimestamp"`
+	// The hash of the transaction containing the transfer.
+	TransactionHash []byte `json:"transactionHash"`
+}
+
+type VehicleTransferConnection struct {
+	TotalCount int                    `json:"totalCount"`
+	Edges      []*VehicleTransferEdge `json:"edges"`
+	Nodes      []*VehicleTransfer     `json:"nodes"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+}
+
+type VehicleTransferEdge struct {
+	Node   *VehicleTransfer `json:"node"`
+	Cursor string           `json:"cursor"`
+}
+
 // The VehiclesFilter input is used to specify filtering criteria for querying vehicles.
 // Vehicles must match all of the specified criteria.
 type VehiclesFilter struct {
 	// Privileged filters for vehicles to which the given address has access. This includes vehicles
-	// that this address owns.
+	// that this address owns, and those covered by account SACDs that their owners granted it.
 	Privileged *common.Address `json:"privileged,omitempty"`
+	// Filter for vehicles on which the address holds all of the given permissions, through
+	// ownership, legacy privileges, vehicle SACDs or account SACDs granted by the owner. Each
+	// permission may come from a different grant.
+	PrivilegedWith *PrivilegedWithFilter `json:"privilegedWith,omitempty"`
 	// Owner filters for vehicles that this address owns.
 	Owner *common.Address `json:"owner,omitempty"`
 	// Make filters for vehicles that are of the given make.
@@ -766,3 +1292,256 @@ type VehiclesFilter struct {
 	// this address.
 	Connection *common.Address `json:"connection,omitempty"`
 }
+
+type PermissionDocumentField string
+
+const (
+	PermissionDocumentFieldGrantor     PermissionDocumentField = "GRANTOR"
+	PermissionDocumentFieldGrantee     PermissionDocumentField = "GRANTEE"
+	PermissionDocumentFieldAsset       PermissionDocumentField = "ASSET"
+	PermissionDocumentFieldPermissions PermissionDocumentField = "PERMISSIONS"
+	PermissionDocumentFieldExpiresAt   PermissionDocumentField = "EXPIRES_AT"
+)
+
+var AllPermissionDocumentField = []PermissionDocumentField{
+	PermissionDocumentFieldGrantor,
+	PermissionDocumentFieldGrantee,
+	PermissionDocumentFieldAsset,
+	PermissionDocumentFieldPermissions,
+	PermissionDocumentFieldExpiresAt,
+}
+
+func (e PermissionDocumentField) IsValid() bool {
+	switch e {
+	case PermissionDocumentFieldGrantor, PermissionDocumentFieldGrantee, PermissionDocumentFieldAsset, PermissionDocumentFieldPermissions, PermissionDocumentFieldExpiresAt:
+		return true
+	}
+	return false
+}
+
+func (e PermissionDocumentField) String() string {
+	return string(e)
+}
+
+func (e *PermissionDocumentField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PermissionDocumentField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PermissionDocumentField", str)
+	}
+	return nil
+}
+
+func (e PermissionDocumentField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PermissionDocumentField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PermissionDocumentField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// A permission that can be granted on a vehicle.
+type PermissionName string
+
+const (
+	PermissionNameNonlocationTelemetry PermissionName = "NONLOCATION_TELEMETRY"
+	PermissionNameCommands             PermissionName = "COMMANDS"
+	PermissionNameCurrentLocation      PermissionName = "CURRENT_LOCATION"
+	PermissionNameAlltimeLocation      PermissionName = "ALLTIME_LOCATION"
+	PermissionNameCredentials          PermissionName = "CREDENTIALS"
+	PermissionNameStreams              PermissionName = "STREAMS"
+	PermissionNameRawData              PermissionName = "RAW_DATA"
+	PermissionNameApproximateLocation  PermissionName = "APPROXIMATE_LOCATION"
+)
+
+var AllPermissionName = []PermissionName{
+	PermissionNameNonlocationTelemetry,
+	PermissionNameCommands,
+	PermissionNameCurrentLocation,
+	PermissionNameAlltimeLocation,
+	PermissionNameCredentials,
+	PermissionNameStreams,
+	PermissionNameRawData,
+	PermissionNameApproximateLocation,
+}
+
+func (e PermissionName) IsValid() bool {
+	switch e {
+	case PermissionNameNonlocationTelemetry, PermissionNameCommands, PermissionNameCurrentLocation, PermissionNameAlltimeLocation, PermissionNameCredentials, PermissionNameStreams, PermissionNameRawData, PermissionNameApproximateLocation:
+		return true
+	}
+	return false
+}
+
+func (e PermissionName) String() string {
+	return string(e)
+}
+
+func (e *PermissionName) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PermissionName(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PermissionName", str)
+	}
+	return nil
+}
+
+func (e PermissionName) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PermissionName) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PermissionName) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// The kind of grant through which an address holds a permission.
+type PermissionSourceType string
+
+const (
+	// The address owns the vehicle, which carries every permission.
+	PermissionSourceTypeOwnership PermissionSourceType = "OWNERSHIP"
+	// A legacy privilege set on the vehicle.
+	PermissionSourceTypePrivilege PermissionSourceType = "PRIVILEGE"
+	// A SACD set on the vehicle.
+	PermissionSourceTypeVehicleSacd PermissionSourceType = "VEHICLE_SACD"
+	// A SACD set by the owner of the vehicle on their account, covering all of their vehicles.
+	PermissionSourceTypeAccountSacd PermissionSourceType = "ACCOUNT_SACD"
+)
+
+var AllPermissionSourceType = []PermissionSourceType{
+	PermissionSourceTypeOwnership,
+	PermissionSourceTypePrivilege,
+	PermissionSourceTypeVehicleSacd,
+	PermissionSourceTypeAccountSacd,
+}
+
+func (e PermissionSourceType) IsValid() bool {
+	switch e {
+	case PermissionSourceTypeOwnership, PermissionSourceTypePrivilege, PermissionSourceTypeVehicleSacd, PermissionSourceTypeAccountSacd:
+		return true
+	}
+	return false
+}
+
+func (e PermissionSourceType) String() string {
+	return string(e)
+}
+
+func (e *PermissionSourceType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PermissionSourceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PermissionSourceType", str)
+	}
+	return nil
+}
+
+func (e PermissionSourceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PermissionSourceType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PermissionSourceType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SacdScope string
+
+const (
+	// Granted on a single vehicle.
+	SacdScopeVehicle SacdScope = "VEHICLE"
+	// Granted by an account on itself.
+	SacdScopeAccount SacdScope = "ACCOUNT"
+	// Granted on a connection.
+	SacdScopeConnection SacdScope = "CONNECTION"
+)
+
+var AllSacdScope = []SacdScope{
+	SacdScopeVehicle,
+	SacdScopeAccount,
+	SacdScopeConnection,
+}
+
+func (e SacdScope) IsValid() bool {
+	switch e {
+	case SacdScopeVehicle, SacdScopeAccount, SacdScopeConnection:
+		return true
+	}
+	return false
+}
+
+func (e SacdScope) String() string {
+	return string(e)
+}
+
+func (e *SacdScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SacdScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SacdScope", str)
+	}
+	return nil
+}
+
+func (e SacdScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SacdScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SacdScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
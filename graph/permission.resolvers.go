@@ -0,0 +1,45 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.89
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
+	"github.com/DIMO-Network/identity-api/internal/repositories"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Access is the resolver for the access field.
+func (r *queryResolver) Access(ctx context.Context, vehicleTokenID int, grantee common.Address, atTime *time.Time) (*model.VehicleAccess, error) {
+	at := time.Now()
+	if atTime != nil {
+		at = *atTime
+	}
+
+	res, err := r.access.GetVehicleAccess(ctx, vehicleTokenID, grantee, at)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, graphql.ErrorOnPath(ctx, &gqlerror.Error{
+			Message: fmt.Sprintf("No vehicle with token id %d.", vehicleTokenID),
+			Extensions: map[string]any{
+				"code": "NOT_FOUND",
+			},
+		})
+	}
+
+	return res, err
+}
+
+// PermissionCatalog is the resolver for the permissionCatalog field.
+func (r *queryResolver) PermissionCatalog(ctx context.Context) ([]*model.Permission, error) {
+	return permissions.ToAPI(permissions.Catalog), nil
+}
@@ -2,26 +2,37 @@ package graph
 
 import (
 	"context"
+	"time"
 
 	"github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/config"
 	"github.com/DIMO-Network/identity-api/internal/loader"
+	"github.com/DIMO-Network/identity-api/internal/repositories/access"
 	"github.com/DIMO-Network/identity-api/internal/repositories/accountsacd"
 	"github.com/DIMO-Network/identity-api/internal/repositories/aftermarket"
+	"github.com/DIMO-Network/identity-api/internal/repositories/aftermarkethistory"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/internal/repositories/connection"
 	"github.com/DIMO-Network/identity-api/internal/repositories/connectionsacd"
+	"github.com/DIMO-Network/identity-api/internal/repositories/contractevent"
 	"github.com/DIMO-Network/identity-api/internal/repositories/dcn"
+	"github.com/DIMO-Network/identity-api/internal/repositories/dcntransfer"
 	"github.com/DIMO-Network/identity-api/internal/repositories/developerlicense"
 	"github.com/DIMO-Network/identity-api/internal/repositories/devicedefinition"
+	"github.com/DIMO-Network/identity-api/internal/repositories/document"
+	"github.com/DIMO-Network/identity-api/internal/repositories/grantee"
 	"github.com/DIMO-Network/identity-api/internal/repositories/manufacturer"
 	"github.com/DIMO-Network/identity-api/internal/repositories/reward"
+	"github.com/DIMO-Network/identity-api/internal/repositories/sacdhistory"
 	"github.com/DIMO-Network/identity-api/internal/repositories/stake"
 	"github.com/DIMO-Network/identity-api/internal/repositories/synthetic"
 	"github.com/DIMO-Network/identity-api/internal/repositories/template"
 	"github.com/DIMO-Network/identity-api/internal/repositories/vehicle"
 	"github.com/DIMO-Network/identity-api/internal/repositories/vehicleprivilege"
 	"github.com/DIMO-Network/identity-api/internal/repositories/vehiclesacd"
+	"github.com/DIMO-Network/identity-api/internal/repositories/vehicletransfer"
 	"github.com/DIMO-Network/identity-api/internal/services"
+	"github.com/DIMO-Network/identity-api/internal/services/documents"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog"
 )
@@ -46,10 +57,11 @@ type AftermarketDeviceRepository interface {
 //
 //go:generate mockgen -destination=./mock_dcn_test.go -package=graph github.com/DIMO-Network/identity-api/graph DCNRepository
 type DCNRepository interface {
-	GetDCN(ctx context.Context, by model.DCNBy) (*model.Dcn, error)
+	GetDCN(ctx context.Context, by model.DCNBy, includeBurned bool) (*model.Dcn, error)
 	GetDCNByNode(ctx context.Context, node []byte) (*model.Dcn, error)
 	GetDCNByName(ctx context.Context, name string) (*model.Dcn, error)
 	GetDCNs(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.DCNFilter) (*model.DCNConnection, error)
+	GetBurnedDCNs(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.DCNFilter) (*model.DCNConnection, error)
 }
 
 // ManufacturerRepository interface for mocking manufacturer.Repository.
@@ -73,7 +85,8 @@ type SyntheticRepository interface {
 //go:generate mockgen -destination=./mock_vehicle_test.go -package=graph github.com/DIMO-Network/identity-api/graph VehicleRepository
 type VehicleRepository interface {
 	GetVehicles(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.VehiclesFilter) (*model.VehicleConnection, error)
-	GetVehicle(ctx context.Context, tokenID *int, tokenDID *string) (*model.Vehicle, error)
+	GetVehicle(ctx context.Context, tokenID *int, tokenDID *string, includeBurned bool) (*model.Vehicle, error)
+	GetBurnedVehicles(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.VehiclesFilter) (*model.VehicleConnection, error)
 }
 
 // DeviceDefinitionRepository interface for mocking devicedefinition.Repository.
@@ -89,8 +102,9 @@ type DeviceDefinitionRepository interface {
 //go:generate mockgen -destination=./mock_developerlicense_test.go -package=graph github.com/DIMO-Network/identity-api/graph DeveloperLicenseRepository
 type DeveloperLicenseRepository interface {
 	GetDeveloperLicenses(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.DeveloperLicenseFilterBy) (*model.DeveloperLicenseConnection, error)
-	GetSignersForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.SignerConnection, error)
-	GetRedirectURIsForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.RedirectURIConnection, error)
+	GetSignersForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*model.SignerConnection, error)
+	GetRedirectURIsForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*model.RedirectURIConnection, error)
+	GetTransfersForLicense(ctx context.Context, obj *model.DeveloperLicense, first *int, after *string, last *int, before *string) (*model.DeveloperLicenseTransferConnection, error)
 
 	GetLicense(ctx context.Context, by model.DeveloperLicenseBy) (*model.DeveloperLicense, error)
 }
@@ -126,25 +140,58 @@ type ConnectionSacdRepository interface {
 	GetSacdsForConnection(ctx context.Context, connectionID []byte, first *int, after *string, last *int, before *string) (*model.SacdConnection, error)
 }
 
+type ContractEventRepository interface {
+	GetContractEvents(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.ContractEventsFilter) (*model.ContractEventConnection, error)
+}
+
 // Resolver holds the repositories for the graph resolvers.
 type Resolver struct {
-	aftermarket      AftermarketDeviceRepository
-	dcn              DCNRepository
-	manufacturer     ManufacturerRepository
-	reward           reward.Repository
-	synthetic        SyntheticRepository
-	vehicle          VehicleRepository
-	vehicleprivilege vehicleprivilege.Repository
-	vehiclesacd      vehiclesacd.Repository
-	deviceDefinition DeviceDefinitionRepository
-	developerLicense DeveloperLicenseRepository
-	stake            StakeRepository
-	connection       ConnectionRepository
-	template         TemplateRepository
-	accountsacd      AccountSacdRepository
-	connectionsacd   ConnectionSacdRepository
-	vehicleDefFetch  loader.VehicleDefinitionFetcher
-	log              *zerolog.Logger
+	access             access.Repository
+	aftermarket        AftermarketDeviceRepository
+	aftermarkethistory aftermarkethistory.Repository
+	dcn                DCNRepository
+	dcntransfer        dcntransfer.Repository
+	manufacturer       ManufacturerRepository
+	reward             reward.Repository
+	sacdhistory        sacdhistory.Repository
+	grantee            grantee.Repository
+	synthetic          SyntheticRepository
+	vehicle            VehicleRepository
+	vehicleprivilege   vehicleprivilege.Repository
+	vehiclesacd        vehiclesacd.Repository
+	vehicletransfer    vehicletransfer.Repository
+	deviceDefinition   DeviceDefinitionRepository
+	developerLicense   DeveloperLicenseRepository
+	stake              StakeRepository
+	connection         ConnectionRepository
+	template           TemplateRepository
+	accountsacd        AccountSacdRepository
+	connectionsacd     ConnectionSacdRepository
+	contractEvent      ContractEventRepository
+	document           *document.Repository
+	vehicleDefFetch    loader.VehicleDefinitionFetcher
+	log                *zerolog.Logger
+}
+
+// documentFetchTimeout bounds how long a single permission document fetch may take.
+const documentFetchTimeout = 10 * time.Second
+
+// newDocumentResolver creates a permission document resolver backed by the configured IPFS
+// gateway. It returns nil if there is no usable gateway, in which case documents aren't resolved.
+func newDocumentResolver(settings config.Settings, log *zerolog.Logger) *documents.Resolver {
+	if settings.IPFSGatewayURL == "" {
+		return nil
+	}
+
+	store, err := documents.NewGatewayStore(settings.IPFSGatewayURL, documentFetchTimeout)
+	if err != nil {
+		if log != nil {
+			log.Warn().Err(err).Msg("Invalid IPFS gateway; permission documents will not be resolved.")
+		}
+		return nil
+	}
+
+	return documents.NewResolver(store, documentFetchTimeout)
 }
 
 // NewResolver creates a new Resolver with allocated repositories.
@@ -152,22 +199,30 @@ func NewResolver(baseRepo *base.Repository) *Resolver {
 	tablelandApiService := services.NewTablelandApiService(baseRepo.Log, &baseRepo.Settings)
 
 	return &Resolver{
-		aftermarket:      aftermarket.New(baseRepo),
-		dcn:              dcn.New(baseRepo),
-		manufacturer:     manufacturer.New(baseRepo),
-		reward:           reward.Repository{Repository: baseRepo},
-		synthetic:        synthetic.New(baseRepo),
-		vehicle:          vehicle.New(baseRepo),
-		vehicleprivilege: vehicleprivilege.Repository{Repository: baseRepo},
-		vehiclesacd:      vehiclesacd.Repository{Repository: baseRepo},
-		deviceDefinition: devicedefinition.New(baseRepo, tablelandApiService),
-		developerLicense: developerlicense.New(baseRepo),
-		stake:            stake.New(baseRepo),
-		connection:       connection.New(baseRepo),
-		template:         template.New(baseRepo),
-		accountsacd:      &accountsacd.Repository{Repository: baseRepo},
-		connectionsacd:   &connectionsacd.Repository{Repository: baseRepo},
-		vehicleDefFetch:  loader.NewVehicleDefinitionFetcher(baseRepo.Settings, baseRepo.Log),
-		log:              baseRepo.Log,
+		access:             access.Repository{Repository: baseRepo},
+		aftermarket:        aftermarket.New(baseRepo),
+		aftermarkethistory: aftermarkethistory.Repository{Repository: baseRepo},
+		dcn:                dcn.New(baseRepo),
+		dcntransfer:        dcntransfer.Repository{Repository: baseRepo},
+		manufacturer:       manufacturer.New(baseRepo),
+		reward:             reward.Repository{Repository: baseRepo},
+		sacdhistory:        sacdhistory.Repository{Repository: baseRepo},
+		grantee:            grantee.Repository{Repository: baseRepo},
+		synthetic:          synthetic.New(baseRepo),
+		vehicle:            vehicle.New(baseRepo),
+		vehicleprivilege:   vehicleprivilege.Repository{Repository: baseRepo},
+		vehiclesacd:        vehiclesacd.Repository{Repository: baseRepo},
+		vehicletransfer:    vehicletransfer.Repository{Repository: baseRepo},
+		deviceDefinition:   devicedefinition.New(baseRepo, tablelandApiService),
+		developerLicense:   developerlicense.New(baseRepo),
+		stake:              stake.New(baseRepo),
+		connection:         connection.New(baseRepo),
+		template:           template.New(baseRepo),
+		accountsacd:        &accountsacd.Repository{Repository: baseRepo},
+		connectionsacd:     &connectionsacd.Repository{Repository: baseRepo},
+		contractEvent:      contractevent.New(baseRepo),
+		document:           document.New(baseRepo, newDocumentResolver(baseRepo.Settings, baseRepo.Log)),
+		vehicleDefFetch:    loader.NewVehicleDefinitionFetcher(baseRepo.Settings, baseRepo.Log),
+		log:                baseRepo.Log,
 	}
 }
@@ -0,0 +1,22 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.89
+
+import (
+	"context"
+
+	"github.com/DIMO-Network/identity-api/graph/model"
+)
+
+// Document is the resolver for the document field.
+func (r *sacdResolver) Document(ctx context.Context, obj *model.Sacd) (*model.PermissionDocument, error) {
+	return r.document.GetSacdDocument(ctx, obj)
+}
+
+// Sacd returns SacdResolver implementation.
+func (r *Resolver) Sacd() SacdResolver { return &sacdResolver{r} }
+
+type sacdResolver struct{ *Resolver }
@@ -28,14 +28,15 @@ func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error)
 	}
 	switch prefix {
 	case vehicle.TokenPrefix:
-		return r.vehicle.GetVehicle(ctx, &objID, nil)
+		// Anything with an id can be refetched, including burned vehicles.
+		return r.vehicle.GetVehicle(ctx, &objID, nil, true)
 	case aftermarket.TokenPrefix:
 		return r.aftermarket.GetAftermarketDevice(ctx, model.AftermarketDeviceBy{TokenID: &objID})
 	case manufacturer.TokenPrefix:
 		return r.manufacturer.GetManufacturer(ctx, model.ManufacturerBy{TokenID: &objID})
 	case dcn.TokenPrefix:
 		b := big.NewInt(int64(objID)).Bytes()
-		return r.dcn.GetDCN(ctx, model.DCNBy{Node: b})
+		return r.dcn.GetDCN(ctx, model.DCNBy{Node: b}, true)
 	case synthetic.TokenPrefix:
 		return r.synthetic.GetSyntheticDevice(ctx, model.SyntheticDeviceBy{TokenID: &objID})
 	default:
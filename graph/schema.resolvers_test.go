@@ -56,7 +56,7 @@ func TestQueryResolver_Node(t *testing.T) {
 			name: "vehicle",
 			id:   testVehicle.ID,
 			setupMocks: func(m *mockResolver) {
-				m.mockVehicle.EXPECT().GetVehicle(ctx, RefMatcher[int]{Val: testVehicle.TokenID}, nil).Return(testVehicle, nil)
+				m.mockVehicle.EXPECT().GetVehicle(ctx, RefMatcher[int]{Val: testVehicle.TokenID}, nil, true).Return(testVehicle, nil)
 			},
 			expectedNode: testVehicle,
 		},
@@ -74,7 +74,7 @@ func TestQueryResolver_Node(t *testing.T) {
 			id:   testDCN.ID,
 			setupMocks: func(m *mockResolver) {
 				by := model.DCNBy{Node: testDCN.Node}
-				m.mockDCN.EXPECT().GetDCN(ctx, by).Return(testDCN, nil)
+				m.mockDCN.EXPECT().GetDCN(ctx, by, true).Return(testDCN, nil)
 			},
 			expectedNode: testDCN,
 		},
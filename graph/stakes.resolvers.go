@@ -17,6 +17,11 @@ func (r *queryResolver) Stakes(ctx context.Context, first *int, after *string, l
 	return r.stake.GetStakes(ctx, first, after, last, before, filterBy)
 }
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *stakeResolver) OwnerAccount(ctx context.Context, obj *model.Stake) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // Vehicle is the resolver for the vehicle field.
 func (r *stakeResolver) Vehicle(ctx context.Context, obj *model.Stake) (*model.Vehicle, error) {
 	if obj.VehicleID == nil {
@@ -0,0 +1,22 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.89
+
+import (
+	"context"
+
+	"github.com/DIMO-Network/identity-api/graph/model"
+)
+
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *storageNodeResolver) OwnerAccount(ctx context.Context, obj *model.StorageNode) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
+// StorageNode returns StorageNodeResolver implementation.
+func (r *Resolver) StorageNode() StorageNodeResolver { return &storageNodeResolver{r} }
+
+type storageNodeResolver struct{ *Resolver }
@@ -20,3 +20,13 @@ func (r *queryResolver) Template(ctx context.Context, by model.TemplateBy) (*mod
 func (r *queryResolver) Templates(ctx context.Context, first *int, after *string, last *int, before *string) (*model.TemplateConnection, error) {
 	return r.template.GetTemplates(ctx, first, after, last, before)
 }
+
+// Document is the resolver for the document field.
+func (r *templateResolver) Document(ctx context.Context, obj *model.Template) (*model.PermissionDocument, error) {
+	return r.document.GetTemplateDocument(ctx, obj)
+}
+
+// Template returns TemplateResolver implementation.
+func (r *Resolver) Template() TemplateResolver { return &templateResolver{r} }
+
+type templateResolver struct{ *Resolver }
@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	"github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/loader"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Sacds is the resolver for the sacds field.
@@ -17,6 +19,71 @@ func (r *accountResolver) Sacds(ctx context.Context, obj *model.Account, first *
 	return r.accountsacd.GetSacdsForAccount(ctx, obj.Address, first, after, last, before)
 }
 
+// SacdHistory is the resolver for the sacdHistory field.
+func (r *accountResolver) SacdHistory(ctx context.Context, obj *model.Account, grantee *common.Address, first *int, after *string, last *int, before *string) (*model.SacdHistoryEntryConnection, error) {
+	return r.sacdhistory.GetHistoryForAccount(ctx, obj.Address, grantee, first, after, last, before)
+}
+
+// ReceivedSacds is the resolver for the receivedSacds field.
+func (r *accountResolver) ReceivedSacds(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string, filterBy *model.ReceivedSacdsFilter) (*model.ReceivedSacdConnection, error) {
+	return r.grantee.GetReceivedSacds(ctx, obj.Address, first, after, last, before, filterBy)
+}
+
+// ReceivedPrivileges is the resolver for the receivedPrivileges field.
+func (r *accountResolver) ReceivedPrivileges(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string, filterBy *model.ReceivedPrivilegesFilter) (*model.ReceivedPrivilegeConnection, error) {
+	return r.grantee.GetReceivedPrivileges(ctx, obj.Address, first, after, last, before, filterBy)
+}
+
+// Vehicles is the resolver for the vehicles field.
+func (r *accountResolver) Vehicles(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.VehicleConnection, error) {
+	return loader.GetVehiclesForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// AftermarketDevices is the resolver for the aftermarketDevices field.
+func (r *accountResolver) AftermarketDevices(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.AftermarketDeviceConnection, error) {
+	return loader.GetAftermarketDevicesForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// SyntheticDevices is the resolver for the syntheticDevices field.
+func (r *accountResolver) SyntheticDevices(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.SyntheticDeviceConnection, error) {
+	return loader.GetSyntheticDevicesForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// Dcns is the resolver for the dcns field.
+func (r *accountResolver) Dcns(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.DCNConnection, error) {
+	return loader.GetDCNsForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// Stakes is the resolver for the stakes field.
+func (r *accountResolver) Stakes(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.StakeConnection, error) {
+	return loader.GetStakesForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// DeveloperLicenses is the resolver for the developerLicenses field.
+func (r *accountResolver) DeveloperLicenses(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.DeveloperLicenseConnection, error) {
+	return loader.GetDeveloperLicensesForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// Connections is the resolver for the connections field.
+func (r *accountResolver) Connections(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.ConnectionConnection, error) {
+	return loader.GetConnectionsForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// StorageNodes is the resolver for the storageNodes field.
+func (r *accountResolver) StorageNodes(ctx context.Context, obj *model.Account, first *int, after *string, last *int, before *string) (*model.StorageNodeConnection, error) {
+	return loader.GetStorageNodesForAccount(ctx, obj.Address, first, after, last, before)
+}
+
+// Rewards is the resolver for the rewards field.
+func (r *accountResolver) Rewards(ctx context.Context, obj *model.Account) (*model.UserRewards, error) {
+	return loader.GetRewardsForAccount(ctx, obj.Address)
+}
+
+// Counts is the resolver for the counts field.
+func (r *accountResolver) Counts(ctx context.Context, obj *model.Account) (*model.AccountCounts, error) {
+	return loader.GetCountsForAccount(ctx, obj.Address)
+}
+
 // Account is the resolver for the account field.
 func (r *queryResolver) Account(ctx context.Context, by model.AccountBy) (*model.Account, error) {
 	// Only one option right now.
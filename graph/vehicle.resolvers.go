@@ -19,8 +19,8 @@ import (
 )
 
 // Vehicle is the resolver for the vehicle field.
-func (r *queryResolver) Vehicle(ctx context.Context, tokenID *int, tokenDid *string) (*model.Vehicle, error) {
-	v, err := r.vehicle.GetVehicle(ctx, tokenID, tokenDid)
+func (r *queryResolver) Vehicle(ctx context.Context, tokenID *int, tokenDid *string, includeBurned *bool) (*model.Vehicle, error) {
+	v, err := r.vehicle.GetVehicle(ctx, tokenID, tokenDid, includeBurned != nil && *includeBurned)
 	if errors.Is(err, repositories.ErrNotFound) {
 		// It would be a different error if neither one of these were populated.
 		// At the time of writing, one of these branches must be taken.
@@ -56,11 +56,26 @@ func (r *queryResolver) Vehicles(ctx context.Context, first *int, after *string,
 	return conn, err
 }
 
+// BurnedVehicles is the resolver for the burnedVehicles field.
+func (r *queryResolver) BurnedVehicles(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *model.VehiclesFilter) (*model.VehicleConnection, error) {
+	conn, err := r.vehicle.GetBurnedVehicles(ctx, first, after, last, before, filterBy)
+	if err == nil && conn != nil {
+		loader.EnrichVehicleDefinitions(ctx, r.log, r.vehicleDefFetch, conn.Nodes)
+	}
+
+	return conn, err
+}
+
 // Manufacturer is the resolver for the manufacturer field.
 func (r *vehicleResolver) Manufacturer(ctx context.Context, obj *model.Vehicle) (*model.Manufacturer, error) {
 	return loader.GetManufacturerID(ctx, obj.ManufacturerID)
 }
 
+// OwnerAccount is the resolver for the ownerAccount field.
+func (r *vehicleResolver) OwnerAccount(ctx context.Context, obj *model.Vehicle) (*model.Account, error) {
+	return &model.Account{Address: obj.Owner}, nil
+}
+
 // AftermarketDevice is the resolver for the aftermarketDevice field.
 func (r *vehicleResolver) AftermarketDevice(ctx context.Context, obj *model.Vehicle) (*model.AftermarketDevice, error) {
 	return loader.GetAftermarketDeviceByVehicleID(ctx, obj.TokenID)
@@ -73,12 +88,17 @@ func (r *vehicleResolver) Privileges(ctx context.Context, obj *model.Vehicle, fi
 
 // Sacds is the resolver for the sacds field.
 func (r *vehicleResolver) Sacds(ctx context.Context, obj *model.Vehicle, first *int, after *string, last *int, before *string) (*model.SacdConnection, error) {
-	return r.vehiclesacd.GetSacdsForVehicle(ctx, obj.TokenID, first, after, last, before)
+	return r.vehiclesacd.GetSacdsForVehicle(ctx, obj.TokenID, obj.Owner, first, after, last, before)
 }
 
 // Sacd is the resolver for the sacd field.
 func (r *vehicleResolver) Sacd(ctx context.Context, obj *model.Vehicle, grantee common.Address) (*model.Sacd, error) {
-	return r.vehiclesacd.GetSacdForVehicleAndGrantee(ctx, obj.TokenID, grantee)
+	return r.vehiclesacd.GetSacdForVehicleAndGrantee(ctx, obj.TokenID, obj.Owner, grantee)
+}
+
+// SacdHistory is the resolver for the sacdHistory field.
+func (r *vehicleResolver) SacdHistory(ctx context.Context, obj *model.Vehicle, grantee *common.Address, first *int, after *string, last *int, before *string) (*model.SacdHistoryEntryConnection, error) {
+	return r.sacdhistory.GetHistoryForVehicle(ctx, obj.TokenID, grantee, first, after, last, before)
 }
 
 // SyntheticDevice is the resolver for the syntheticDevice field.
@@ -110,6 +130,16 @@ func (r *vehicleResolver) StorageNode(ctx context.Context, obj *model.Vehicle) (
 	return loader.GetStorageNodeByID(ctx, obj.StorageNodeID)
 }
 
+// OwnershipHistory is the resolver for the ownershipHistory field.
+func (r *vehicleResolver) OwnershipHistory(ctx context.Context, obj *model.Vehicle, first *int, after *string, last *int, before *string) (*model.VehicleTransferConnection, error) {
+	return r.vehicletransfer.GetTransfersForVehicle(ctx, obj.TokenID, first, after, last, before)
+}
+
+// AftermarketDeviceHistory is the resolver for the aftermarketDeviceHistory field.
+func (r *vehicleResolver) AftermarketDeviceHistory(ctx context.Context, obj *model.Vehicle, first *int, after *string, last *int, before *string) (*model.AftermarketDeviceEventConnection, error) {
+	return r.aftermarkethistory.GetEventsForVehicle(ctx, obj.TokenID, first, after, last, before)
+}
+
 // History is the resolver for the history field.
 func (r *vehicleEarningsResolver) History(ctx context.Context, obj *model.VehicleEarnings, first *int, after *string, last *int, before *string) (*model.EarningsConnection, error) {
 	return r.reward.PaginateVehicleEarningsByID(ctx, obj, first, after, last, before)
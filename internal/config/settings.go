@@ -30,4 +30,11 @@ type Settings struct {
 	StorageNodeAddr       string      `yaml:"STORAGE_NODE_ADDR"`
 	TemplateAddr          string      `yaml:"TEMPLATE_ADDR"`
 	FetchAPIGRPCAddr      string      `yaml:"FETCH_API_GRPC_ADDR"`
+	IPFSGatewayURL        string      `yaml:"IPFS_GATEWAY_URL"`
+	ReorgHandling         bool        `yaml:"REORG_HANDLING"`
+	ReorgDepth            int64       `yaml:"REORG_DEPTH"`
+	AppliedEventRetention int64       `yaml:"APPLIED_EVENT_RETENTION"`
+	BlockTransactions     bool        `yaml:"BLOCK_TRANSACTIONS"`
+	ConsumerWorkers       int         `yaml:"CONSUMER_WORKERS"`
+	ConsumerQueueSize     int         `yaml:"CONSUMER_QUEUE_SIZE"`
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -98,3 +99,30 @@ func GenerateWallet() (*ecdsa.PrivateKey, *common.Address, error) {
 
 	return privateKey, &userAddr, nil
 }
+
+// TestTransfer is a token transfer, as stored in any of the transfer history tables.
+type TestTransfer struct {
+	From            common.Address
+	To              common.Address
+	BlockNumber     int64
+	BlockTime       time.Time
+	TransactionHash []byte
+}
+
+// TransferChain returns the transfers that pass a token along owners, one every 100 blocks
+// starting at block 100. Chains with different seeds get different transaction hashes.
+func TransferChain(seed int64, owners []common.Address) []TestTransfer {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	out := make([]TestTransfer, 0, len(owners)-1)
+	for i := 1; i < len(owners); i++ {
+		out = append(out, TestTransfer{
+			From:            owners[i-1],
+			To:              owners[i],
+			BlockNumber:     int64(100 * i),
+			BlockTime:       blockTime.Add(time.Duration(i) * time.Hour),
+			TransactionHash: common.BigToHash(big.NewInt(seed*1000 + int64(i))).Bytes(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+// Package kafka consumes cloud events from a Kafka topic. Unlike the shared consumer, it tells
+// handlers which message they're processing, and on each rebalance it resumes every partition
+// from the offset last stored in Postgres rather than the one committed to Kafka. Handlers that
+// record the offset with ClaimOffset in the same transaction as their writes get exactly-once
+// processing.
+package kafka
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/IBM/sarama"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/goccy/go-json"
+	"github.com/rs/zerolog"
+)
+
+type Config struct {
+	Brokers []string
+	Topic   string
+	Group   string
+}
+
+// Message identifies a Kafka message.
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+}
+
+type messageKey struct{}
+
+// WithMessage returns a context that records the message being processed. A zero Message hides
+// any message set further up.
+func WithMessage(ctx context.Context, msg Message) context.Context {
+	return context.WithValue(ctx, messageKey{}, msg)
+}
+
+// MessageFromContext returns the message being processed, if any.
+func MessageFromContext(ctx context.Context) (Message, bool) {
+	msg, ok := ctx.Value(messageKey{}).(Message)
+	return msg, ok && msg.Topic != ""
+}
+
+// ClaimOffset records that the message is being applied in tx. It returns false if the stored
+// offset shows that the message was already applied, in which case nothing should be written.
+// The offset row stays locked until tx ends, so that two consumers can't apply the same message
+// during a rebalance.
+func ClaimOffset(ctx context.Context, tx *sql.Tx, msg Message) (bool, error) {
+	co, err := models.ConsumerOffsets(
+		models.ConsumerOffsetWhere.Topic.EQ(msg.Topic),
+		models.ConsumerOffsetWhere.Partition.EQ(int(msg.Partition)),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	if co != nil && co.LastOffset >= msg.Offset {
+		return false, nil
+	}
+
+	co = &models.ConsumerOffset{Topic: msg.Topic, Partition: int(msg.Partition), LastOffset: msg.Offset}
+	if err := co.Upsert(ctx, tx, true, []string{models.ConsumerOffsetColumns.Topic, models.ConsumerOffsetColumns.Partition},
+		boil.Whitelist(models.ConsumerOffsetColumns.LastOffset), boil.Infer()); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+type handler struct {
+	dbs     db.Store
+	handler func(context.Context, *cloudevent.RawEvent) error
+	logger  *zerolog.Logger
+}
+
+// Setup moves every claimed partition to the message after the last one stored in Postgres.
+// This can go backward, for example to redeliver events that were buffered but never applied.
+func (h *handler) Setup(session sarama.ConsumerGroupSession) error {
+	for topic, partitions := range session.Claims() {
+		stored, err := models.ConsumerOffsets(models.ConsumerOffsetWhere.Topic.EQ(topic)).All(session.Context(), h.dbs.DBS().Reader)
+		if err != nil {
+			return err
+		}
+
+		for _, co := range stored {
+			for _, p := range partitions {
+				if int32(co.Partition) == p {
+					// MarkOffset only moves forward and ResetOffset only moves backward.
+					session.MarkOffset(topic, p, co.LastOffset+1, "")
+					session.ResetOffset(topic, p, co.LastOffset+1, "")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *handler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *handler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			var event cloudevent.RawEvent
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				h.logger.Err(err).Msg("Failed unmarshaling message.")
+			} else {
+				ctx := WithMessage(session.Context(), Message{Topic: msg.Topic, Partition: msg.Partition, Offset: msg.Offset})
+				if err := h.handler(ctx, &event); err != nil {
+					h.logger.Err(err).Msg("Error processing message.")
+				}
+			}
+			session.MarkMessage(msg, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Consume starts consuming in the background. It returns once the consumer group is created.
+func Consume(ctx context.Context, config Config, dbs db.Store, h func(context.Context, *cloudevent.RawEvent) error, logger *zerolog.Logger) error {
+	kconf := sarama.NewConfig()
+	kconf.Version = sarama.V3_6_0_0
+
+	g, err := sarama.NewConsumerGroup(config.Brokers, config.Group, kconf)
+	if err != nil {
+		return err
+	}
+
+	w := handler{dbs: dbs, handler: h, logger: logger}
+
+	go func() {
+		for {
+			if err := g.Consume(ctx, []string{config.Topic}, &w); err != nil {
+				logger.Err(err).Msg("Consumer group session ended with an error.")
+			}
+			if ctx.Err() != nil {
+				logger.Info().Msg("Context canceled, shutting down.")
+				if err := g.Close(); err != nil {
+					logger.Err(err).Msg("Error closing consumer group.")
+				}
+				return
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageFromContext(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok := MessageFromContext(ctx)
+	assert.False(t, ok)
+
+	msg := Message{Topic: "contract-events", Partition: 1, Offset: 42}
+	ctx = WithMessage(ctx, msg)
+
+	got, ok := MessageFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, msg, got)
+
+	// A zero message hides the outer one.
+	_, ok = MessageFromContext(WithMessage(ctx, Message{}))
+	assert.False(t, ok)
+}
@@ -0,0 +1,333 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/aftermarket"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/repositories/connection"
+	"github.com/DIMO-Network/identity-api/internal/repositories/dcn"
+	"github.com/DIMO-Network/identity-api/internal/repositories/developerlicense"
+	"github.com/DIMO-Network/identity-api/internal/repositories/reward"
+	"github.com/DIMO-Network/identity-api/internal/repositories/stake"
+	"github.com/DIMO-Network/identity-api/internal/repositories/storagenode"
+	"github.com/DIMO-Network/identity-api/internal/repositories/synthetic"
+	"github.com/DIMO-Network/identity-api/internal/repositories/vehicle"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/rs/zerolog"
+)
+
+// AccountPageKey identifies a page of one of an account's asset listings. The pagination
+// arguments are held by value so that repeated requests for the same page share a key.
+type AccountPageKey struct {
+	Owner common.Address
+
+	first, last                            int
+	after, before                          string
+	hasFirst, hasLast, hasAfter, hasBefore bool
+}
+
+func newAccountPageKey(owner common.Address, first *int, after *string, last *int, before *string) AccountPageKey {
+	key := AccountPageKey{Owner: owner}
+	if first != nil {
+		key.first, key.hasFirst = *first, true
+	}
+	if after != nil {
+		key.after, key.hasAfter = *after, true
+	}
+	if last != nil {
+		key.last, key.hasLast = *last, true
+	}
+	if before != nil {
+		key.before, key.hasBefore = *before, true
+	}
+	return key
+}
+
+func (k AccountPageKey) args() (first *int, after *string, last *int, before *string) {
+	if k.hasFirst {
+		first = &k.first
+	}
+	if k.hasAfter {
+		after = &k.after
+	}
+	if k.hasLast {
+		last = &k.last
+	}
+	if k.hasBefore {
+		before = &k.before
+	}
+	return
+}
+
+// AccountLoader resolves the assets held by accounts.
+type AccountLoader struct {
+	vehicle          *vehicle.Repository
+	aftermarket      *aftermarket.Repository
+	synthetic        *synthetic.Repository
+	dcn              *dcn.Repository
+	stake            *stake.Repository
+	developerLicense *developerlicense.Repository
+	connection       *connection.Repository
+	storageNode      *storagenode.Repository
+	reward           *reward.Repository
+	fetcher          VehicleDefinitionFetcher
+	log              *zerolog.Logger
+}
+
+func GetVehiclesForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.VehicleConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountVehicles.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetAftermarketDevicesForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.AftermarketDeviceConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountAftermarketDevices.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetSyntheticDevicesForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.SyntheticDeviceConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountSyntheticDevices.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetDCNsForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.DCNConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountDCNs.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetStakesForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.StakeConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountStakes.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetDeveloperLicensesForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.DeveloperLicenseConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountDeveloperLicenses.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetConnectionsForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.ConnectionConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountConnections.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetStorageNodesForAccount(ctx context.Context, owner common.Address, first *int, after *string, last *int, before *string) (*model.StorageNodeConnection, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountStorageNodes.Load(ctx, newAccountPageKey(owner, first, after, last, before))
+	return thunk()
+}
+
+func GetRewardsForAccount(ctx context.Context, owner common.Address) (*model.UserRewards, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountRewards.Load(ctx, owner)
+	return thunk()
+}
+
+func GetCountsForAccount(ctx context.Context, owner common.Address) (*model.AccountCounts, error) {
+	loaders := ctx.Value(dataLoadersKey).(*Loaders)
+	thunk := loaders.AccountCounts.Load(ctx, owner)
+	return thunk()
+}
+
+// batchOwnerPages builds a batch function from a listing that returns a page for each of several
+// owners. Keys asking for the same page of different owners share a query, and cursors only
+// differ between pages, so walks like vehicles → ownerAccount → aftermarketDevices cost a query
+// per page rather than one per account.
+func batchOwnerPages[C any](list func(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]C, error)) dataloader.BatchFunc[AccountPageKey, C] {
+	return func(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[C] {
+		results := make([]*dataloader.Result[C], len(keys))
+
+		// Group the keys by their pagination arguments.
+		ownersByPage := make(map[AccountPageKey][]common.Address)
+		for _, key := range keys {
+			page := key
+			page.Owner = common.Address{}
+			ownersByPage[page] = append(ownersByPage[page], key.Owner)
+		}
+
+		connsByPage := make(map[AccountPageKey]map[common.Address]C, len(ownersByPage))
+		errsByPage := make(map[AccountPageKey]error)
+		for page, owners := range ownersByPage {
+			first, after, last, before := page.args()
+			connsByPage[page], errsByPage[page] = list(ctx, owners, first, after, last, before)
+		}
+
+		for i, key := range keys {
+			page := key
+			page.Owner = common.Address{}
+			if err := errsByPage[page]; err != nil {
+				results[i] = &dataloader.Result[C]{Error: err}
+				continue
+			}
+			results[i] = &dataloader.Result[C]{Data: connsByPage[page][key.Owner]}
+		}
+
+		return results
+	}
+}
+
+// BatchGetVehicles lists vehicle pages, then fills in their definitions with a single fetch.
+func (a *AccountLoader) BatchGetVehicles(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.VehicleConnection] {
+	results := batchOwnerPages(a.vehicle.GetVehiclesForOwners)(ctx, keys)
+
+	var vehicles []*model.Vehicle
+	for _, res := range results {
+		if res.Error == nil {
+			vehicles = append(vehicles, res.Data.Nodes...)
+		}
+	}
+	EnrichVehicleDefinitions(ctx, a.log, a.fetcher, vehicles)
+
+	return results
+}
+
+func (a *AccountLoader) BatchGetAftermarketDevices(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.AftermarketDeviceConnection] {
+	return batchOwnerPages(a.aftermarket.GetAftermarketDevicesForOwners)(ctx, keys)
+}
+
+func (a *AccountLoader) BatchGetSyntheticDevices(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.SyntheticDeviceConnection] {
+	return batchOwnerPages(a.synthetic.GetSyntheticDevicesForOwners)(ctx, keys)
+}
+
+func (a *AccountLoader) BatchGetDCNs(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.DCNConnection] {
+	return batchOwnerPages(a.dcn.GetDCNsForOwners)(ctx, keys)
+}
+
+func (a *AccountLoader) BatchGetStakes(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.StakeConnection] {
+	return batchOwnerPages(a.stake.GetStakesForOwners)(ctx, keys)
+}
+
+func (a *AccountLoader) BatchGetDeveloperLicenses(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.DeveloperLicenseConnection] {
+	return batchOwnerPages(a.developerLicense.GetDeveloperLicensesForOwners)(ctx, keys)
+}
+
+func (a *AccountLoader) BatchGetConnections(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.ConnectionConnection] {
+	return batchOwnerPages(a.connection.GetConnectionsForOwners)(ctx, keys)
+}
+
+func (a *AccountLoader) BatchGetStorageNodes(ctx context.Context, keys []AccountPageKey) []*dataloader.Result[*model.StorageNodeConnection] {
+	return batchOwnerPages(a.storageNode.GetStorageNodesForOwners)(ctx, keys)
+}
+
+// BatchGetRewards summarizes the rewards of all requested accounts in one query.
+func (a *AccountLoader) BatchGetRewards(ctx context.Context, owners []common.Address) []*dataloader.Result[*model.UserRewards] {
+	results := make([]*dataloader.Result[*model.UserRewards], len(owners))
+
+	rewardsByOwner, err := a.reward.GetEarningsByUserAddresses(ctx, owners)
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[*model.UserRewards]{Error: err}
+		}
+		return results
+	}
+
+	for i, owner := range owners {
+		results[i] = &dataloader.Result[*model.UserRewards]{Data: rewardsByOwner[owner]}
+	}
+
+	return results
+}
+
+// accountCountSources lists, for each field of AccountCounts, the query whose rows are counted
+// and the column holding the owner. The conditions match the owner filters of the corresponding
+// listings.
+var accountCountSources = []struct {
+	ownerColumn string
+	query       func(mods ...qm.QueryMod) base.Binder
+	set         func(counts *model.AccountCounts, n int)
+}{
+	{
+		ownerColumn: models.VehicleTableColumns.OwnerAddress,
+		query: func(mods ...qm.QueryMod) base.Binder {
+			return models.Vehicles(append(mods, models.VehicleWhere.BurnedAt.IsNull())...)
+		},
+		set: func(c *model.AccountCounts, n int) { c.Vehicles = n },
+	},
+	{
+		ownerColumn: models.AftermarketDeviceTableColumns.Owner,
+		query:       func(mods ...qm.QueryMod) base.Binder { return models.AftermarketDevices(mods...) },
+		set:         func(c *model.AccountCounts, n int) { c.AftermarketDevices = n },
+	},
+	{
+		// Synthetic devices belong to the owner of their vehicle.
+		ownerColumn: models.VehicleTableColumns.OwnerAddress,
+		query: func(mods ...qm.QueryMod) base.Binder {
+			return models.SyntheticDevices(append(mods,
+				qm.InnerJoin(fmt.Sprintf("%s ON %s = %s", helpers.WithSchema(models.TableNames.Vehicles), models.VehicleTableColumns.ID, models.SyntheticDeviceTableColumns.VehicleID)),
+			)...)
+		},
+		set: func(c *model.AccountCounts, n int) { c.SyntheticDevices = n },
+	},
+	{
+		ownerColumn: models.DCNTableColumns.OwnerAddress,
+		query: func(mods ...qm.QueryMod) base.Binder {
+			return models.DCNS(append(mods, models.DCNWhere.BurnedAt.IsNull())...)
+		},
+		set: func(c *model.AccountCounts, n int) { c.Dcns = n },
+	},
+	{
+		ownerColumn: models.StakeTableColumns.Owner,
+		query:       func(mods ...qm.QueryMod) base.Binder { return models.Stakes(mods...) },
+		set:         func(c *model.AccountCounts, n int) { c.Stakes = n },
+	},
+	{
+		ownerColumn: models.DeveloperLicenseTableColumns.Owner,
+		query: func(mods ...qm.QueryMod) base.Binder {
+			return models.DeveloperLicenses(append(mods, models.DeveloperLicenseWhere.BurnedAt.IsNull())...)
+		},
+		set: func(c *model.AccountCounts, n int) { c.DeveloperLicenses = n },
+	},
+	{
+		ownerColumn: models.ConnectionTableColumns.Owner,
+		query:       func(mods ...qm.QueryMod) base.Binder { return models.Connections(mods...) },
+		set:         func(c *model.AccountCounts, n int) { c.Connections = n },
+	},
+	{
+		ownerColumn: models.StorageNodeTableColumns.Owner,
+		query:       func(mods ...qm.QueryMod) base.Binder { return models.StorageNodes(mods...) },
+		set:         func(c *model.AccountCounts, n int) { c.StorageNodes = n },
+	},
+}
+
+// BatchGetCounts counts the assets of all requested accounts, with one grouped query per asset
+// type.
+func (a *AccountLoader) BatchGetCounts(ctx context.Context, owners []common.Address) []*dataloader.Result[*model.AccountCounts] {
+	results := make([]*dataloader.Result[*model.AccountCounts], len(owners))
+
+	countsByOwner := make(map[common.Address]*model.AccountCounts, len(owners))
+	for _, owner := range owners {
+		countsByOwner[owner] = &model.AccountCounts{}
+	}
+
+	for _, src := range accountCountSources {
+		counts, err := base.CountPerOwner(ctx, a.vehicle.PDB.DBS().Reader, src.query, src.ownerColumn, owners)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*model.AccountCounts]{Error: err}
+			}
+			return results
+		}
+
+		for owner, n := range counts {
+			src.set(countsByOwner[owner], int(n))
+		}
+	}
+
+	for i, owner := range owners {
+		results[i] = &dataloader.Result[*model.AccountCounts]{Data: countsByOwner[owner]}
+	}
+
+	return results
+}
@@ -0,0 +1,152 @@
+package loader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/repositories/connection"
+	"github.com/DIMO-Network/identity-api/internal/repositories/storagenode"
+	"github.com/DIMO-Network/identity-api/internal/repositories/synthetic"
+	"github.com/DIMO-Network/identity-api/internal/repositories/vehicle"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountLoader(t *testing.T) {
+	ctx := context.Background()
+	pdb, cont := helpers.StartContainerDatabase(ctx, t, migrationsDir)
+	defer cont.Terminate(t.Context()) //nolint
+
+	log := zerolog.Nop()
+	baseRepo := base.NewRepository(pdb, config.Settings{}, &log)
+	al := &AccountLoader{
+		vehicle:     vehicle.New(baseRepo),
+		synthetic:   synthetic.New(baseRepo),
+		connection:  connection.New(baseRepo),
+		storageNode: storagenode.New(baseRepo),
+		log:         &log,
+	}
+
+	owner := common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	other := common.HexToAddress("0xb83de952d389f9a6806819434450324197712fda")
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	mfr := models.Manufacturer{ID: 131, Name: "Toyota", Owner: owner.Bytes(), MintedAt: now, Slug: "toyota"}
+	require.NoError(t, mfr.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	vehicles := []models.Vehicle{
+		{ID: 1, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: now},
+		{ID: 2, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: now, BurnedAt: null.TimeFrom(now)},
+		{ID: 3, ManufacturerID: 131, OwnerAddress: other.Bytes(), MintedAt: now},
+		{ID: 4, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: now},
+	}
+	for _, v := range vehicles {
+		require.NoError(t, v.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+	}
+
+	sd := models.SyntheticDevice{ID: 1, IntegrationID: 2, VehicleID: 1, DeviceAddress: common.HexToAddress("0x1").Bytes(), MintedAt: now}
+	require.NoError(t, sd.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	conn := models.Connection{ID: nameToConnID("Staex"), Address: common.HexToAddress("0x2").Bytes(), Owner: owner.Bytes(), MintedAt: now}
+	require.NoError(t, conn.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	nodes := []models.StorageNode{
+		{ID: common.HexToHash("0x1").Bytes(), Label: "a", Address: common.HexToAddress("0x3").Bytes(), Owner: owner.Bytes(), URI: "https://a.example", MintedAt: now.Add(-time.Hour)},
+		{ID: common.HexToHash("0x2").Bytes(), Label: "b", Address: common.HexToAddress("0x4").Bytes(), Owner: owner.Bytes(), URI: "https://b.example", MintedAt: now},
+	}
+	for _, sn := range nodes {
+		require.NoError(t, sn.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+	}
+
+	counts := al.BatchGetCounts(ctx, []common.Address{owner, other})
+	require.Len(t, counts, 2)
+	require.NoError(t, counts[0].Error)
+	assert.Equal(t, &model.AccountCounts{Vehicles: 2, SyntheticDevices: 1, Connections: 1, StorageNodes: 2}, counts[0].Data)
+	require.NoError(t, counts[1].Error)
+	assert.Equal(t, &model.AccountCounts{Vehicles: 1}, counts[1].Data)
+
+	first := 1
+	pages := al.BatchGetStorageNodes(ctx, []AccountPageKey{
+		newAccountPageKey(owner, &first, nil, nil, nil),
+		newAccountPageKey(other, &first, nil, nil, nil),
+	})
+	require.Len(t, pages, 2)
+	require.NoError(t, pages[0].Error)
+	assert.Equal(t, 2, pages[0].Data.TotalCount)
+	require.Len(t, pages[0].Data.Nodes, 1)
+	assert.Equal(t, "b", pages[0].Data.Nodes[0].Label)
+	assert.True(t, pages[0].Data.PageInfo.HasNextPage)
+	require.NoError(t, pages[1].Error)
+	assert.Zero(t, pages[1].Data.TotalCount)
+
+	next := al.BatchGetStorageNodes(ctx, []AccountPageKey{
+		newAccountPageKey(owner, &first, pages[0].Data.PageInfo.EndCursor, nil, nil),
+	})
+	require.NoError(t, next[0].Error)
+	require.Len(t, next[0].Data.Nodes, 1)
+	assert.Equal(t, "a", next[0].Data.Nodes[0].Label)
+	assert.False(t, next[0].Data.PageInfo.HasNextPage)
+
+	// Owners asking for the same page share a query; other pages are listed separately.
+	vehiclePages := al.BatchGetVehicles(ctx, []AccountPageKey{
+		newAccountPageKey(owner, &first, nil, nil, nil),
+		newAccountPageKey(other, &first, nil, nil, nil),
+		newAccountPageKey(owner, nil, nil, &first, nil),
+	})
+	require.Len(t, vehiclePages, 3)
+
+	require.NoError(t, vehiclePages[0].Error)
+	assert.Equal(t, 2, vehiclePages[0].Data.TotalCount)
+	require.Len(t, vehiclePages[0].Data.Nodes, 1)
+	assert.Equal(t, 4, vehiclePages[0].Data.Nodes[0].TokenID)
+	assert.True(t, vehiclePages[0].Data.PageInfo.HasNextPage)
+
+	require.NoError(t, vehiclePages[1].Error)
+	assert.Equal(t, 1, vehiclePages[1].Data.TotalCount)
+	require.Len(t, vehiclePages[1].Data.Nodes, 1)
+	assert.Equal(t, 3, vehiclePages[1].Data.Nodes[0].TokenID)
+	assert.False(t, vehiclePages[1].Data.PageInfo.HasNextPage)
+
+	require.NoError(t, vehiclePages[2].Error)
+	require.Len(t, vehiclePages[2].Data.Nodes, 1)
+	assert.Equal(t, 1, vehiclePages[2].Data.Nodes[0].TokenID)
+	assert.True(t, vehiclePages[2].Data.PageInfo.HasPreviousPage)
+
+	// Synthetic devices are ranked by the owner of their vehicle.
+	sd3 := models.SyntheticDevice{ID: 3, IntegrationID: 2, VehicleID: 3, DeviceAddress: common.HexToAddress("0x5").Bytes(), MintedAt: now}
+	require.NoError(t, sd3.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	sdPages := al.BatchGetSyntheticDevices(ctx, []AccountPageKey{
+		newAccountPageKey(owner, &first, nil, nil, nil),
+		newAccountPageKey(other, &first, nil, nil, nil),
+	})
+	require.Len(t, sdPages, 2)
+	require.NoError(t, sdPages[0].Error)
+	require.Len(t, sdPages[0].Data.Nodes, 1)
+	assert.Equal(t, 1, sdPages[0].Data.Nodes[0].TokenID)
+	assert.Equal(t, 1, sdPages[0].Data.TotalCount)
+	require.NoError(t, sdPages[1].Error)
+	require.Len(t, sdPages[1].Data.Nodes, 1)
+	assert.Equal(t, 3, sdPages[1].Data.Nodes[0].TokenID)
+
+	connPages := al.BatchGetConnections(ctx, []AccountPageKey{
+		newAccountPageKey(owner, &first, nil, nil, nil),
+		newAccountPageKey(other, &first, nil, nil, nil),
+	})
+	require.Len(t, connPages, 2)
+	require.NoError(t, connPages[0].Error)
+	require.Len(t, connPages[0].Data.Nodes, 1)
+	assert.Equal(t, "Staex", connPages[0].Data.Nodes[0].Name)
+	require.NoError(t, connPages[1].Error)
+	assert.Empty(t, connPages[1].Data.Nodes)
+}
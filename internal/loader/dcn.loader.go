@@ -30,7 +30,7 @@ func GetDCNByVehicleID(ctx context.Context, vehicleID int) (*gmodel.Dcn, error)
 // them in the order requested
 func (d *DCNLoader) BatchGetDCNByVehicleID(ctx context.Context, vehicleIDs []int) []*dataloader.Result[*gmodel.Dcn] {
 	results := make([]*dataloader.Result[*gmodel.Dcn], len(vehicleIDs))
-	dcns, err := models.DCNS(models.DCNWhere.VehicleID.IN(vehicleIDs)).All(ctx, d.repo.PDB.DBS().Reader)
+	dcns, err := models.DCNS(models.DCNWhere.VehicleID.IN(vehicleIDs), models.DCNWhere.BurnedAt.IsNull()).All(ctx, d.repo.PDB.DBS().Reader)
 	if err != nil {
 		for i := range vehicleIDs {
 			results[i] = &dataloader.Result[*gmodel.Dcn]{Data: nil, Error: err}
@@ -10,13 +10,16 @@ import (
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/internal/repositories/connection"
 	"github.com/DIMO-Network/identity-api/internal/repositories/dcn"
+	"github.com/DIMO-Network/identity-api/internal/repositories/developerlicense"
 	"github.com/DIMO-Network/identity-api/internal/repositories/manufacturer"
+	"github.com/DIMO-Network/identity-api/internal/repositories/reward"
 	"github.com/DIMO-Network/identity-api/internal/repositories/stake"
 	"github.com/DIMO-Network/identity-api/internal/repositories/storagenode"
 	"github.com/DIMO-Network/identity-api/internal/repositories/synthetic"
 	"github.com/DIMO-Network/identity-api/internal/repositories/vehicle"
 	"github.com/DIMO-Network/identity-api/internal/services"
 	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/dataloader/v7"
 	"github.com/rs/zerolog"
 )
@@ -38,6 +41,16 @@ type Loaders struct {
 	StakeByVehicleID             dataloader.Interface[int, *model.Stake]
 	ConnectionByID               dataloader.Interface[ConnectionQueryKey, *model.Connection]
 	StorageNodeByID              dataloader.Interface[[32]byte, *model.StorageNode]
+	AccountVehicles              dataloader.Interface[AccountPageKey, *model.VehicleConnection]
+	AccountAftermarketDevices    dataloader.Interface[AccountPageKey, *model.AftermarketDeviceConnection]
+	AccountSyntheticDevices      dataloader.Interface[AccountPageKey, *model.SyntheticDeviceConnection]
+	AccountDCNs                  dataloader.Interface[AccountPageKey, *model.DCNConnection]
+	AccountStakes                dataloader.Interface[AccountPageKey, *model.StakeConnection]
+	AccountDeveloperLicenses     dataloader.Interface[AccountPageKey, *model.DeveloperLicenseConnection]
+	AccountConnections           dataloader.Interface[AccountPageKey, *model.ConnectionConnection]
+	AccountStorageNodes          dataloader.Interface[AccountPageKey, *model.StorageNodeConnection]
+	AccountRewards               dataloader.Interface[common.Address, *model.UserRewards]
+	AccountCounts                dataloader.Interface[common.Address, *model.AccountCounts]
 }
 
 // NewDataLoader returns the instantiated Loaders struct for use in a request
@@ -50,13 +63,32 @@ func NewDataLoaderWithFetcher(dbs db.Store, settings config.Settings, log *zerol
 	baseRepo := &base.Repository{PDB: dbs, Settings: settings, Log: log}
 	vehicleRepo := vehicle.New(baseRepo)
 	vehicle := NewVehicleLoader(vehicleRepo, fetcher, log)
-	aftermarketDevice := NewAftermarketDeviceLoader(aftermarket.New(baseRepo))
-	syntheticDevice := NewSyntheticDeviceLoader(synthetic.New(baseRepo))
-	dcn := NewDCNLoader(dcn.New(baseRepo))
+	aftermarketRepo := aftermarket.New(baseRepo)
+	aftermarketDevice := NewAftermarketDeviceLoader(aftermarketRepo)
+	syntheticRepo := synthetic.New(baseRepo)
+	syntheticDevice := NewSyntheticDeviceLoader(syntheticRepo)
+	dcnRepo := dcn.New(baseRepo)
+	dcn := NewDCNLoader(dcnRepo)
 	manufacturer := NewManufacturerLoader(manufacturer.New(baseRepo))
-	stake := NewStakeLoader(stake.New(baseRepo))
-	connection := ConnectionLoader{repo: connection.New(baseRepo)}
-	storageNode := NewStorageNodeLoader(storagenode.New(baseRepo))
+	stakeRepo := stake.New(baseRepo)
+	stake := NewStakeLoader(stakeRepo)
+	connectionRepo := connection.New(baseRepo)
+	connection := ConnectionLoader{repo: connectionRepo}
+	storageNodeRepo := storagenode.New(baseRepo)
+	storageNode := NewStorageNodeLoader(storageNodeRepo)
+	account := &AccountLoader{
+		vehicle:          vehicleRepo,
+		aftermarket:      aftermarketRepo,
+		synthetic:        syntheticRepo,
+		dcn:              dcnRepo,
+		stake:            stakeRepo,
+		developerLicense: developerlicense.New(baseRepo),
+		connection:       connectionRepo,
+		storageNode:      storageNodeRepo,
+		reward:           &reward.Repository{Repository: baseRepo},
+		fetcher:          fetcher,
+		log:              log,
+	}
 
 	// return the DataLoader
 	return &Loaders{
@@ -100,6 +132,46 @@ func NewDataLoaderWithFetcher(dbs db.Store, settings config.Settings, log *zerol
 			storageNode.BatchGetStorageNodesByIDs,
 			dataloader.WithClearCacheOnBatch[[32]byte, *model.StorageNode](),
 		),
+		AccountVehicles: dataloader.NewBatchedLoader(
+			account.BatchGetVehicles,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.VehicleConnection](),
+		),
+		AccountAftermarketDevices: dataloader.NewBatchedLoader(
+			account.BatchGetAftermarketDevices,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.AftermarketDeviceConnection](),
+		),
+		AccountSyntheticDevices: dataloader.NewBatchedLoader(
+			account.BatchGetSyntheticDevices,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.SyntheticDeviceConnection](),
+		),
+		AccountDCNs: dataloader.NewBatchedLoader(
+			account.BatchGetDCNs,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.DCNConnection](),
+		),
+		AccountStakes: dataloader.NewBatchedLoader(
+			account.BatchGetStakes,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.StakeConnection](),
+		),
+		AccountDeveloperLicenses: dataloader.NewBatchedLoader(
+			account.BatchGetDeveloperLicenses,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.DeveloperLicenseConnection](),
+		),
+		AccountConnections: dataloader.NewBatchedLoader(
+			account.BatchGetConnections,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.ConnectionConnection](),
+		),
+		AccountStorageNodes: dataloader.NewBatchedLoader(
+			account.BatchGetStorageNodes,
+			dataloader.WithClearCacheOnBatch[AccountPageKey, *model.StorageNodeConnection](),
+		),
+		AccountRewards: dataloader.NewBatchedLoader(
+			account.BatchGetRewards,
+			dataloader.WithClearCacheOnBatch[common.Address, *model.UserRewards](),
+		),
+		AccountCounts: dataloader.NewBatchedLoader(
+			account.BatchGetCounts,
+			dataloader.WithClearCacheOnBatch[common.Address, *model.AccountCounts](),
+		),
 	}
 }
 
@@ -0,0 +1,110 @@
+// Package permissions is the catalog of the permissions that can be granted on a vehicle, and
+// decodes SACD permission masks and legacy privilege ids into them.
+package permissions
+
+import (
+	"math/big"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+)
+
+// Permission is a single grantable permission. In a SACD permission mask it occupies the pair
+// of bits starting at 2*Index, and is granted when both are set. Legacy privileges use Index as
+// their id.
+type Permission struct {
+	Name  string
+	Index int
+	// DocumentName is the name used for the permission in signed agreement documents.
+	DocumentName string
+	Description  string
+}
+
+// Catalog lists every known permission, ordered by index. Names are stable and match the
+// PermissionName enum in the GraphQL schema.
+var Catalog = []Permission{
+	{Name: "NONLOCATION_TELEMETRY", Index: 1, DocumentName: "privilege:GetNonLocationHistory", Description: "All-time access to vehicle data other than location."},
+	{Name: "COMMANDS", Index: 2, DocumentName: "privilege:ExecuteCommands", Description: "Send commands to the vehicle, such as locking and unlocking the doors."},
+	{Name: "CURRENT_LOCATION", Index: 3, DocumentName: "privilege:GetCurrentLocation", Description: "Access to the current location of the vehicle."},
+	{Name: "ALLTIME_LOCATION", Index: 4, DocumentName: "privilege:GetLocationHistory", Description: "All-time access to the location history of the vehicle."},
+	{Name: "CREDENTIALS", Index: 5, DocumentName: "privilege:GetVINCredential", Description: "View the verifiable credentials of the vehicle, such as its VIN."},
+	{Name: "STREAMS", Index: 6, DocumentName: "privilege:GetLiveData", Description: "Subscribe to live data streams from the vehicle."},
+	{Name: "RAW_DATA", Index: 7, DocumentName: "privilege:GetRawData", Description: "Access to the raw data sent by the vehicle's devices."},
+	{Name: "APPROXIMATE_LOCATION", Index: 8, DocumentName: "privilege:GetApproximateLocation", Description: "Access to the approximate location of the vehicle."},
+}
+
+// ByPrivilegeID returns the permission granted by the legacy privilege with the given id.
+func ByPrivilegeID(id int) (Permission, bool) {
+	for _, p := range Catalog {
+		if p.Index == id {
+			return p, true
+		}
+	}
+	return Permission{}, false
+}
+
+// ByName returns the permission with the given name, as used in the PermissionName enum.
+func ByName(name string) (Permission, bool) {
+	for _, p := range Catalog {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Permission{}, false
+}
+
+// ByDocumentName returns the permission with the given name, as used in agreement documents.
+// The names of the PermissionName enum are accepted as well.
+func ByDocumentName(name string) (Permission, bool) {
+	for _, p := range Catalog {
+		if p.DocumentName == name || p.Name == name {
+			return p, true
+		}
+	}
+	return Permission{}, false
+}
+
+// FromMask returns the permissions granted by a SACD permission mask, ordered by index. Bits
+// that don't belong to a known permission are ignored.
+func FromMask(mask *big.Int) []Permission {
+	var out []Permission
+	for _, p := range Catalog {
+		if mask.Bit(2*p.Index) == 1 && mask.Bit(2*p.Index+1) == 1 {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// FromBinary decodes a permission mask stored as a binary string, the format of the permissions
+// columns. It returns false if the string isn't valid binary.
+func FromBinary(s string) ([]Permission, bool) {
+	mask, ok := new(big.Int).SetString(s, 2)
+	if !ok {
+		return nil, false
+	}
+	return FromMask(mask), true
+}
+
+// ToAPI converts permissions into their API form. The result is never nil.
+func ToAPI(perms []Permission) []*gmodel.Permission {
+	out := make([]*gmodel.Permission, len(perms))
+	for i, p := range perms {
+		out[i] = &gmodel.Permission{
+			Name:        gmodel.PermissionName(p.Name),
+			PrivilegeID: p.Index,
+			SacdBit:     2 * p.Index,
+			Description: p.Description,
+		}
+	}
+	return out
+}
+
+// PrivilegeToAPI returns the API form of the permission granted by a legacy privilege: a single
+// entry, or none if the id isn't in the catalog.
+func PrivilegeToAPI(id int) []*gmodel.Permission {
+	p, ok := ByPrivilegeID(id)
+	if !ok {
+		return ToAPI(nil)
+	}
+	return ToAPI([]Permission{p})
+}
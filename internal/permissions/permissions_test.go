@@ -0,0 +1,75 @@
+package permissions
+
+import (
+	"math/big"
+	"testing"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func names(ps []Permission) []string {
+	out := make([]string, len(ps))
+	for i, p := range ps {
+		out[i] = p.Name
+	}
+	return out
+}
+
+func TestFromMask(t *testing.T) {
+	assert.Equal(t, []string{"COMMANDS", "ALLTIME_LOCATION", "CREDENTIALS"}, names(FromMask(big.NewInt(3888))))
+	assert.Equal(t, []string{"NONLOCATION_TELEMETRY", "COMMANDS", "CURRENT_LOCATION", "ALLTIME_LOCATION", "CREDENTIALS", "STREAMS"}, names(FromMask(big.NewInt(0x3ffc))))
+
+	// Only one bit of the pair for index 1 is set.
+	assert.Empty(t, FromMask(big.NewInt(0b0100)))
+}
+
+func TestFromBinary(t *testing.T) {
+	ps, ok := FromBinary("1100")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"NONLOCATION_TELEMETRY"}, names(ps))
+
+	_, ok = FromBinary("0x1100")
+	assert.False(t, ok)
+}
+
+func TestByPrivilegeID(t *testing.T) {
+	p, ok := ByPrivilegeID(4)
+	assert.True(t, ok)
+	assert.Equal(t, "ALLTIME_LOCATION", p.Name)
+
+	_, ok = ByPrivilegeID(0)
+	assert.False(t, ok)
+}
+
+func TestByDocumentName(t *testing.T) {
+	p, ok := ByDocumentName("privilege:GetLocationHistory")
+	assert.True(t, ok)
+	assert.Equal(t, "ALLTIME_LOCATION", p.Name)
+
+	p, ok = ByDocumentName("COMMANDS")
+	assert.True(t, ok)
+	assert.Equal(t, 2, p.Index)
+
+	_, ok = ByDocumentName("privilege:Teleport")
+	assert.False(t, ok)
+}
+
+func TestPrivilegeToAPI(t *testing.T) {
+	assert.Equal(t, []*gmodel.Permission{{
+		Name:        gmodel.PermissionNameCurrentLocation,
+		PrivilegeID: 3,
+		SacdBit:     6,
+		Description: "Access to the current location of the vehicle.",
+	}}, PrivilegeToAPI(3))
+
+	// Not nil, since the field is a non-null list.
+	assert.Equal(t, []*gmodel.Permission{}, PrivilegeToAPI(42))
+}
+
+func TestCatalogMatchesSchema(t *testing.T) {
+	for _, p := range Catalog {
+		assert.True(t, gmodel.PermissionName(p.Name).IsValid(), p.Name)
+	}
+	assert.Len(t, Catalog, len(gmodel.AllPermissionName))
+}
@@ -0,0 +1,368 @@
+// Package access works out which permissions an address holds on a vehicle, from every kind of
+// grant that can carry them.
+package access
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
+	"github.com/DIMO-Network/identity-api/internal/repositories"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/repositories/vehicletransfer"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+// candidate is a grant, taken either from one of the grant tables or from the history.
+type candidate struct {
+	setAt       time.Time
+	expiresAt   time.Time
+	permissions null.String
+	privilegeID null.Int
+	source      null.String
+	templateID  null.Bytes
+}
+
+func historyCandidate(h *models.SacdHistory) *candidate {
+	return &candidate{
+		setAt:       h.ChangedAt,
+		expiresAt:   h.ExpiresAt,
+		permissions: h.Permissions,
+		privilegeID: h.PrivilegeID,
+		source:      h.Source,
+		templateID:  h.TemplateID,
+	}
+}
+
+// inEffect returns the grant that was in effect at the given time. The current row is used if
+// it had been set by then. Otherwise the latest change recorded up to then decides, and unless
+// that change set the grant, there was none.
+func inEffect(current *candidate, latest *models.SacdHistory, at time.Time) *candidate {
+	c := current
+	if c == nil || c.setAt.After(at) {
+		c = nil
+		if latest != nil && latest.Cause == "set" {
+			c = historyCandidate(latest)
+		}
+	}
+
+	if c == nil || !c.expiresAt.After(at) {
+		return nil
+	}
+
+	return c
+}
+
+// grant is a source together with the permissions it carries.
+type grant struct {
+	source      *gmodel.PermissionSource
+	permissions []permissions.Permission
+}
+
+func sacdGrant(typ gmodel.PermissionSourceType, c *candidate) (*grant, error) {
+	perms, ok := permissions.FromBinary(c.permissions.String)
+	if !ok {
+		return nil, fmt.Errorf("couldn't parse permission string %q as binary", c.permissions.String)
+	}
+
+	src := &gmodel.PermissionSource{
+		Type:       typ,
+		SacdSource: c.source.Ptr(),
+		ExpiresAt:  &c.expiresAt,
+	}
+	if c.templateID.Valid {
+		src.TemplateID = new(big.Int).SetBytes(c.templateID.Bytes)
+	}
+
+	return &grant{source: src, permissions: perms}, nil
+}
+
+// GetVehicleAccess returns the permissions that the grantee held on the vehicle at the given
+// time.
+func (r *Repository) GetVehicleAccess(ctx context.Context, vehicleID int, grantee common.Address, at time.Time) (*gmodel.VehicleAccess, error) {
+	v, err := models.FindVehicle(ctx, r.PDB.DBS().Reader, vehicleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, err
+	}
+
+	res := &gmodel.VehicleAccess{
+		VehicleTokenID: vehicleID,
+		Grantee:        grantee,
+		AtTime:         at,
+		Permissions:    []gmodel.PermissionName{},
+		Grants:         []*gmodel.PermissionAccess{},
+	}
+
+	owner, err := r.ownerAt(ctx, v, at)
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil {
+		// Not minted yet, or already burned.
+		return res, nil
+	}
+
+	var grants []*grant
+
+	if *owner == grantee {
+		res.IsOwner = true
+		grants = append(grants, &grant{
+			source:      &gmodel.PermissionSource{Type: gmodel.PermissionSourceTypeOwnership},
+			permissions: permissions.Catalog,
+		})
+	}
+
+	privGrants, err := r.privilegeGrants(ctx, vehicleID, grantee, at)
+	if err != nil {
+		return nil, err
+	}
+	grants = append(grants, privGrants...)
+
+	vs, err := r.vehicleSacdGrant(ctx, vehicleID, grantee, at)
+	if err != nil {
+		return nil, err
+	}
+	if vs != nil {
+		grants = append(grants, vs)
+	}
+
+	as, err := r.accountSacdGrant(ctx, *owner, grantee, at)
+	if err != nil {
+		return nil, err
+	}
+	if as != nil {
+		grants = append(grants, as)
+	}
+
+	summarize(res, grants)
+
+	return res, nil
+}
+
+// summarize fills in the held permissions from the grants, in catalog order.
+func summarize(res *gmodel.VehicleAccess, grants []*grant) {
+	byName := make(map[string]*gmodel.PermissionAccess)
+	// Permissions held through ownership don't lapse.
+	permanent := make(map[string]bool)
+
+	for _, g := range grants {
+		for _, p := range g.permissions {
+			pa, ok := byName[p.Name]
+			if !ok {
+				pa = &gmodel.PermissionAccess{Permission: gmodel.PermissionName(p.Name)}
+				byName[p.Name] = pa
+			}
+			pa.Sources = append(pa.Sources, g.source)
+
+			if g.source.ExpiresAt == nil {
+				permanent[p.Name] = true
+			} else if pa.ExpiresAt == nil || g.source.ExpiresAt.After(*pa.ExpiresAt) {
+				pa.ExpiresAt = g.source.ExpiresAt
+			}
+		}
+	}
+
+	for _, p := range permissions.Catalog {
+		pa, ok := byName[p.Name]
+		if !ok {
+			continue
+		}
+
+		if permanent[p.Name] {
+			pa.ExpiresAt = nil
+		} else if res.ExpiresAt == nil || pa.ExpiresAt.Before(*res.ExpiresAt) {
+			res.ExpiresAt = pa.ExpiresAt
+		}
+
+		res.Permissions = append(res.Permissions, pa.Permission)
+		res.Grants = append(res.Grants, pa)
+	}
+}
+
+// ownerAt returns the owner of the vehicle at the given time, or nil if it hadn't been minted
+// or had been burned by then.
+func (r *Repository) ownerAt(ctx context.Context, v *models.Vehicle, at time.Time) (*common.Address, error) {
+	if v.MintedAt.After(at) || v.BurnedAt.Valid && !v.BurnedAt.Time.After(at) {
+		return nil, nil
+	}
+
+	last, err := models.VehicleTransfers(
+		models.VehicleTransferWhere.VehicleID.EQ(v.ID),
+		models.VehicleTransferWhere.BlockTime.LTE(at),
+		qm.OrderBy(vehicletransfer.Columns.NewestFirst()),
+	).One(ctx, r.PDB.DBS().Reader)
+	if err == nil {
+		owner := common.BytesToAddress(last.ToAddress)
+		return &owner, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// No transfer had been recorded by then. If one was recorded later, the vehicle belonged to
+	// its sender.
+	next, err := models.VehicleTransfers(
+		models.VehicleTransferWhere.VehicleID.EQ(v.ID),
+		qm.OrderBy(vehicletransfer.Columns.OldestFirst()),
+	).One(ctx, r.PDB.DBS().Reader)
+	if err == nil && common.BytesToAddress(next.FromAddress) != (common.Address{}) {
+		owner := common.BytesToAddress(next.FromAddress)
+		return &owner, nil
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	owner := common.BytesToAddress(v.OwnerAddress)
+	return &owner, nil
+}
+
+func (r *Repository) privilegeGrants(ctx context.Context, vehicleID int, grantee common.Address, at time.Time) ([]*grant, error) {
+	current, err := models.Privileges(
+		models.PrivilegeWhere.TokenID.EQ(vehicleID),
+		models.PrivilegeWhere.UserAddress.EQ(grantee.Bytes()),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := models.SacdHistories(
+		models.SacdHistoryWhere.VehicleID.EQ(null.IntFrom(vehicleID)),
+		models.SacdHistoryWhere.Grantee.EQ(grantee.Bytes()),
+		models.SacdHistoryWhere.PrivilegeID.IsNotNull(),
+		models.SacdHistoryWhere.ChangedAt.LTE(at),
+		qm.OrderBy(models.SacdHistoryColumns.ChangedAt+" DESC, "+models.SacdHistoryColumns.ID+" DESC"),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	currentByID := make(map[int]*candidate)
+	for _, p := range current {
+		currentByID[p.PrivilegeID] = &candidate{
+			setAt:       p.SetAt,
+			expiresAt:   p.ExpiresAt,
+			privilegeID: null.IntFrom(p.PrivilegeID),
+		}
+	}
+
+	latestByID := make(map[int]*models.SacdHistory)
+	for _, h := range history {
+		if _, ok := latestByID[h.PrivilegeID.Int]; !ok {
+			latestByID[h.PrivilegeID.Int] = h
+		}
+	}
+
+	var grants []*grant
+
+	for _, p := range permissions.Catalog {
+		c := inEffect(currentByID[p.Index], latestByID[p.Index], at)
+		if c == nil {
+			continue
+		}
+
+		grants = append(grants, &grant{
+			source: &gmodel.PermissionSource{
+				Type:        gmodel.PermissionSourceTypePrivilege,
+				PrivilegeID: c.privilegeID.Ptr(),
+				ExpiresAt:   &c.expiresAt,
+			},
+			permissions: []permissions.Permission{p},
+		})
+	}
+
+	return grants, nil
+}
+
+func (r *Repository) vehicleSacdGrant(ctx context.Context, vehicleID int, grantee common.Address, at time.Time) (*grant, error) {
+	var current *candidate
+
+	vs, err := models.FindVehicleSacd(ctx, r.PDB.DBS().Reader, vehicleID, grantee.Bytes())
+	if err == nil {
+		current = &candidate{
+			setAt:       vs.CreatedAt,
+			expiresAt:   vs.ExpiresAt,
+			permissions: null.StringFrom(vs.Permissions),
+			source:      null.StringFrom(vs.Source),
+			templateID:  vs.TemplateID,
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	latest, err := r.latestChange(ctx, models.SacdHistoryWhere.VehicleID.EQ(null.IntFrom(vehicleID)), grantee, at)
+	if err != nil {
+		return nil, err
+	}
+
+	c := inEffect(current, latest, at)
+	if c == nil {
+		return nil, nil
+	}
+
+	return sacdGrant(gmodel.PermissionSourceTypeVehicleSacd, c)
+}
+
+func (r *Repository) accountSacdGrant(ctx context.Context, owner, grantee common.Address, at time.Time) (*grant, error) {
+	var current *candidate
+
+	as, err := models.FindAccountSacd(ctx, r.PDB.DBS().Reader, owner.Bytes(), grantee.Bytes())
+	if err == nil {
+		current = &candidate{
+			setAt:       as.CreatedAt,
+			expiresAt:   as.ExpiresAt,
+			permissions: null.StringFrom(as.Permissions),
+			source:      null.StringFrom(as.Source),
+			templateID:  as.TemplateID,
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	latest, err := r.latestChange(ctx, models.SacdHistoryWhere.Account.EQ(null.BytesFrom(owner.Bytes())), grantee, at)
+	if err != nil {
+		return nil, err
+	}
+
+	c := inEffect(current, latest, at)
+	if c == nil {
+		return nil, nil
+	}
+
+	return sacdGrant(gmodel.PermissionSourceTypeAccountSacd, c)
+}
+
+// latestChange returns the last recorded change, up to the given time, to the SACD of the
+// grantee on the asset.
+func (r *Repository) latestChange(ctx context.Context, asset qm.QueryMod, grantee common.Address, at time.Time) (*models.SacdHistory, error) {
+	h, err := models.SacdHistories(
+		asset,
+		models.SacdHistoryWhere.Grantee.EQ(grantee.Bytes()),
+		models.SacdHistoryWhere.PrivilegeID.IsNull(),
+		models.SacdHistoryWhere.ChangedAt.LTE(at),
+		qm.OrderBy(models.SacdHistoryColumns.ChangedAt+" DESC, "+models.SacdHistoryColumns.ID+" DESC"),
+	).One(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return h, nil
+}
@@ -0,0 +1,216 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+var (
+	owner    = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	newOwner = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	grantee  = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	start    = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type AccessRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *AccessRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = &Repository{base.NewRepository(s.pdb, s.settings, &logger)}
+}
+
+// TearDownTest after each test truncate tables
+func (s *AccessRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *AccessRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestAccessRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(AccessRepoTestSuite))
+}
+
+func (s *AccessRepoTestSuite) insertVehicle(vehicleOwner common.Address) {
+	m := models.Manufacturer{
+		ID:    131,
+		Name:  "Toyota",
+		Owner: owner.Bytes(),
+		Slug:  "toyota",
+	}
+	s.Require().NoError(m.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	v := models.Vehicle{
+		ID:             1,
+		ManufacturerID: 131,
+		OwnerAddress:   vehicleOwner.Bytes(),
+		MintedAt:       start,
+	}
+	s.Require().NoError(v.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+}
+
+func (s *AccessRepoTestSuite) TestGetVehicleAccess() {
+	s.insertVehicle(owner)
+
+	p := models.Privilege{
+		TokenID:     1,
+		PrivilegeID: 1,
+		UserAddress: grantee.Bytes(),
+		SetAt:       start.Add(time.Hour),
+		ExpiresAt:   start.Add(48 * time.Hour),
+	}
+	s.Require().NoError(p.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	// COMMANDS and CURRENT_LOCATION.
+	vs := models.VehicleSacd{
+		VehicleID:   1,
+		Grantee:     grantee.Bytes(),
+		Permissions: "11110000",
+		Source:      "ipfs://vehicle",
+		CreatedAt:   start.Add(2 * time.Hour),
+		ExpiresAt:   start.Add(24 * time.Hour),
+	}
+	s.Require().NoError(vs.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	// NONLOCATION_TELEMETRY, on every vehicle of the owner.
+	as := models.AccountSacd{
+		Account:     owner.Bytes(),
+		Grantee:     grantee.Bytes(),
+		Permissions: "1100",
+		Source:      "ipfs://account",
+		CreatedAt:   start.Add(3 * time.Hour),
+		ExpiresAt:   start.Add(72 * time.Hour),
+	}
+	s.Require().NoError(as.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	res, err := s.repo.GetVehicleAccess(s.ctx, 1, grantee, start.Add(4*time.Hour))
+	s.Require().NoError(err)
+
+	s.False(res.IsOwner)
+	s.Equal([]gmodel.PermissionName{gmodel.PermissionNameNonlocationTelemetry, gmodel.PermissionNameCommands, gmodel.PermissionNameCurrentLocation}, res.Permissions)
+	s.Require().Len(res.Grants, 3)
+
+	telemetry := res.Grants[0]
+	s.Require().Len(telemetry.Sources, 2)
+	s.Equal(gmodel.PermissionSourceTypePrivilege, telemetry.Sources[0].Type)
+	s.Equal(1, *telemetry.Sources[0].PrivilegeID)
+	s.Equal(gmodel.PermissionSourceTypeAccountSacd, telemetry.Sources[1].Type)
+	s.Equal("ipfs://account", *telemetry.Sources[1].SacdSource)
+	s.Equal(start.Add(72*time.Hour), telemetry.ExpiresAt.UTC())
+
+	s.Require().Len(res.Grants[1].Sources, 1)
+	s.Equal(gmodel.PermissionSourceTypeVehicleSacd, res.Grants[1].Sources[0].Type)
+	s.Equal(start.Add(24*time.Hour), res.ExpiresAt.UTC())
+
+	// The vehicle SACD has lapsed.
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, grantee, start.Add(25*time.Hour))
+	s.Require().NoError(err)
+	s.Equal([]gmodel.PermissionName{gmodel.PermissionNameNonlocationTelemetry}, res.Permissions)
+
+	// Nothing had been granted yet.
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, grantee, start.Add(30*time.Minute))
+	s.Require().NoError(err)
+	s.Empty(res.Permissions)
+	s.Nil(res.ExpiresAt)
+
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, owner, start.Add(4*time.Hour))
+	s.Require().NoError(err)
+	s.True(res.IsOwner)
+	s.Len(res.Permissions, 8)
+	s.Nil(res.ExpiresAt)
+	s.Nil(res.Grants[0].ExpiresAt)
+	s.Equal(gmodel.PermissionSourceTypeOwnership, res.Grants[0].Sources[0].Type)
+
+	_, err = s.repo.GetVehicleAccess(s.ctx, 2, grantee, start)
+	s.ErrorIs(err, repositories.ErrNotFound)
+}
+
+func (s *AccessRepoTestSuite) TestGetVehicleAccessFromHistory() {
+	s.insertVehicle(newOwner)
+
+	vt := models.VehicleTransfer{
+		VehicleID:       1,
+		FromAddress:     owner.Bytes(),
+		ToAddress:       newOwner.Bytes(),
+		BlockNumber:     300,
+		BlockTime:       start.Add(10 * time.Hour),
+		TransactionHash: common.BigToHash(common.Big3).Bytes(),
+	}
+	s.Require().NoError(vt.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	// A vehicle SACD that was set and then dropped by the transfer.
+	for i, cause := range []string{"set", "transfer"} {
+		h := models.SacdHistory{
+			VehicleID:       null.IntFrom(1),
+			Grantee:         grantee.Bytes(),
+			Cause:           cause,
+			Permissions:     null.StringFrom("1100"),
+			Source:          null.StringFrom("ipfs://vehicle"),
+			ExpiresAt:       start.Add(100 * time.Hour),
+			ChangedAt:       vt.BlockTime.Add(time.Duration(i-1) * 9 * time.Hour),
+			BlockNumber:     null.Int64From(int64(100 * (i + 2))),
+			TransactionHash: null.BytesFrom(common.BigToHash(common.Big1).Bytes()),
+		}
+		s.Require().NoError(h.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	res, err := s.repo.GetVehicleAccess(s.ctx, 1, grantee, start.Add(2*time.Hour))
+	s.Require().NoError(err)
+	s.Equal([]gmodel.PermissionName{gmodel.PermissionNameNonlocationTelemetry}, res.Permissions)
+	s.Equal(gmodel.PermissionSourceTypeVehicleSacd, res.Grants[0].Sources[0].Type)
+
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, grantee, start.Add(11*time.Hour))
+	s.Require().NoError(err)
+	s.Empty(res.Permissions)
+
+	// Before the transfer, the vehicle belonged to its sender.
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, owner, start.Add(2*time.Hour))
+	s.Require().NoError(err)
+	s.True(res.IsOwner)
+
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, owner, start.Add(11*time.Hour))
+	s.Require().NoError(err)
+	s.False(res.IsOwner)
+	s.Empty(res.Permissions)
+
+	res, err = s.repo.GetVehicleAccess(s.ctx, 1, newOwner, start.Add(11*time.Hour))
+	s.Require().NoError(err)
+	s.True(res.IsOwner)
+}
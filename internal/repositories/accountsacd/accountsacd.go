@@ -9,6 +9,7 @@ import (
 
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -24,18 +25,22 @@ type SacdCursor struct {
 	Grantee   []byte
 }
 
-func sacdToAPIResponse(pr *models.AccountSacd) (*gmodel.Sacd, error) {
+// SacdToAPI converts an account SACD to its API form.
+func SacdToAPI(pr *models.AccountSacd) (*gmodel.Sacd, error) {
 	b, ok := new(big.Int).SetString(pr.Permissions, 2)
 	if !ok {
 		return nil, fmt.Errorf("couldn't parse permission string %q as binary", pr.Permissions)
 	}
 
 	sacd := &gmodel.Sacd{
-		Grantee:     common.BytesToAddress(pr.Grantee),
-		Permissions: "0x" + b.Text(16),
-		Source:      pr.Source,
-		CreatedAt:   pr.CreatedAt,
-		ExpiresAt:   pr.ExpiresAt,
+		Grantee:        common.BytesToAddress(pr.Grantee),
+		Permissions:    "0x" + b.Text(16),
+		PermissionList: permissions.ToAPI(permissions.FromMask(b)),
+		Source:         pr.Source,
+		CreatedAt:      pr.CreatedAt,
+		ExpiresAt:      pr.ExpiresAt,
+		Scope:          gmodel.SacdScopeAccount,
+		Account:        pr.Account,
 	}
 
 	return sacd, nil
@@ -69,7 +74,7 @@ func (p *Repository) createSacdResponse(sacds models.AccountSacdSlice, totalCoun
 	nodes := make([]*gmodel.Sacd, len(sacds))
 
 	for i, dp := range sacds {
-		gp, err := sacdToAPIResponse(dp)
+		gp, err := SacdToAPI(dp)
 		if err != nil {
 			return nil, err
 		}
@@ -120,6 +120,80 @@ func (r *Repository) GetAftermarketDevices(ctx context.Context, first *int, afte
 		slices.Reverse(all)
 	}
 
+	return r.createAftermarketDevicesResponse(adCount, all, hasNext, hasPrevious)
+}
+
+// GetAftermarketDevicesForOwners returns, for each owner, the page that GetAftermarketDevices
+// would return with an owner filter, using a single query for the devices.
+func (r *Repository) GetAftermarketDevicesForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.AftermarketDeviceConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.AftermarketDevices(mods...) },
+		models.AftermarketDeviceColumns.Owner, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy := " DESC"
+	if last != nil {
+		orderBy = " ASC"
+	}
+
+	rankWhere := models.AftermarketDeviceColumns.Owner + " = ANY(?)"
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterID, err := helpers.CursorToID(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += " AND " + models.AftermarketDeviceColumns.ID + " < ?"
+		args = append(args, afterID)
+	} else if before != nil {
+		beforeID, err := helpers.CursorToID(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += " AND " + models.AftermarketDeviceColumns.ID + " > ?"
+		args = append(args, beforeID)
+	}
+
+	all, err := models.AftermarketDevices(
+		// Use limit + 1 here to check if there's a next page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.AftermarketDevices), models.AftermarketDeviceColumns.ID, models.AftermarketDeviceColumns.Owner,
+			models.AftermarketDeviceColumns.ID+orderBy, rankWhere, limit+1, args...),
+		qm.OrderBy(models.AftermarketDeviceColumns.ID+orderBy),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.AftermarketDeviceSlice, len(owners))
+	for _, ad := range all {
+		owner := common.BytesToAddress(ad.Owner)
+		byOwner[owner] = append(byOwner[owner], ad)
+	}
+
+	out := make(map[common.Address]*gmodel.AftermarketDeviceConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		conn, err := r.createAftermarketDevicesResponse(counts[owner], page, hasNext, hasPrevious)
+		if err != nil {
+			return nil, err
+		}
+		out[owner] = conn
+	}
+
+	return out, nil
+}
+
+func (r *Repository) createAftermarketDevicesResponse(totalCount int64, all models.AftermarketDeviceSlice, hasNext bool, hasPrevious bool) (*gmodel.AftermarketDeviceConnection, error) {
 	edges := make([]*gmodel.AftermarketDeviceEdge, len(all))
 	nodes := make([]*gmodel.AftermarketDevice, len(all))
 	var errList gqlerror.List
@@ -154,7 +228,7 @@ func (r *Repository) GetAftermarketDevices(ctx context.Context, first *int, afte
 	}
 
 	res := &gmodel.AftermarketDeviceConnection{
-		TotalCount: int(adCount),
+		TotalCount: int(totalCount),
 		Edges:      edges,
 		Nodes:      nodes,
 		PageInfo: &gmodel.PageInfo{
@@ -0,0 +1,173 @@
+package aftermarkethistory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+// EventCursor orders rows by block and then by the order in which they were processed.
+type EventCursor struct {
+	BlockNumber int64
+	ID          int64
+}
+
+func addressPtr(b null.Bytes) *common.Address {
+	if !b.Valid {
+		return nil
+	}
+	addr := common.BytesToAddress(b.Bytes)
+	return &addr
+}
+
+func eventToAPIResponse(h *models.AftermarketDeviceHistory) *gmodel.AftermarketDeviceEvent {
+	return &gmodel.AftermarketDeviceEvent{
+		EventName:                h.EventName,
+		AftermarketDeviceTokenID: h.AftermarketDeviceID,
+		VehicleTokenID:           h.VehicleID.Ptr(),
+		Owner:                    addressPtr(h.Owner),
+		Address:                  addressPtr(h.Address),
+		BlockNumber:              int(h.BlockNumber),
+		BlockTimestamp:           h.BlockTime,
+		TransactionHash:          h.TransactionHash,
+	}
+}
+
+// GetEventsForDevice returns the claim, pairing and address history of the aftermarket device,
+// most recent first.
+func (r *Repository) GetEventsForDevice(ctx context.Context, tokenID int, first *int, after *string, last *int, before *string) (*gmodel.AftermarketDeviceEventConnection, error) {
+	return r.getEvents(ctx, models.AftermarketDeviceHistoryWhere.AftermarketDeviceID.EQ(tokenID), first, after, last, before)
+}
+
+// GetEventsForVehicle returns the aftermarket device pairings and unpairings of the vehicle, most
+// recent first.
+func (r *Repository) GetEventsForVehicle(ctx context.Context, vehicleID int, first *int, after *string, last *int, before *string) (*gmodel.AftermarketDeviceEventConnection, error) {
+	return r.getEvents(ctx, models.AftermarketDeviceHistoryWhere.VehicleID.EQ(null.IntFrom(vehicleID)), first, after, last, before)
+}
+
+func (r *Repository) getEvents(ctx context.Context, where qm.QueryMod, first *int, after *string, last *int, before *string) (*gmodel.AftermarketDeviceEventConnection, error) {
+	pHelp := helpers.PaginationHelper[EventCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	queryMods := []qm.QueryMod{where}
+
+	totalCount, err := models.AftermarketDeviceHistories(queryMods...).Count(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		afterCursor, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Expr(
+				models.AftermarketDeviceHistoryWhere.BlockNumber.EQ(afterCursor.BlockNumber),
+				models.AftermarketDeviceHistoryWhere.ID.LT(afterCursor.ID),
+				qm.Or2(models.AftermarketDeviceHistoryWhere.BlockNumber.LT(afterCursor.BlockNumber)),
+			),
+		)
+	}
+
+	if before != nil {
+		beforeCursor, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Expr(
+				models.AftermarketDeviceHistoryWhere.BlockNumber.EQ(beforeCursor.BlockNumber),
+				models.AftermarketDeviceHistoryWhere.ID.GT(beforeCursor.ID),
+				qm.Or2(models.AftermarketDeviceHistoryWhere.BlockNumber.GT(beforeCursor.BlockNumber)),
+			),
+		)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s DESC", models.AftermarketDeviceHistoryColumns.BlockNumber, models.AftermarketDeviceHistoryColumns.ID)
+	if last != nil {
+		orderBy = fmt.Sprintf("%s ASC, %s ASC", models.AftermarketDeviceHistoryColumns.BlockNumber, models.AftermarketDeviceHistoryColumns.ID)
+	}
+
+	queryMods = append(queryMods,
+		// Use limit + 1 here to check if there's another page.
+		qm.Limit(limit+1),
+		qm.OrderBy(orderBy),
+	)
+
+	page, err := models.AftermarketDeviceHistories(queryMods...).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(page) == limit+1 {
+		hasNext = true
+		page = page[:limit]
+	} else if last != nil && len(page) == limit+1 {
+		hasPrevious = true
+		page = page[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(page)
+	}
+
+	edges := make([]*gmodel.AftermarketDeviceEventEdge, len(page))
+	nodes := make([]*gmodel.AftermarketDeviceEvent, len(page))
+
+	for i, h := range page {
+		crsr, err := pHelp.EncodeCursor(EventCursor{BlockNumber: h.BlockNumber, ID: h.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		ge := eventToAPIResponse(h)
+
+		edges[i] = &gmodel.AftermarketDeviceEventEdge{
+			Node:   ge,
+			Cursor: crsr,
+		}
+		nodes[i] = ge
+	}
+
+	var endCur, startCur *string
+
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.AftermarketDeviceEventConnection{
+		TotalCount: int(totalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo: &gmodel.PageInfo{
+			StartCursor:     startCur,
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+		},
+	}, nil
+}
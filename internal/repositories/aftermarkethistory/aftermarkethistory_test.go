@@ -0,0 +1,157 @@
+package aftermarkethistory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type AftermarketHistoryRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *AftermarketHistoryRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = &Repository{base.NewRepository(s.pdb, s.settings, &logger)}
+}
+
+// TearDownTest after each test truncate tables
+func (s *AftermarketHistoryRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *AftermarketHistoryRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestAftermarketHistoryRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(AftermarketHistoryRepoTestSuite))
+}
+
+func (s *AftermarketHistoryRepoTestSuite) insertEvent(deviceID int, eventName string, vehicleID null.Int, blockNumber int64) {
+	h := models.AftermarketDeviceHistory{
+		AftermarketDeviceID: deviceID,
+		EventName:           eventName,
+		VehicleID:           vehicleID,
+		BlockNumber:         blockNumber,
+		BlockTime:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(blockNumber) * time.Hour),
+		TransactionHash:     common.BigToHash(big.NewInt(blockNumber)).Bytes(),
+	}
+	s.Require().NoError(h.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+}
+
+func (s *AftermarketHistoryRepoTestSuite) TestGetEventsForDeviceAndVehicle() {
+	// Device 1 is paired to vehicle 10, moved to vehicle 20, and then has its address reset.
+	s.insertEvent(1, "AftermarketDeviceClaimed", null.Int{}, 100)
+	s.insertEvent(1, "AftermarketDevicePaired", null.IntFrom(10), 100)
+	s.insertEvent(1, "AftermarketDeviceUnpaired", null.IntFrom(10), 200)
+	s.insertEvent(1, "AftermarketDevicePaired", null.IntFrom(20), 300)
+	s.insertEvent(1, "AftermarketDeviceAddressReset", null.Int{}, 400)
+	// Device 2 replaces it on vehicle 10.
+	s.insertEvent(2, "AftermarketDevicePaired", null.IntFrom(10), 300)
+
+	first := 2
+	res, err := s.repo.GetEventsForDevice(s.ctx, 1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(5, res.TotalCount)
+	s.True(res.PageInfo.HasNextPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal("AftermarketDeviceAddressReset", res.Nodes[0].EventName)
+	s.Equal("AftermarketDevicePaired", res.Nodes[1].EventName)
+	s.Equal(20, *res.Nodes[1].VehicleTokenID)
+
+	// The claim and the first pairing share a block, and come out in processing order.
+	first = 10
+	res, err = s.repo.GetEventsForDevice(s.ctx, 1, &first, res.PageInfo.EndCursor, nil, nil)
+	s.Require().NoError(err)
+
+	s.Require().Len(res.Nodes, 3)
+	s.Equal("AftermarketDeviceUnpaired", res.Nodes[0].EventName)
+	s.Equal("AftermarketDevicePaired", res.Nodes[1].EventName)
+	s.Equal("AftermarketDeviceClaimed", res.Nodes[2].EventName)
+	s.False(res.PageInfo.HasNextPage)
+
+	res, err = s.repo.GetEventsForVehicle(s.ctx, 10, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(3, res.TotalCount)
+	s.Require().Len(res.Nodes, 3)
+	s.Equal(2, res.Nodes[0].AftermarketDeviceTokenID)
+	s.Equal(1, res.Nodes[1].AftermarketDeviceTokenID)
+	s.Equal("AftermarketDeviceUnpaired", res.Nodes[1].EventName)
+	s.Equal(1, res.Nodes[2].AftermarketDeviceTokenID)
+}
+
+func (s *AftermarketHistoryRepoTestSuite) TestGetBeneficiaryAt() {
+	owner := common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	beneficiary := common.HexToAddress("0x55b6D41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	changes := []models.AftermarketDeviceBeneficiary{
+		{EventName: "AftermarketDeviceNodeMinted", Beneficiary: owner.Bytes(), BlockNumber: 100},
+		{EventName: "BeneficiarySet", Beneficiary: beneficiary.Bytes(), Explicit: true, BlockNumber: 200},
+		{EventName: "BeneficiarySet", Beneficiary: owner.Bytes(), BlockNumber: 300},
+	}
+	for _, c := range changes {
+		c.AftermarketDeviceID = 1
+		c.BlockTime = start.Add(time.Duration(c.BlockNumber) * time.Hour)
+		c.TransactionHash = common.BigToHash(big.NewInt(c.BlockNumber)).Bytes()
+		s.Require().NoError(c.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	addr, err := s.repo.GetBeneficiaryAt(s.ctx, 1, start.Add(50*time.Hour))
+	s.Require().NoError(err)
+	s.Nil(addr)
+
+	addr, err = s.repo.GetBeneficiaryAt(s.ctx, 1, start.Add(200*time.Hour))
+	s.Require().NoError(err)
+	s.Equal(&beneficiary, addr)
+
+	addr, err = s.repo.GetBeneficiaryAt(s.ctx, 1, start.Add(1000*time.Hour))
+	s.Require().NoError(err)
+	s.Equal(&owner, addr)
+
+	first := 10
+	history, err := s.repo.GetBeneficiaryHistory(s.ctx, 1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(3, history.TotalCount)
+	s.Require().Len(history.Nodes, 3)
+	s.Equal("BeneficiarySet", history.Nodes[0].EventName)
+	s.False(history.Nodes[0].Explicit)
+	s.True(history.Nodes[1].Explicit)
+	s.Equal(beneficiary, history.Nodes[1].Beneficiary)
+}
@@ -0,0 +1,166 @@
+package aftermarkethistory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func beneficiaryChangeToAPIResponse(b *models.AftermarketDeviceBeneficiary) *gmodel.BeneficiaryChange {
+	return &gmodel.BeneficiaryChange{
+		EventName:       b.EventName,
+		Beneficiary:     common.BytesToAddress(b.Beneficiary),
+		Explicit:        b.Explicit,
+		BlockNumber:     int(b.BlockNumber),
+		BlockTimestamp:  b.BlockTime,
+		TransactionHash: b.TransactionHash,
+	}
+}
+
+// GetBeneficiaryAt returns the beneficiary of the aftermarket device as of the given time, or nil
+// if no change to it had been recorded by then.
+func (r *Repository) GetBeneficiaryAt(ctx context.Context, tokenID int, at time.Time) (*common.Address, error) {
+	b, err := models.AftermarketDeviceBeneficiaries(
+		models.AftermarketDeviceBeneficiaryWhere.AftermarketDeviceID.EQ(tokenID),
+		models.AftermarketDeviceBeneficiaryWhere.BlockTime.LTE(at),
+		qm.OrderBy(models.AftermarketDeviceBeneficiaryColumns.BlockNumber+" DESC, "+models.AftermarketDeviceBeneficiaryColumns.ID+" DESC"),
+	).One(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	addr := common.BytesToAddress(b.Beneficiary)
+	return &addr, nil
+}
+
+// GetBeneficiaryHistory returns the beneficiary changes of the aftermarket device, most recent
+// first.
+func (r *Repository) GetBeneficiaryHistory(ctx context.Context, tokenID int, first *int, after *string, last *int, before *string) (*gmodel.BeneficiaryChangeConnection, error) {
+	pHelp := helpers.PaginationHelper[EventCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	queryMods := []qm.QueryMod{
+		models.AftermarketDeviceBeneficiaryWhere.AftermarketDeviceID.EQ(tokenID),
+	}
+
+	totalCount, err := models.AftermarketDeviceBeneficiaries(queryMods...).Count(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		afterCursor, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Expr(
+				models.AftermarketDeviceBeneficiaryWhere.BlockNumber.EQ(afterCursor.BlockNumber),
+				models.AftermarketDeviceBeneficiaryWhere.ID.LT(afterCursor.ID),
+				qm.Or2(models.AftermarketDeviceBeneficiaryWhere.BlockNumber.LT(afterCursor.BlockNumber)),
+			),
+		)
+	}
+
+	if before != nil {
+		beforeCursor, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Expr(
+				models.AftermarketDeviceBeneficiaryWhere.BlockNumber.EQ(beforeCursor.BlockNumber),
+				models.AftermarketDeviceBeneficiaryWhere.ID.GT(beforeCursor.ID),
+				qm.Or2(models.AftermarketDeviceBeneficiaryWhere.BlockNumber.GT(beforeCursor.BlockNumber)),
+			),
+		)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s DESC", models.AftermarketDeviceBeneficiaryColumns.BlockNumber, models.AftermarketDeviceBeneficiaryColumns.ID)
+	if last != nil {
+		orderBy = fmt.Sprintf("%s ASC, %s ASC", models.AftermarketDeviceBeneficiaryColumns.BlockNumber, models.AftermarketDeviceBeneficiaryColumns.ID)
+	}
+
+	queryMods = append(queryMods,
+		// Use limit + 1 here to check if there's another page.
+		qm.Limit(limit+1),
+		qm.OrderBy(orderBy),
+	)
+
+	page, err := models.AftermarketDeviceBeneficiaries(queryMods...).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(page) == limit+1 {
+		hasNext = true
+		page = page[:limit]
+	} else if last != nil && len(page) == limit+1 {
+		hasPrevious = true
+		page = page[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(page)
+	}
+
+	edges := make([]*gmodel.BeneficiaryChangeEdge, len(page))
+	nodes := make([]*gmodel.BeneficiaryChange, len(page))
+
+	for i, b := range page {
+		crsr, err := pHelp.EncodeCursor(EventCursor{BlockNumber: b.BlockNumber, ID: b.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		gb := beneficiaryChangeToAPIResponse(b)
+
+		edges[i] = &gmodel.BeneficiaryChangeEdge{
+			Node:   gb,
+			Cursor: crsr,
+		}
+		nodes[i] = gb
+	}
+
+	var endCur, startCur *string
+
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.BeneficiaryChangeConnection{
+		TotalCount: int(totalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo: &gmodel.PageInfo{
+			StartCursor:     startCur,
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+)
+
+// The ForOwners listings return one page per owner from a single query, so that listing the
+// assets of many accounts doesn't cost a query per account. The helpers below are shared by them.
+
+// OwnerArray converts the owners into a query argument for "= ANY(?)".
+func OwnerArray(owners []common.Address) pq.ByteaArray {
+	out := make(pq.ByteaArray, len(owners))
+	for i, owner := range owners {
+		out[i] = owner.Bytes()
+	}
+	return out
+}
+
+// Binder is satisfied by every generated query.
+type Binder interface {
+	Bind(ctx context.Context, exec boil.Executor, obj any) error
+}
+
+type ownerCount struct {
+	Owner      []byte `boil:"owner"`
+	TotalCount int64  `boil:"total_count"`
+}
+
+// CountPerOwner counts the rows of the query that belong to each of the owners. ownerColumn
+// holds the owner, and mods should apply the same conditions as the listing.
+func CountPerOwner(ctx context.Context, exec boil.Executor, query func(mods ...qm.QueryMod) Binder, ownerColumn string, owners []common.Address, mods ...qm.QueryMod) (map[common.Address]int64, error) {
+	var rows []ownerCount
+	err := query(append(mods,
+		qm.Select(ownerColumn+" AS owner", "count(*) AS total_count"),
+		qm.Where(ownerColumn+" = ANY(?)", OwnerArray(owners)),
+		qm.GroupBy(ownerColumn),
+	)...).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[common.Address]int64, len(rows))
+	for _, row := range rows {
+		out[common.BytesToAddress(row.Owner)] = row.TotalCount
+	}
+	return out, nil
+}
+
+// RankedPerOwner keeps the first limit rows of each owner, taking the rows of from that satisfy
+// where in the given order. key has to identify a row of from. The owner condition belongs in
+// where, along with the cursor.
+func RankedPerOwner(from, key, ownerColumn, orderBy, where string, limit int, args ...any) qm.QueryMod {
+	return qm.Where(fmt.Sprintf(
+		"%[1]s IN (SELECT ranked_key FROM (SELECT %[1]s AS ranked_key, row_number() OVER (PARTITION BY %[2]s ORDER BY %[3]s) AS rn FROM %[4]s WHERE %[5]s) AS ranked WHERE rn <= ?)",
+		key, ownerColumn, orderBy, from, where,
+	), append(args, limit)...)
+}
+
+// TrimPage takes up to limit + 1 rows in query order, as fetched for a page, and returns the page
+// in the order it's shown along with whether there are rows past either end.
+func TrimPage[T any](rows []T, limit int, first, last *int, after, before *string) ([]T, bool, bool) {
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(rows) == limit+1 {
+		hasNext = true
+		rows = rows[:limit]
+	} else if last != nil && len(rows) == limit+1 {
+		hasPrevious = true
+		rows = rows[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(rows)
+	}
+
+	return rows, hasNext, hasPrevious
+}
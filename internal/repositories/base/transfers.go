@@ -0,0 +1,150 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Every transfer history lists the transfers of one token, most recent first. The tables differ
+// only in the token they point at, so the ordering and pagination below are shared by them.
+
+// TransferColumns names the columns of a transfer table that place a transfer on chain.
+type TransferColumns struct {
+	BlockNumber string
+	LogIndex    string
+	ID          string
+}
+
+// Position is the sort key of a transfer: where it happened on chain, with the row id breaking
+// ties between transfers that have no log index.
+func (c TransferColumns) Position() string {
+	return fmt.Sprintf("(%s, COALESCE(%s, -1), %s)", c.BlockNumber, c.LogIndex, c.ID)
+}
+
+// NewestFirst orders transfers from the most recent to the oldest.
+func (c TransferColumns) NewestFirst() string {
+	return fmt.Sprintf("%s DESC, COALESCE(%s, -1) DESC, %s DESC", c.BlockNumber, c.LogIndex, c.ID)
+}
+
+// OldestFirst orders transfers from the oldest to the most recent.
+func (c TransferColumns) OldestFirst() string {
+	return fmt.Sprintf("%s ASC, COALESCE(%s, -1) ASC, %s ASC", c.BlockNumber, c.LogIndex, c.ID)
+}
+
+// TransferCursor holds the position of a transfer.
+type TransferCursor struct {
+	BlockNumber int64
+	LogIndex    int
+	ID          int64
+}
+
+// NewTransferCursor returns the cursor of the transfer at the given position.
+func NewTransferCursor(blockNumber int64, logIndex null.Int, id int64) TransferCursor {
+	c := TransferCursor{BlockNumber: blockNumber, LogIndex: -1, ID: id}
+	if logIndex.Valid {
+		c.LogIndex = logIndex.Int
+	}
+	return c
+}
+
+// TransferPage is a page of transfers in the order it's shown, with the cursor of each.
+type TransferPage[T any] struct {
+	Rows       []T
+	Cursors    []string
+	TotalCount int64
+	PageInfo   *gmodel.PageInfo
+}
+
+type transferCount struct {
+	TotalCount int64 `boil:"total_count"`
+}
+
+// GetTransferPage fetches a page of the transfers returned by query, which should select the
+// history of a single token. cursor gives the position of a row.
+func GetTransferPage[T any](ctx context.Context, exec boil.Executor, query func(mods ...qm.QueryMod) Binder, cols TransferColumns, cursor func(T) TransferCursor, first *int, after *string, last *int, before *string) (*TransferPage[T], error) {
+	pHelp := helpers.PaginationHelper[TransferCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var count transferCount
+	if err := query(qm.Select("count(*) AS total_count")).Bind(ctx, exec, &count); err != nil {
+		return nil, err
+	}
+
+	page := &TransferPage[T]{TotalCount: count.TotalCount, PageInfo: &gmodel.PageInfo{}}
+	if count.TotalCount == 0 {
+		return page, nil
+	}
+
+	var queryMods []qm.QueryMod
+
+	if after != nil {
+		afterCursor, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Where(cols.Position()+" < (?, ?, ?)", afterCursor.BlockNumber, afterCursor.LogIndex, afterCursor.ID),
+		)
+	}
+
+	if before != nil {
+		beforeCursor, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Where(cols.Position()+" > (?, ?, ?)", beforeCursor.BlockNumber, beforeCursor.LogIndex, beforeCursor.ID),
+		)
+	}
+
+	orderBy := cols.NewestFirst()
+	if last != nil {
+		orderBy = cols.OldestFirst()
+	}
+
+	queryMods = append(queryMods,
+		qm.Limit(limit+1),
+		qm.OrderBy(orderBy),
+	)
+
+	var rows []T
+	if err := query(queryMods...).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return page, nil
+	}
+
+	rows, hasNext, hasPrevious := TrimPage(rows, limit, first, last, after, before)
+
+	page.Rows = rows
+	page.Cursors = make([]string, len(rows))
+	for i, row := range rows {
+		page.Cursors[i], err = pHelp.EncodeCursor(cursor(row))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page.PageInfo = &gmodel.PageInfo{
+		StartCursor:     &page.Cursors[0],
+		EndCursor:       &page.Cursors[len(rows)-1],
+		HasNextPage:     hasNext,
+		HasPreviousPage: hasPrevious,
+	}
+
+	return page, nil
+}
@@ -137,6 +137,82 @@ func (r *Repository) GetConnections(ctx context.Context, first *int, after *stri
 		slices.Reverse(all)
 	}
 
+	return r.createConnectionsResponse(totalCount, all, hasNext, hasPrevious)
+}
+
+// GetConnectionsForOwners returns, for each owner, the connections it owns, paged and ordered as
+// in GetConnections. The connections come from a single query.
+func (r *Repository) GetConnectionsForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.ConnectionConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.Connections(mods...) },
+		models.ConnectionColumns.Owner, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	rankWhere := models.ConnectionColumns.Owner + " = ANY(?)"
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterCur, err := pageHelper.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += fmt.Sprintf(" AND ((%[1]s = ? AND %[2]s > ?) OR %[1]s < ?)", models.ConnectionColumns.MintedAt, models.ConnectionColumns.Address)
+		args = append(args, afterCur.MintedAt, afterCur.Address, afterCur.MintedAt)
+	}
+
+	if before != nil {
+		beforeCur, err := pageHelper.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += fmt.Sprintf(" AND ((%[1]s = ? AND %[2]s < ?) OR %[1]s > ?)", models.ConnectionColumns.MintedAt, models.ConnectionColumns.Address)
+		args = append(args, beforeCur.MintedAt, beforeCur.Address, beforeCur.MintedAt)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s ASC", models.ConnectionColumns.MintedAt, models.ConnectionColumns.Address)
+	if last != nil {
+		orderBy = fmt.Sprintf("%s ASC, %s DESC", models.ConnectionColumns.MintedAt, models.ConnectionColumns.Address)
+	}
+
+	all, err := models.Connections(
+		// Use limit + 1 here to check if there's another page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.Connections), models.ConnectionColumns.ID, models.ConnectionColumns.Owner,
+			orderBy, rankWhere, limit+1, args...),
+		qm.OrderBy(orderBy),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.ConnectionSlice, len(owners))
+	for _, c := range all {
+		owner := common.BytesToAddress(c.Owner)
+		byOwner[owner] = append(byOwner[owner], c)
+	}
+
+	out := make(map[common.Address]*gmodel.ConnectionConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		conn, err := r.createConnectionsResponse(counts[owner], page, hasNext, hasPrevious)
+		if err != nil {
+			return nil, err
+		}
+		out[owner] = conn
+	}
+
+	return out, nil
+}
+
+func (r *Repository) createConnectionsResponse(totalCount int64, all models.ConnectionSlice, hasNext bool, hasPrevious bool) (*gmodel.ConnectionConnection, error) {
 	nodes := make([]*gmodel.Connection, len(all))
 	edges := make([]*gmodel.ConnectionEdge, len(all))
 
@@ -0,0 +1,193 @@
+package contractevent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+func New(repo *base.Repository) *Repository {
+	return &Repository{Repository: repo}
+}
+
+type ContractEventCursor struct {
+	BlockNumber int64
+	LogIndex    int
+	ID          int64
+}
+
+// position is the sort key of an event: where it was emitted on chain. Events without a log
+// index sort first in their block, and the id orders them among themselves.
+var position = fmt.Sprintf("(%s, COALESCE(%s, -1), %s)",
+	models.ContractEventColumns.BlockNumber, models.ContractEventColumns.LogIndex, models.ContractEventColumns.ID)
+
+func eventCursor(ce *models.ContractEvent) ContractEventCursor {
+	c := ContractEventCursor{BlockNumber: ce.BlockNumber, LogIndex: -1, ID: ce.ID}
+	if ce.LogIndex.Valid {
+		c.LogIndex = ce.LogIndex.Int
+	}
+	return c
+}
+
+func (r *Repository) ToAPI(ce *models.ContractEvent) *gmodel.ContractEvent {
+	return &gmodel.ContractEvent{
+		ChainID:         int(ce.ChainID),
+		Contract:        common.BytesToAddress(ce.Contract),
+		EventName:       ce.EventName,
+		EventSignature:  ce.EventSignature,
+		TransactionHash: ce.TransactionHash,
+		BlockNumber:     int(ce.BlockNumber),
+		BlockHash:       ce.BlockHash,
+		BlockTimestamp:  ce.BlockTime,
+		Arguments:       string(ce.Arguments),
+	}
+}
+
+func (r *Repository) GetContractEvents(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *gmodel.ContractEventsFilter) (*gmodel.ContractEventConnection, error) {
+	pHelp := helpers.PaginationHelper[ContractEventCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryMods []qm.QueryMod
+
+	if filterBy != nil {
+		if filterBy.Contract != nil {
+			queryMods = append(queryMods, models.ContractEventWhere.Contract.EQ(filterBy.Contract.Bytes()))
+		}
+		if filterBy.EventName != nil {
+			queryMods = append(queryMods, models.ContractEventWhere.EventName.EQ(*filterBy.EventName))
+		}
+		if filterBy.TxHash != nil {
+			queryMods = append(queryMods, models.ContractEventWhere.TransactionHash.EQ(filterBy.TxHash))
+		}
+		if br := filterBy.BlockRange; br != nil {
+			if br.From != nil {
+				queryMods = append(queryMods, models.ContractEventWhere.BlockNumber.GTE(int64(*br.From)))
+			}
+			if br.To != nil {
+				queryMods = append(queryMods, models.ContractEventWhere.BlockNumber.LTE(int64(*br.To)))
+			}
+		}
+		if filterBy.TokenID != nil {
+			tokenID, err := helpers.ConvertTokenIDToID(filterBy.TokenID)
+			if err != nil {
+				return nil, err
+			}
+			queryMods = append(queryMods, models.ContractEventWhere.TokenID.EQ(null.BytesFrom(tokenID)))
+		}
+	}
+
+	totalCount, err := models.ContractEvents(queryMods...).Count(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		afterCursor, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Where(position+" < (?, ?, ?)", afterCursor.BlockNumber, afterCursor.LogIndex, afterCursor.ID),
+		)
+	}
+
+	if before != nil {
+		beforeCursor, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Where(position+" > (?, ?, ?)", beforeCursor.BlockNumber, beforeCursor.LogIndex, beforeCursor.ID),
+		)
+	}
+
+	// Events can be processed out of chain order, for example when they're retried from the
+	// dead-letter queue, so the id alone doesn't give the order.
+	orderBy := "DESC"
+	if last != nil {
+		orderBy = "ASC"
+	}
+
+	queryMods = append(queryMods,
+		// Use limit + 1 here to check if there's another page.
+		qm.Limit(limit+1),
+		qm.OrderBy(fmt.Sprintf("%[1]s %[4]s, COALESCE(%[2]s, -1) %[4]s, %[3]s %[4]s",
+			models.ContractEventColumns.BlockNumber, models.ContractEventColumns.LogIndex, models.ContractEventColumns.ID, orderBy)),
+	)
+
+	all, err := models.ContractEvents(queryMods...).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(all) == limit+1 {
+		hasNext = true
+		all = all[:limit]
+	} else if last != nil && len(all) == limit+1 {
+		hasPrevious = true
+		all = all[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(all)
+	}
+
+	edges := make([]*gmodel.ContractEventEdge, len(all))
+	nodes := make([]*gmodel.ContractEvent, len(all))
+
+	for i, ce := range all {
+		crsr, err := pHelp.EncodeCursor(eventCursor(ce))
+		if err != nil {
+			return nil, err
+		}
+
+		gce := r.ToAPI(ce)
+
+		edges[i] = &gmodel.ContractEventEdge{
+			Node:   gce,
+			Cursor: crsr,
+		}
+
+		nodes[i] = gce
+	}
+
+	var endCur, startCur *string
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.ContractEventConnection{
+		TotalCount: int(totalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo: &gmodel.PageInfo{
+			StartCursor:     startCur,
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+		},
+	}, nil
+}
@@ -0,0 +1,118 @@
+package contractevent
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type ContractEventRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *ContractEventRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = New(base.NewRepository(s.pdb, s.settings, &logger))
+}
+
+// TearDownTest after each test truncate tables
+func (s *ContractEventRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *ContractEventRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestContractEventRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractEventRepoTestSuite))
+}
+
+func (s *ContractEventRepoTestSuite) insertEvent(name string, blockNumber int64, logIndex null.Int) {
+	ce := models.ContractEvent{
+		CloudEventID:    name,
+		ChainID:         80001,
+		Contract:        common.HexToAddress(s.settings.DIMORegistryAddr).Bytes(),
+		EventName:       name,
+		EventSignature:  common.BigToHash(common.Big1).Bytes(),
+		TransactionHash: common.BigToHash(big.NewInt(blockNumber)).Bytes(),
+		BlockNumber:     blockNumber,
+		BlockHash:       common.BigToHash(big.NewInt(blockNumber)).Bytes(),
+		BlockTime:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Arguments:       types.JSON(`{}`),
+		LogIndex:        logIndex,
+	}
+	s.Require().NoError(ce.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+}
+
+func (s *ContractEventRepoTestSuite) TestGetContractEvents_ChainOrder() {
+	// Inserted in the order they might be processed after a retry, not the order they were
+	// emitted in.
+	s.insertEvent("c", 101, null.IntFrom(2))
+	s.insertEvent("a", 100, null.IntFrom(7))
+	s.insertEvent("d", 101, null.IntFrom(9))
+	s.insertEvent("b", 101, null.Int{})
+
+	first := 2
+	res, err := s.repo.GetContractEvents(s.ctx, &first, nil, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(4, res.TotalCount)
+	s.True(res.PageInfo.HasNextPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal("d", res.Nodes[0].EventName)
+	s.Equal("c", res.Nodes[1].EventName)
+
+	res, err = s.repo.GetContractEvents(s.ctx, &first, res.PageInfo.EndCursor, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.False(res.PageInfo.HasNextPage)
+	s.True(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal("b", res.Nodes[0].EventName)
+	s.Equal("a", res.Nodes[1].EventName)
+
+	last := 3
+	res, err = s.repo.GetContractEvents(s.ctx, nil, nil, &last, res.PageInfo.EndCursor, nil)
+	s.Require().NoError(err)
+
+	s.True(res.PageInfo.HasNextPage)
+	s.False(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 3)
+	s.Equal("d", res.Nodes[0].EventName)
+	s.Equal("c", res.Nodes[1].EventName)
+	s.Equal("b", res.Nodes[2].EventName)
+}
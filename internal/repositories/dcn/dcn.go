@@ -11,6 +11,7 @@ import (
 	"github.com/DIMO-Network/cloudevent"
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/aarondl/null/v8"
@@ -56,7 +57,7 @@ func (r *Repository) ToAPI(d *models.DCN) (*gmodel.Dcn, error) {
 		TokenID:         tokenID,
 	}.String()
 
-	return &gmodel.Dcn{
+	out := &gmodel.Dcn{
 		ID:        globalID,
 		Owner:     common.BytesToAddress(d.OwnerAddress),
 		TokenID:   tokenID,
@@ -66,10 +67,32 @@ func (r *Repository) ToAPI(d *models.DCN) (*gmodel.Dcn, error) {
 		Name:      d.Name.Ptr(),
 		VehicleID: d.VehicleID.Ptr(),
 		MintedAt:  d.MintedAt,
-	}, nil
+		BurnedAt:  d.BurnedAt.Ptr(),
+	}
+
+	if d.BurnTransactionHash.Valid {
+		out.BurnTransactionHash = d.BurnTransactionHash.Bytes
+	}
+
+	return out, nil
+}
+
+// GetDCN looks up a DCN by node, name or DID. Burned DCNs are only returned if includeBurned is
+// set.
+func (r *Repository) GetDCN(ctx context.Context, by gmodel.DCNBy, includeBurned bool) (*gmodel.Dcn, error) {
+	d, err := r.getDCN(ctx, by)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.BurnedAt != nil && !includeBurned {
+		return nil, repositories.ErrNotFound
+	}
+
+	return d, nil
 }
 
-func (r *Repository) GetDCN(ctx context.Context, by gmodel.DCNBy) (*gmodel.Dcn, error) {
+func (r *Repository) getDCN(ctx context.Context, by gmodel.DCNBy) (*gmodel.Dcn, error) {
 	if base.CountTrue(len(by.Node) != 0, by.Name != nil, by.TokenDID != nil) != 1 {
 		return nil, gqlerror.Errorf("Provide exactly one of `name`, `node`, or `tokenDID`.")
 	}
@@ -116,8 +139,11 @@ func (r *Repository) GetDCNByNode(ctx context.Context, node []byte) (*gmodel.Dcn
 }
 
 func (r *Repository) GetDCNByName(ctx context.Context, name string) (*gmodel.Dcn, error) {
+	// A burned name can live on in its tombstone after being given to another node. Prefer the
+	// live one, then the most recently burned.
 	dcn, err := models.DCNS(
 		models.DCNWhere.Name.EQ(null.StringFrom(name)),
+		qm.OrderBy(models.DCNColumns.BurnedAt+" DESC NULLS FIRST"),
 	).One(ctx, r.PDB.DBS().Reader)
 	if err != nil {
 		return nil, err
@@ -129,12 +155,26 @@ func (r *Repository) GetDCNByName(ctx context.Context, name string) (*gmodel.Dcn
 var dcnCursorColumnsTuple = "(" + models.DCNColumns.MintedAt + ", " + models.DCNColumns.Node + ")"
 
 func (r *Repository) GetDCNs(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *gmodel.DCNFilter) (*gmodel.DCNConnection, error) {
+	return r.getDCNs(ctx, false, first, after, last, before, filterBy)
+}
+
+// GetBurnedDCNs lists burned DCNs, using the same filters and ordering as GetDCNs.
+func (r *Repository) GetBurnedDCNs(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *gmodel.DCNFilter) (*gmodel.DCNConnection, error) {
+	return r.getDCNs(ctx, true, first, after, last, before, filterBy)
+}
+
+func (r *Repository) getDCNs(ctx context.Context, burned bool, first *int, after *string, last *int, before *string, filterBy *gmodel.DCNFilter) (*gmodel.DCNConnection, error) {
 	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
 	if err != nil {
 		return nil, err
 	}
 
 	queryMods := []qm.QueryMod{}
+	if burned {
+		queryMods = append(queryMods, models.DCNWhere.BurnedAt.IsNotNull())
+	} else {
+		queryMods = append(queryMods, models.DCNWhere.BurnedAt.IsNull())
+	}
 	if filterBy != nil && filterBy.Owner != nil {
 		queryMods = append(queryMods, models.DCNWhere.OwnerAddress.EQ(filterBy.Owner.Bytes()))
 	}
@@ -169,7 +209,7 @@ func (r *Repository) GetDCNs(ctx context.Context, first *int, after *string, las
 			return nil, err
 		}
 		queryMods = append(queryMods,
-			qm.Where(dcnCursorColumnsTuple+" < (?, ?)", beforeT.MintedAt, beforeT.Node),
+			qm.Where(dcnCursorColumnsTuple+" > (?, ?)", beforeT.MintedAt, beforeT.Node),
 		)
 	}
 
@@ -193,6 +233,81 @@ func (r *Repository) GetDCNs(ctx context.Context, first *int, after *string, las
 		slices.Reverse(all)
 	}
 
+	return r.createDCNsResponse(dcnCount, all, hasNext, hasPrevious)
+}
+
+// GetDCNsForOwners returns, for each owner, the page that GetDCNs would return with an owner
+// filter, using a single query for the names.
+func (r *Repository) GetDCNsForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.DCNConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.DCNS(mods...) },
+		models.DCNColumns.OwnerAddress, owners, models.DCNWhere.BurnedAt.IsNull())
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy := " DESC"
+	if last != nil {
+		orderBy = " ASC"
+	}
+	order := models.DCNColumns.MintedAt + orderBy + ", " + models.DCNColumns.Node + orderBy
+
+	rankWhere := models.DCNColumns.OwnerAddress + " = ANY(?) AND " + models.DCNColumns.BurnedAt + " IS NULL"
+	args := []any{base.OwnerArray(owners)}
+
+	pHelp := &helpers.PaginationHelper[DCNCursor]{}
+	if after != nil {
+		afterT, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+		rankWhere += " AND " + dcnCursorColumnsTuple + " < (?, ?)"
+		args = append(args, afterT.MintedAt, afterT.Node)
+	} else if before != nil {
+		beforeT, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+		rankWhere += " AND " + dcnCursorColumnsTuple + " > (?, ?)"
+		args = append(args, beforeT.MintedAt, beforeT.Node)
+	}
+
+	all, err := models.DCNS(
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.DCNS), models.DCNColumns.Node, models.DCNColumns.OwnerAddress,
+			order, rankWhere, limit+1, args...),
+		qm.OrderBy(order),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.DCNSlice, len(owners))
+	for _, d := range all {
+		owner := common.BytesToAddress(d.OwnerAddress)
+		byOwner[owner] = append(byOwner[owner], d)
+	}
+
+	out := make(map[common.Address]*gmodel.DCNConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		conn, err := r.createDCNsResponse(counts[owner], page, hasNext, hasPrevious)
+		if err != nil {
+			return nil, err
+		}
+		out[owner] = conn
+	}
+
+	return out, nil
+}
+
+func (r *Repository) createDCNsResponse(totalCount int64, all models.DCNSlice, hasNext bool, hasPrevious bool) (*gmodel.DCNConnection, error) {
+	pHelp := &helpers.PaginationHelper[DCNCursor]{}
+
 	edges := make([]*gmodel.DCNEdge, len(all))
 	nodes := make([]*gmodel.Dcn, len(all))
 	var errList gqlerror.List
@@ -221,7 +336,7 @@ func (r *Repository) GetDCNs(ctx context.Context, first *int, after *string, las
 	}
 
 	res := &gmodel.DCNConnection{
-		TotalCount: int(dcnCount),
+		TotalCount: int(totalCount),
 		Edges:      edges,
 		Nodes:      nodes,
 		PageInfo: &gmodel.PageInfo{
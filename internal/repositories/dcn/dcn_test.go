@@ -9,6 +9,7 @@ import (
 	"github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/config"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/DIMO-Network/shared/pkg/db"
@@ -291,3 +292,60 @@ func (o *DCNRepoTestSuite) Test_GetDCNs() {
 		o.Equal(testCase.ExpectedResponse.MintedAt, result.Nodes[0].MintedAt)
 	}
 }
+
+func (o *DCNRepoTestSuite) Test_BurnedDCNs() {
+	_, wallet, err := helpers.GenerateWallet()
+	o.Require().NoError(err)
+
+	live := models.DCN{
+		Node:         helpers.GenerateDCNNode(),
+		OwnerAddress: wallet.Bytes(),
+		Name:         null.StringFrom("reddy.dimo"),
+		MintedAt:     time.Now(),
+	}
+	o.Require().NoError(live.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	// The burned name was later given to another node.
+	burned := models.DCN{
+		Node:                helpers.GenerateDCNNode(),
+		OwnerAddress:        wallet.Bytes(),
+		Name:                null.StringFrom("reddy.dimo"),
+		MintedAt:            time.Now(),
+		BurnedAt:            null.TimeFrom(time.Now()),
+		BurnTransactionHash: null.BytesFrom(common.HexToHash("0x02").Bytes()),
+	}
+	o.Require().NoError(burned.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	first := 10
+
+	res, err := o.repo.GetDCNs(o.ctx, &first, nil, nil, nil, &model.DCNFilter{Owner: wallet})
+	o.Require().NoError(err)
+	o.Require().Len(res.Nodes, 1)
+	o.Equal(live.Node, res.Nodes[0].Node)
+	o.Equal(1, res.TotalCount)
+
+	res, err = o.repo.GetBurnedDCNs(o.ctx, &first, nil, nil, nil, &model.DCNFilter{Owner: wallet})
+	o.Require().NoError(err)
+	o.Require().Len(res.Nodes, 1)
+	o.Equal(burned.Node, res.Nodes[0].Node)
+	o.NotNil(res.Nodes[0].BurnedAt)
+	o.Equal(burned.BurnTransactionHash.Bytes, res.Nodes[0].BurnTransactionHash)
+
+	byOwner, err := o.repo.GetDCNsForOwners(o.ctx, []common.Address{*wallet}, &first, nil, nil, nil)
+	o.Require().NoError(err)
+	o.Require().Len(byOwner[*wallet].Nodes, 1)
+	o.Equal(live.Node, byOwner[*wallet].Nodes[0].Node)
+	o.Equal(1, byOwner[*wallet].TotalCount)
+
+	_, err = o.repo.GetDCN(o.ctx, model.DCNBy{Node: burned.Node}, false)
+	o.ErrorIs(err, repositories.ErrNotFound)
+
+	d, err := o.repo.GetDCN(o.ctx, model.DCNBy{Node: burned.Node}, true)
+	o.Require().NoError(err)
+	o.Equal(*wallet, d.Owner)
+
+	name := "reddy.dimo"
+	d, err = o.repo.GetDCN(o.ctx, model.DCNBy{Name: &name}, false)
+	o.Require().NoError(err)
+	o.Equal(live.Node, d.Node)
+}
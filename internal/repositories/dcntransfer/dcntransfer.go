@@ -0,0 +1,65 @@
+package dcntransfer
+
+import (
+	"context"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+var columns = base.TransferColumns{
+	BlockNumber: models.DCNTransferColumns.BlockNumber,
+	LogIndex:    models.DCNTransferColumns.LogIndex,
+	ID:          models.DCNTransferColumns.ID,
+}
+
+func transferCursor(dt *models.DCNTransfer) base.TransferCursor {
+	return base.NewTransferCursor(dt.BlockNumber, dt.LogIndex, dt.ID)
+}
+
+func transferToAPIResponse(dt *models.DCNTransfer) *gmodel.DCNTransfer {
+	return &gmodel.DCNTransfer{
+		From:            common.BytesToAddress(dt.FromAddress),
+		To:              common.BytesToAddress(dt.ToAddress),
+		BlockNumber:     int(dt.BlockNumber),
+		BlockTimestamp:  dt.BlockTime,
+		TransactionHash: dt.TransactionHash,
+	}
+}
+
+// GetTransfersForDCN returns the transfer history of the DCN with the given node, most recent first.
+func (r *Repository) GetTransfersForDCN(ctx context.Context, node []byte, first *int, after *string, last *int, before *string) (*gmodel.DCNTransferConnection, error) {
+	page, err := base.GetTransferPage(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder {
+			return models.DCNTransfers(append(mods, models.DCNTransferWhere.Node.EQ(node))...)
+		},
+		columns, transferCursor, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gmodel.DCNTransferEdge, len(page.Rows))
+	nodes := make([]*gmodel.DCNTransfer, len(page.Rows))
+
+	for i, dt := range page.Rows {
+		nodes[i] = transferToAPIResponse(dt)
+		edges[i] = &gmodel.DCNTransferEdge{
+			Node:   nodes[i],
+			Cursor: page.Cursors[i],
+		}
+	}
+
+	return &gmodel.DCNTransferConnection{
+		TotalCount: int(page.TotalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   page.PageInfo,
+	}, nil
+}
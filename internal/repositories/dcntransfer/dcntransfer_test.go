@@ -0,0 +1,156 @@
+package dcntransfer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type DCNTransferRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *DCNTransferRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = &Repository{base.NewRepository(s.pdb, s.settings, &logger)}
+}
+
+// TearDownTest after each test truncate tables
+func (s *DCNTransferRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *DCNTransferRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestDCNTransferRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(DCNTransferRepoTestSuite))
+}
+
+var (
+	node1 = common.HexToHash("0x01").Bytes()
+	node2 = common.HexToHash("0x02").Bytes()
+
+	owner1 = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	owner2 = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+func (s *DCNTransferRepoTestSuite) insertTransfers(node []byte, owners []common.Address) []*models.DCNTransfer {
+	var out []*models.DCNTransfer
+	for _, t := range helpers.TransferChain(int64(node[31]), owners) {
+		dt := &models.DCNTransfer{
+			Node:            node,
+			FromAddress:     t.From.Bytes(),
+			ToAddress:       t.To.Bytes(),
+			BlockNumber:     t.BlockNumber,
+			BlockTime:       t.BlockTime,
+			TransactionHash: t.TransactionHash,
+			LogIndex:        null.IntFrom(0),
+		}
+		s.Require().NoError(dt.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+		out = append(out, dt)
+	}
+	return out
+}
+
+// A name is minted and burned by transfers from and to the zero address. Its history spans both,
+// and outlives the name itself, which is only kept as a tombstone.
+func (s *DCNTransferRepoTestSuite) TestGetTransfersForDCN_MintToBurn() {
+	s.insertTransfers(node1, []common.Address{{}, owner1, owner2, {}})
+
+	d := models.DCN{
+		Node:                node1,
+		OwnerAddress:        owner2.Bytes(),
+		MintedAt:            time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+		BurnedAt:            null.TimeFrom(time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)),
+		BurnTransactionHash: null.BytesFrom(common.BigToHash(big.NewInt(1003)).Bytes()),
+	}
+	s.Require().NoError(d.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	first := 1
+	res, err := s.repo.GetTransfersForDCN(s.ctx, node1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(3, res.TotalCount)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(owner2, res.Nodes[0].From)
+	s.Equal(common.Address{}, res.Nodes[0].To)
+	s.Equal(d.BurnTransactionHash.Bytes, res.Nodes[0].TransactionHash)
+
+	last := 1
+	res, err = s.repo.GetTransfersForDCN(s.ctx, node1, nil, nil, &last, nil)
+	s.Require().NoError(err)
+
+	s.True(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(common.Address{}, res.Nodes[0].From)
+	s.Equal(owner1, res.Nodes[0].To)
+}
+
+// Transfers are keyed by node, transaction and log index. A single log can't be recorded twice
+// for a name, but one transaction can move several names.
+func (s *DCNTransferRepoTestSuite) TestGetTransfersForDCN_LogKey() {
+	minted := s.insertTransfers(node1, []common.Address{{}, owner1})
+
+	dup := *minted[0]
+	dup.ID = 0
+	s.Error(dup.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	other := *minted[0]
+	other.ID = 0
+	other.Node = node2
+	s.Require().NoError(other.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	first := 10
+	for _, node := range [][]byte{node1, node2} {
+		res, err := s.repo.GetTransfersForDCN(s.ctx, node, &first, nil, nil, nil)
+		s.Require().NoError(err)
+
+		s.Equal(1, res.TotalCount)
+		s.Require().Len(res.Nodes, 1)
+		s.Equal(minted[0].TransactionHash, res.Nodes[0].TransactionHash)
+	}
+}
+
+func (s *DCNTransferRepoTestSuite) TestGetTransfersForDCN_None() {
+	first := 10
+	res, err := s.repo.GetTransfersForDCN(s.ctx, node1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Zero(res.TotalCount)
+	s.Empty(res.Nodes)
+	s.Empty(res.Edges)
+}
@@ -56,15 +56,17 @@ func (r *Repository) ToAPI(v *models.DeveloperLicense) *gmodel.DeveloperLicense
 
 func SignerToAPI(v *models.Signer) *gmodel.Signer {
 	return &gmodel.Signer{
-		Address:   common.BytesToAddress(v.Signer),
-		EnabledAt: v.EnabledAt,
+		Address:    common.BytesToAddress(v.Signer),
+		EnabledAt:  v.EnabledAt,
+		DisabledAt: v.DisabledAt.Ptr(),
 	}
 }
 
 func RedirectToAPI(v *models.RedirectURI) *gmodel.RedirectURI {
 	return &gmodel.RedirectURI{
-		URI:       v.URI,
-		EnabledAt: v.EnabledAt,
+		URI:        v.URI,
+		EnabledAt:  v.EnabledAt,
+		DisabledAt: v.DisabledAt.Ptr(),
 	}
 }
 
@@ -74,7 +76,9 @@ func (r *Repository) GetDeveloperLicenses(ctx context.Context, first *int, after
 		return nil, err
 	}
 
-	var queryMods []qm.QueryMod
+	queryMods := []qm.QueryMod{
+		models.DeveloperLicenseWhere.BurnedAt.IsNull(),
+	}
 
 	if filterBy != nil {
 		if filterBy.Signer != nil {
@@ -83,6 +87,7 @@ func (r *Repository) GetDeveloperLicenses(ctx context.Context, first *int, after
 					helpers.WithSchema(models.TableNames.Signers)+" ON "+models.DeveloperLicenseColumns.ID+" = "+models.SignerColumns.DeveloperLicenseID,
 				),
 				models.SignerWhere.Signer.EQ(filterBy.Signer.Bytes()),
+				models.SignerWhere.DisabledAt.IsNull(),
 			)
 		}
 		if filterBy.Owner != nil {
@@ -147,6 +152,78 @@ func (r *Repository) GetDeveloperLicenses(ctx context.Context, first *int, after
 		slices.Reverse(all)
 	}
 
+	return r.createDeveloperLicensesResponse(totalCount, all, hasNext, hasPrevious), nil
+}
+
+// GetDeveloperLicensesForOwners returns, for each owner, the page that GetDeveloperLicenses would
+// return with an owner filter, using a single query for the licenses.
+func (r *Repository) GetDeveloperLicensesForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.DeveloperLicenseConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.DeveloperLicenses(mods...) },
+		models.DeveloperLicenseColumns.Owner, owners, models.DeveloperLicenseWhere.BurnedAt.IsNull())
+	if err != nil {
+		return nil, err
+	}
+
+	rankWhere := models.DeveloperLicenseColumns.Owner + " = ANY(?) AND " + models.DeveloperLicenseColumns.BurnedAt + " IS NULL"
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterID, err := helpers.CursorToID(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += " AND " + models.DeveloperLicenseColumns.ID + " < ?"
+		args = append(args, afterID)
+	}
+
+	if before != nil {
+		beforeID, err := helpers.CursorToID(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += " AND " + models.DeveloperLicenseColumns.ID + " > ?"
+		args = append(args, beforeID)
+	}
+
+	orderBy := "DESC"
+	if last != nil {
+		orderBy = "ASC"
+	}
+
+	all, err := models.DeveloperLicenses(
+		// Use limit + 1 here to check if there's another page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.DeveloperLicenses), models.DeveloperLicenseColumns.ID, models.DeveloperLicenseColumns.Owner,
+			models.DeveloperLicenseColumns.ID+" "+orderBy, rankWhere, limit+1, args...),
+		qm.OrderBy(models.DeveloperLicenseColumns.ID+" "+orderBy),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.DeveloperLicenseSlice, len(owners))
+	for _, dl := range all {
+		owner := common.BytesToAddress(dl.Owner)
+		byOwner[owner] = append(byOwner[owner], dl)
+	}
+
+	out := make(map[common.Address]*gmodel.DeveloperLicenseConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		out[owner] = r.createDeveloperLicensesResponse(counts[owner], page, hasNext, hasPrevious)
+	}
+
+	return out, nil
+}
+
+func (r *Repository) createDeveloperLicensesResponse(totalCount int64, all models.DeveloperLicenseSlice, hasNext bool, hasPrevious bool) *gmodel.DeveloperLicenseConnection {
 	var endCur, startCur *string
 	if len(all) != 0 {
 		ec := helpers.IDToCursor(all[len(all)-1].ID)
@@ -170,7 +247,7 @@ func (r *Repository) GetDeveloperLicenses(ctx context.Context, first *int, after
 		nodes[i] = dlv
 	}
 
-	res := &gmodel.DeveloperLicenseConnection{
+	return &gmodel.DeveloperLicenseConnection{
 		Edges: edges,
 		Nodes: nodes,
 		PageInfo: &gmodel.PageInfo{
@@ -181,8 +258,13 @@ func (r *Repository) GetDeveloperLicenses(ctx context.Context, first *int, after
 		},
 		TotalCount: int(totalCount),
 	}
+}
 
-	return res, nil
+func validateHistoryArgs(includeDisabled *bool, activeAt *time.Time) error {
+	if includeDisabled != nil && *includeDisabled && activeAt != nil {
+		return gqlerror.Errorf("`includeDisabled` and `activeAt` can't be used together")
+	}
+	return nil
 }
 
 type SignerCursor struct {
@@ -190,7 +272,10 @@ type SignerCursor struct {
 	Signer    [20]byte
 }
 
-func (r *Repository) GetSignersForLicense(ctx context.Context, obj *gmodel.DeveloperLicense, first *int, after *string, last *int, before *string) (*gmodel.SignerConnection, error) {
+// GetSignersForLicense lists the license's signers. By default only the enabled ones are listed.
+// With includeDisabled every enabled interval is listed, and with activeAt those that contain
+// the given time.
+func (r *Repository) GetSignersForLicense(ctx context.Context, obj *gmodel.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*gmodel.SignerConnection, error) {
 	pHelp := helpers.PaginationHelper[SignerCursor]{}
 
 	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
@@ -198,10 +283,27 @@ func (r *Repository) GetSignersForLicense(ctx context.Context, obj *gmodel.Devel
 		return nil, err
 	}
 
+	if err := validateHistoryArgs(includeDisabled, activeAt); err != nil {
+		return nil, err
+	}
+
 	queryMods := []qm.QueryMod{
 		models.SignerWhere.DeveloperLicenseID.EQ(obj.TokenID),
 	}
 
+	switch {
+	case activeAt != nil:
+		queryMods = append(queryMods,
+			models.SignerWhere.EnabledAt.LTE(*activeAt),
+			qm.Expr(
+				models.SignerWhere.DisabledAt.IsNull(),
+				qm.Or2(models.SignerWhere.DisabledAt.GT(null.TimeFrom(*activeAt))),
+			),
+		)
+	case includeDisabled == nil || !*includeDisabled:
+		queryMods = append(queryMods, models.SignerWhere.DisabledAt.IsNull())
+	}
+
 	totalCount, err := models.Signers(queryMods...).Count(ctx, r.PDB.DBS().Reader)
 	if err != nil {
 		return nil, err
@@ -223,7 +325,7 @@ func (r *Repository) GetSignersForLicense(ctx context.Context, obj *gmodel.Devel
 	}
 
 	if before != nil {
-		beforeCursor, err := pHelp.DecodeCursor(*after)
+		beforeCursor, err := pHelp.DecodeCursor(*before)
 		if err != nil {
 			return nil, err
 		}
@@ -323,7 +425,9 @@ type RedirectCursor struct {
 	URI       string
 }
 
-func (r *Repository) GetRedirectURIsForLicense(ctx context.Context, obj *gmodel.DeveloperLicense, first *int, after *string, last *int, before *string) (*gmodel.RedirectURIConnection, error) {
+// GetRedirectURIsForLicense lists the license's redirect URIs, filtered like
+// GetSignersForLicense.
+func (r *Repository) GetRedirectURIsForLicense(ctx context.Context, obj *gmodel.DeveloperLicense, first *int, after *string, last *int, before *string, includeDisabled *bool, activeAt *time.Time) (*gmodel.RedirectURIConnection, error) {
 	pHelp := helpers.PaginationHelper[RedirectCursor]{}
 
 	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
@@ -331,10 +435,27 @@ func (r *Repository) GetRedirectURIsForLicense(ctx context.Context, obj *gmodel.
 		return nil, err
 	}
 
+	if err := validateHistoryArgs(includeDisabled, activeAt); err != nil {
+		return nil, err
+	}
+
 	queryMods := []qm.QueryMod{
 		models.RedirectURIWhere.DeveloperLicenseID.EQ(obj.TokenID),
 	}
 
+	switch {
+	case activeAt != nil:
+		queryMods = append(queryMods,
+			models.RedirectURIWhere.EnabledAt.LTE(*activeAt),
+			qm.Expr(
+				models.RedirectURIWhere.DisabledAt.IsNull(),
+				qm.Or2(models.RedirectURIWhere.DisabledAt.GT(null.TimeFrom(*activeAt))),
+			),
+		)
+	case includeDisabled == nil || !*includeDisabled:
+		queryMods = append(queryMods, models.RedirectURIWhere.DisabledAt.IsNull())
+	}
+
 	totalCount, err := models.RedirectUris(queryMods...).Count(ctx, r.PDB.DBS().Reader)
 	if err != nil {
 		return nil, err
@@ -356,7 +477,7 @@ func (r *Repository) GetRedirectURIsForLicense(ctx context.Context, obj *gmodel.
 	}
 
 	if before != nil {
-		beforeCursor, err := pHelp.DecodeCursor(*after)
+		beforeCursor, err := pHelp.DecodeCursor(*before)
 		if err != nil {
 			return nil, err
 		}
@@ -484,7 +605,7 @@ func (r *Repository) GetLicense(ctx context.Context, by gmodel.DeveloperLicenseB
 		return nil, fmt.Errorf("invalid filter")
 	}
 
-	dl, err := models.DeveloperLicenses(mod).One(ctx, r.PDB.DBS().Reader)
+	dl, err := models.DeveloperLicenses(mod, models.DeveloperLicenseWhere.BurnedAt.IsNull()).One(ctx, r.PDB.DBS().Reader)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repositories.ErrNotFound
@@ -494,3 +615,53 @@ func (r *Repository) GetLicense(ctx context.Context, by gmodel.DeveloperLicenseB
 
 	return r.ToAPI(dl), nil
 }
+
+var transferColumns = base.TransferColumns{
+	BlockNumber: models.DeveloperLicenseTransferColumns.BlockNumber,
+	LogIndex:    models.DeveloperLicenseTransferColumns.LogIndex,
+	ID:          models.DeveloperLicenseTransferColumns.ID,
+}
+
+func transferCursor(dt *models.DeveloperLicenseTransfer) base.TransferCursor {
+	return base.NewTransferCursor(dt.BlockNumber, dt.LogIndex, dt.ID)
+}
+
+func TransferToAPI(dt *models.DeveloperLicenseTransfer) *gmodel.DeveloperLicenseTransfer {
+	return &gmodel.DeveloperLicenseTransfer{
+		From:            common.BytesToAddress(dt.FromAddress),
+		To:              common.BytesToAddress(dt.ToAddress),
+		BlockNumber:     int(dt.BlockNumber),
+		BlockTimestamp:  dt.BlockTime,
+		TransactionHash: dt.TransactionHash,
+	}
+}
+
+// GetTransfersForLicense returns the transfer history of the license, most recent first.
+func (r *Repository) GetTransfersForLicense(ctx context.Context, obj *gmodel.DeveloperLicense, first *int, after *string, last *int, before *string) (*gmodel.DeveloperLicenseTransferConnection, error) {
+	page, err := base.GetTransferPage(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder {
+			return models.DeveloperLicenseTransfers(append(mods, models.DeveloperLicenseTransferWhere.DeveloperLicenseID.EQ(obj.TokenID))...)
+		},
+		transferColumns, transferCursor, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gmodel.DeveloperLicenseTransferEdge, len(page.Rows))
+	nodes := make([]*gmodel.DeveloperLicenseTransfer, len(page.Rows))
+
+	for i, dt := range page.Rows {
+		nodes[i] = TransferToAPI(dt)
+		edges[i] = &gmodel.DeveloperLicenseTransferEdge{
+			Node:   nodes[i],
+			Cursor: page.Cursors[i],
+		}
+	}
+
+	return &gmodel.DeveloperLicenseTransferConnection{
+		TotalCount: int(page.TotalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   page.PageInfo,
+	}, nil
+}
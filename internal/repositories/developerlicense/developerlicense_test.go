@@ -0,0 +1,148 @@
+package developerlicense
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type DeveloperLicenseRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *DeveloperLicenseRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DevLicenseAddr:      "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = New(base.NewRepository(s.pdb, s.settings, &logger))
+}
+
+// TearDownTest after each test truncate tables
+func (s *DeveloperLicenseRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *DeveloperLicenseRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestDeveloperLicenseRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(DeveloperLicenseRepoTestSuite))
+}
+
+var enabledAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (s *DeveloperLicenseRepoTestSuite) insertLicense() *gmodel.DeveloperLicense {
+	dl := models.DeveloperLicense{
+		ID:       1,
+		Owner:    common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes(),
+		ClientID: common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes(),
+		MintedAt: enabledAt,
+	}
+	s.Require().NoError(dl.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	return s.repo.ToAPI(&dl)
+}
+
+func (s *DeveloperLicenseRepoTestSuite) TestGetSignersForLicense_Backward() {
+	license := s.insertLicense()
+
+	signers := []common.Address{
+		common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		common.HexToAddress("0x0000000000000000000000000000000000000003"),
+	}
+	for i, signer := range signers {
+		sg := models.Signer{
+			DeveloperLicenseID: license.TokenID,
+			Signer:             signer.Bytes(),
+			EnabledAt:          enabledAt.Add(time.Duration(i+1) * time.Hour),
+		}
+		s.Require().NoError(sg.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	// Newest first, so the oldest signer is at the end.
+	last := 1
+	res, err := s.repo.GetSignersForLicense(s.ctx, license, nil, nil, &last, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(3, res.TotalCount)
+	s.False(res.PageInfo.HasNextPage)
+	s.True(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(signers[0], res.Nodes[0].Address)
+
+	last = 2
+	res, err = s.repo.GetSignersForLicense(s.ctx, license, nil, nil, &last, res.PageInfo.StartCursor, nil, nil)
+	s.Require().NoError(err)
+
+	s.True(res.PageInfo.HasNextPage)
+	s.False(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal(signers[2], res.Nodes[0].Address)
+	s.Equal(signers[1], res.Nodes[1].Address)
+}
+
+func (s *DeveloperLicenseRepoTestSuite) TestGetRedirectURIsForLicense_Backward() {
+	license := s.insertLicense()
+
+	uris := []string{"https://a.example", "https://b.example", "https://c.example"}
+	for i, uri := range uris {
+		ru := models.RedirectURI{
+			DeveloperLicenseID: license.TokenID,
+			URI:                uri,
+			EnabledAt:          enabledAt.Add(time.Duration(i+1) * time.Hour),
+		}
+		s.Require().NoError(ru.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	// Newest first, so the oldest URI is at the end.
+	last := 1
+	res, err := s.repo.GetRedirectURIsForLicense(s.ctx, license, nil, nil, &last, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(3, res.TotalCount)
+	s.False(res.PageInfo.HasNextPage)
+	s.True(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(uris[0], res.Nodes[0].URI)
+
+	last = 2
+	res, err = s.repo.GetRedirectURIsForLicense(s.ctx, license, nil, nil, &last, res.PageInfo.StartCursor, nil, nil)
+	s.Require().NoError(err)
+
+	s.True(res.PageInfo.HasNextPage)
+	s.False(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal(uris[2], res.Nodes[0].URI)
+	s.Equal(uris[1], res.Nodes[1].URI)
+}
@@ -0,0 +1,232 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/services/documents"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Repository resolves the permission documents of SACDs and templates and checks them against
+// the grants recorded on-chain.
+type Repository struct {
+	*base.Repository
+	resolver          *documents.Resolver
+	chainID           uint64
+	vehicleAddress    common.Address
+	connectionAddress common.Address
+}
+
+// New creates a new document repository. If resolver is nil, no documents are resolved.
+func New(db *base.Repository, resolver *documents.Resolver) *Repository {
+	return &Repository{
+		Repository:        db,
+		resolver:          resolver,
+		chainID:           uint64(db.Settings.DIMORegistryChainID),
+		vehicleAddress:    common.HexToAddress(db.Settings.VehicleNFTAddr),
+		connectionAddress: common.HexToAddress(db.Settings.ConnectionAddr),
+	}
+}
+
+// GetSacdDocument returns the document that the source of the SACD points to, or nil if the
+// source isn't an IPFS URI.
+func (r *Repository) GetSacdDocument(ctx context.Context, sacd *gmodel.Sacd) (*gmodel.PermissionDocument, error) {
+	doc, err := r.resolve(ctx, sacd.Source)
+	if doc == nil || err != nil {
+		return nil, err
+	}
+
+	out := ToAPI(sacd.Source, doc)
+	out.Mismatches = r.sacdMismatches(sacd, doc)
+	return out, nil
+}
+
+// GetTemplateDocument returns the document stored at the CID of the template.
+func (r *Repository) GetTemplateDocument(ctx context.Context, template *gmodel.Template) (*gmodel.PermissionDocument, error) {
+	doc, err := r.resolve(ctx, template.Cid)
+	if doc == nil || err != nil {
+		return nil, err
+	}
+
+	out := ToAPI(template.Cid, doc)
+	out.Mismatches = templateMismatches(template, doc)
+	return out, nil
+}
+
+func (r *Repository) resolve(ctx context.Context, uri string) (*documents.Document, error) {
+	if r.resolver == nil {
+		return nil, nil
+	}
+
+	doc, err := r.resolver.Resolve(ctx, uri)
+	switch {
+	case err == nil:
+		return doc, nil
+	case errors.Is(err, documents.ErrUnsupportedURI):
+		return nil, nil
+	case errors.Is(err, documents.ErrNotFound):
+		return nil, gqlerror.Errorf("No permission document found at %s.", uri)
+	case errors.Is(err, documents.ErrInvalid), errors.Is(err, documents.ErrTooLarge):
+		return nil, gqlerror.Errorf("Permission document at %s is invalid: %v", uri, err)
+	default:
+		return nil, fmt.Errorf("failed to resolve permission document %s: %w", uri, err)
+	}
+}
+
+// ToAPI converts a parsed document to its API form, without mismatches.
+func ToAPI(uri string, doc *documents.Document) *gmodel.PermissionDocument {
+	out := &gmodel.PermissionDocument{
+		URI:            uri,
+		Grantor:        doc.Grantor,
+		Grantee:        doc.Grantee,
+		EffectiveAt:    doc.EffectiveAt,
+		ExpiresAt:      doc.ExpiresAt,
+		Agreements:     make([]*gmodel.PermissionAgreement, len(doc.Agreements)),
+		Signature:      doc.Signature,
+		SignatureValid: doc.SignatureValid,
+		Mismatches:     []*gmodel.PermissionDocumentMismatch{},
+	}
+
+	if doc.Type != "" {
+		out.Type = &doc.Type
+	}
+
+	for i, a := range doc.Agreements {
+		agreement := &gmodel.PermissionAgreement{
+			Type:        a.Type,
+			Permissions: a.Permissions,
+		}
+		if a.Asset != "" {
+			agreement.Asset = &a.Asset
+		}
+		out.Agreements[i] = agreement
+	}
+
+	return out
+}
+
+func (r *Repository) sacdMismatches(sacd *gmodel.Sacd, doc *documents.Document) []*gmodel.PermissionDocumentMismatch {
+	out := []*gmodel.PermissionDocumentMismatch{}
+
+	if sacd.Scope == gmodel.SacdScopeAccount && doc.Grantor != nil && *doc.Grantor != common.BytesToAddress(sacd.Account) {
+		out = append(out, mismatch(gmodel.PermissionDocumentFieldGrantor, doc.Grantor.Hex(), common.BytesToAddress(sacd.Account).Hex()))
+	}
+
+	if doc.Grantee != nil && *doc.Grantee != sacd.Grantee {
+		out = append(out, mismatch(gmodel.PermissionDocumentFieldGrantee, doc.Grantee.Hex(), sacd.Grantee.Hex()))
+	}
+
+	if asset, ok := r.sacdAsset(sacd); ok {
+		for _, a := range doc.Agreements {
+			if a.Asset == "" {
+				continue
+			}
+			did, err := cloudevent.DecodeERC721DID(a.Asset)
+			if err != nil || did.ChainID != asset.ChainID || did.ContractAddress != asset.ContractAddress || did.TokenID.Cmp(asset.TokenID) != 0 {
+				out = append(out, mismatch(gmodel.PermissionDocumentFieldAsset, a.Asset, asset.String()))
+				break
+			}
+		}
+	}
+
+	if docPerms, chainPerms := documentPermissions(doc), apiPermissions(sacd.PermissionList); !slices.Equal(docPerms, chainPerms) {
+		out = append(out, mismatch(gmodel.PermissionDocumentFieldPermissions, strings.Join(docPerms, ","), strings.Join(chainPerms, ",")))
+	}
+
+	// Expiration times on-chain are whole seconds.
+	if doc.ExpiresAt != nil && doc.ExpiresAt.Unix() != sacd.ExpiresAt.Unix() {
+		out = append(out, mismatch(gmodel.PermissionDocumentFieldExpiresAt, doc.ExpiresAt.UTC().Format(time.RFC3339), sacd.ExpiresAt.UTC().Format(time.RFC3339)))
+	}
+
+	return out
+}
+
+// sacdAsset returns the DID of the token that the SACD was granted on. Account SACDs cover
+// every vehicle of the account, so they have none.
+func (r *Repository) sacdAsset(sacd *gmodel.Sacd) (cloudevent.ERC721DID, bool) {
+	switch {
+	case sacd.Scope == gmodel.SacdScopeVehicle && sacd.VehicleID != nil:
+		return cloudevent.ERC721DID{
+			ChainID:         r.chainID,
+			ContractAddress: r.vehicleAddress,
+			TokenID:         big.NewInt(int64(*sacd.VehicleID)),
+		}, true
+	case sacd.Scope == gmodel.SacdScopeConnection && sacd.ConnectionID != nil:
+		return cloudevent.ERC721DID{
+			ChainID:         r.chainID,
+			ContractAddress: r.connectionAddress,
+			TokenID:         new(big.Int).SetBytes(sacd.ConnectionID),
+		}, true
+	default:
+		return cloudevent.ERC721DID{}, false
+	}
+}
+
+func templateMismatches(template *gmodel.Template, doc *documents.Document) []*gmodel.PermissionDocumentMismatch {
+	out := []*gmodel.PermissionDocumentMismatch{}
+
+	// A template covers any token of its asset contract.
+	for _, a := range doc.Agreements {
+		if a.Asset == "" {
+			continue
+		}
+		did, err := cloudevent.DecodeERC721DID(a.Asset)
+		if err != nil || did.ContractAddress != template.Asset {
+			out = append(out, mismatch(gmodel.PermissionDocumentFieldAsset, a.Asset, template.Asset.Hex()))
+			break
+		}
+	}
+
+	if docPerms, chainPerms := documentPermissions(doc), apiPermissions(template.PermissionList); !slices.Equal(docPerms, chainPerms) {
+		out = append(out, mismatch(gmodel.PermissionDocumentFieldPermissions, strings.Join(docPerms, ","), strings.Join(chainPerms, ",")))
+	}
+
+	return out
+}
+
+// documentPermissions returns the sorted, distinct permissions named across the agreements of
+// the document. Names in the catalog are translated to their API names; others are kept as is,
+// so that they show up as a difference.
+func documentPermissions(doc *documents.Document) []string {
+	var out []string
+	for _, a := range doc.Agreements {
+		for _, name := range a.Permissions {
+			if p, ok := permissions.ByDocumentName(name); ok {
+				name = p.Name
+			}
+			out = append(out, name)
+		}
+	}
+
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+func apiPermissions(perms []*gmodel.Permission) []string {
+	out := make([]string, len(perms))
+	for i, p := range perms {
+		out[i] = string(p.Name)
+	}
+
+	slices.Sort(out)
+	return out
+}
+
+func mismatch(field gmodel.PermissionDocumentField, document, onChain string) *gmodel.PermissionDocumentMismatch {
+	return &gmodel.PermissionDocumentMismatch{
+		Field:    field,
+		Document: &document,
+		OnChain:  &onChain,
+	}
+}
@@ -0,0 +1,170 @@
+package document
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/services/documents"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	vehicleDocCID  = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	templateDocCID = "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"
+)
+
+const vehicleDoc = `{
+  "type": "dimo.sacd",
+  "data": {
+    "grantor": {"address": "0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"},
+    "grantee": {"address": "0x1111111111111111111111111111111111111111"},
+    "expiresAt": "2026-01-01T00:00:00Z",
+    "agreements": [{
+      "type": "permission",
+      "asset": "did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:7",
+      "permissions": [{"name": "privilege:GetNonLocationHistory"}, {"name": "privilege:ExecuteCommands"}]
+    }]
+  }
+}`
+
+const templateDoc = `{
+  "type": "dimo.sacd.template",
+  "data": {
+    "agreements": [{
+      "type": "permission",
+      "asset": "did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:0",
+      "permissions": [{"name": "privilege:GetLocationHistory"}]
+    }]
+  }
+}`
+
+func newTestRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, vehicleDocCID), []byte(vehicleDoc), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, templateDocCID), []byte(templateDoc), 0o600))
+
+	baseRepo := base.NewRepository(db.Store{}, config.Settings{
+		DIMORegistryChainID: 137,
+		VehicleNFTAddr:      "0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF",
+	}, nil)
+
+	return New(baseRepo, documents.NewResolver(documents.FileStore{Dir: dir}, time.Second))
+}
+
+func permissionList(names ...string) []*gmodel.Permission {
+	var perms []permissions.Permission
+	for _, n := range names {
+		p, ok := permissions.ByName(n)
+		if !ok {
+			panic(n)
+		}
+		perms = append(perms, p)
+	}
+	return permissions.ToAPI(perms)
+}
+
+func TestGetSacdDocument(t *testing.T) {
+	repo := newTestRepository(t)
+	vehicleID := 7
+
+	sacd := &gmodel.Sacd{
+		Grantee:        common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		PermissionList: permissionList("NONLOCATION_TELEMETRY", "COMMANDS"),
+		Source:         "ipfs://" + vehicleDocCID,
+		ExpiresAt:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Scope:          gmodel.SacdScopeVehicle,
+		VehicleID:      &vehicleID,
+	}
+
+	doc, err := repo.GetSacdDocument(context.Background(), sacd)
+	require.NoError(t, err)
+	require.NotNil(t, doc)
+	assert.Equal(t, "dimo.sacd", *doc.Type)
+	require.Len(t, doc.Agreements, 1)
+	assert.Equal(t, []string{"privilege:GetNonLocationHistory", "privilege:ExecuteCommands"}, doc.Agreements[0].Permissions)
+	assert.False(t, doc.SignatureValid)
+	assert.Empty(t, doc.Mismatches)
+
+	otherVehicle := 8
+	sacd.VehicleID = &otherVehicle
+	sacd.Grantee = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	sacd.PermissionList = permissionList("NONLOCATION_TELEMETRY")
+	sacd.ExpiresAt = sacd.ExpiresAt.Add(time.Hour)
+
+	doc, err = repo.GetSacdDocument(context.Background(), sacd)
+	require.NoError(t, err)
+
+	fields := make([]gmodel.PermissionDocumentField, len(doc.Mismatches))
+	for i, m := range doc.Mismatches {
+		fields[i] = m.Field
+	}
+	assert.Equal(t, []gmodel.PermissionDocumentField{
+		gmodel.PermissionDocumentFieldGrantee,
+		gmodel.PermissionDocumentFieldAsset,
+		gmodel.PermissionDocumentFieldPermissions,
+		gmodel.PermissionDocumentFieldExpiresAt,
+	}, fields)
+	assert.Equal(t, "COMMANDS,NONLOCATION_TELEMETRY", *doc.Mismatches[2].Document)
+	assert.Equal(t, "NONLOCATION_TELEMETRY", *doc.Mismatches[2].OnChain)
+
+	// Account SACDs are checked against the granting account instead of an asset.
+	sacd = &gmodel.Sacd{
+		Grantee:        common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		PermissionList: permissionList("NONLOCATION_TELEMETRY", "COMMANDS"),
+		Source:         vehicleDocCID,
+		ExpiresAt:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Scope:          gmodel.SacdScopeAccount,
+		Account:        common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes(),
+	}
+
+	doc, err = repo.GetSacdDocument(context.Background(), sacd)
+	require.NoError(t, err)
+	require.Len(t, doc.Mismatches, 1)
+	assert.Equal(t, gmodel.PermissionDocumentFieldGrantor, doc.Mismatches[0].Field)
+
+	// Sources that aren't on IPFS aren't fetched.
+	sacd.Source = "https://example.com/agreement.json"
+	doc, err = repo.GetSacdDocument(context.Background(), sacd)
+	require.NoError(t, err)
+	assert.Nil(t, doc)
+}
+
+func TestGetTemplateDocument(t *testing.T) {
+	repo := newTestRepository(t)
+
+	template := &gmodel.Template{
+		Asset:          common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF"),
+		PermissionList: permissionList("ALLTIME_LOCATION"),
+		Cid:            templateDocCID,
+	}
+
+	doc, err := repo.GetTemplateDocument(context.Background(), template)
+	require.NoError(t, err)
+	assert.Empty(t, doc.Mismatches)
+
+	template.Asset = common.HexToAddress("0x4444444444444444444444444444444444444444")
+	doc, err = repo.GetTemplateDocument(context.Background(), template)
+	require.NoError(t, err)
+	require.Len(t, doc.Mismatches, 1)
+	assert.Equal(t, gmodel.PermissionDocumentFieldAsset, doc.Mismatches[0].Field)
+
+	template.Cid = "QmSrPmbaUKA3ZodhzPWZnpFgcPMFWF4QsxXbkWfEptTBJd"
+	_, err = repo.GetTemplateDocument(context.Background(), template)
+	require.Error(t, err)
+
+	doc, err = New(repo.Repository, nil).GetTemplateDocument(context.Background(), template)
+	require.NoError(t, err)
+	assert.Nil(t, doc)
+}
@@ -0,0 +1,392 @@
+// Package grantee lists the grants held by an address, across every kind of asset.
+package grantee
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/accountsacd"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/repositories/connectionsacd"
+	"github.com/DIMO-Network/identity-api/internal/repositories/vehiclesacd"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+// Ranks order SACDs that were set at the same time.
+const (
+	rankVehicle = iota
+	rankAccount
+	rankConnection
+)
+
+// SacdCursor orders SACDs newest first, then by the kind of asset, then by the asset itself.
+// Vehicles are identified by VehicleID, and accounts and connections by Asset.
+type SacdCursor struct {
+	CreatedAt time.Time
+	Rank      int
+	VehicleID int
+	Asset     []byte
+}
+
+func compareSacdCursors(a, b SacdCursor) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Rank, b.Rank); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.VehicleID, b.VehicleID); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.Asset, b.Asset)
+}
+
+// received is a SACD held by the grantee, already converted.
+type received struct {
+	cursor     SacdCursor
+	templateID null.Bytes
+	node       *gmodel.ReceivedSacd
+}
+
+// sacdTable is one of the tables that hold SACDs. Column names are qualified with the table.
+type sacdTable struct {
+	scope      gmodel.SacdScope
+	rank       int
+	grantee    string
+	createdAt  string
+	expiresAt  string
+	templateID string
+	asset      string
+	assetArg   func(c *SacdCursor) any
+	count      func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) (int64, error)
+	all        func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ([]*received, error)
+}
+
+var sacdTables = []*sacdTable{
+	{
+		scope:      gmodel.SacdScopeVehicle,
+		rank:       rankVehicle,
+		grantee:    models.VehicleSacdTableColumns.Grantee,
+		createdAt:  models.VehicleSacdTableColumns.CreatedAt,
+		expiresAt:  models.VehicleSacdTableColumns.ExpiresAt,
+		templateID: models.VehicleSacdTableColumns.TemplateID,
+		asset:      models.VehicleSacdTableColumns.VehicleID,
+		assetArg:   func(c *SacdCursor) any { return c.VehicleID },
+		count: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) (int64, error) {
+			return models.VehicleSacds(mods...).Count(ctx, exec)
+		},
+		all: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ([]*received, error) {
+			sacds, err := models.VehicleSacds(mods...).All(ctx, exec)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]*received, len(sacds))
+			for i, s := range sacds {
+				sacd, err := vehiclesacd.SacdToAPI(s)
+				if err != nil {
+					return nil, err
+				}
+				out[i] = &received{
+					cursor:     SacdCursor{CreatedAt: s.CreatedAt, Rank: rankVehicle, VehicleID: s.VehicleID},
+					templateID: s.TemplateID,
+					node:       &gmodel.ReceivedSacd{Scope: gmodel.SacdScopeVehicle, VehicleTokenID: &s.VehicleID, Sacd: sacd},
+				}
+			}
+			return out, nil
+		},
+	},
+	{
+		scope:      gmodel.SacdScopeAccount,
+		rank:       rankAccount,
+		grantee:    models.AccountSacdTableColumns.Grantee,
+		createdAt:  models.AccountSacdTableColumns.CreatedAt,
+		expiresAt:  models.AccountSacdTableColumns.ExpiresAt,
+		templateID: models.AccountSacdTableColumns.TemplateID,
+		asset:      models.AccountSacdTableColumns.Account,
+		assetArg:   func(c *SacdCursor) any { return c.Asset },
+		count: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) (int64, error) {
+			return models.AccountSacds(mods...).Count(ctx, exec)
+		},
+		all: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ([]*received, error) {
+			sacds, err := models.AccountSacds(mods...).All(ctx, exec)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]*received, len(sacds))
+			for i, s := range sacds {
+				sacd, err := accountsacd.SacdToAPI(s)
+				if err != nil {
+					return nil, err
+				}
+				account := common.BytesToAddress(s.Account)
+				out[i] = &received{
+					cursor:     SacdCursor{CreatedAt: s.CreatedAt, Rank: rankAccount, Asset: s.Account},
+					templateID: s.TemplateID,
+					node:       &gmodel.ReceivedSacd{Scope: gmodel.SacdScopeAccount, Account: &account, Sacd: sacd},
+				}
+			}
+			return out, nil
+		},
+	},
+	{
+		scope:      gmodel.SacdScopeConnection,
+		rank:       rankConnection,
+		grantee:    models.ConnectionSacdTableColumns.Grantee,
+		createdAt:  models.ConnectionSacdTableColumns.CreatedAt,
+		expiresAt:  models.ConnectionSacdTableColumns.ExpiresAt,
+		templateID: models.ConnectionSacdTableColumns.TemplateID,
+		asset:      models.ConnectionSacdTableColumns.ConnectionID,
+		assetArg:   func(c *SacdCursor) any { return c.Asset },
+		count: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) (int64, error) {
+			return models.ConnectionSacds(mods...).Count(ctx, exec)
+		},
+		all: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ([]*received, error) {
+			sacds, err := models.ConnectionSacds(mods...).All(ctx, exec)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]*received, len(sacds))
+			for i, s := range sacds {
+				sacd, err := connectionsacd.SacdToAPI(s)
+				if err != nil {
+					return nil, err
+				}
+				out[i] = &received{
+					cursor:     SacdCursor{CreatedAt: s.CreatedAt, Rank: rankConnection, Asset: s.ConnectionID},
+					templateID: s.TemplateID,
+					node:       &gmodel.ReceivedSacd{Scope: gmodel.SacdScopeConnection, ConnectionTokenID: new(big.Int).SetBytes(s.ConnectionID), Sacd: sacd},
+				}
+			}
+			return out, nil
+		},
+	},
+}
+
+func (t *sacdTable) filterMods(grantee common.Address, filter *gmodel.ReceivedSacdsFilter, templateID []byte) []qm.QueryMod {
+	expiresAfter := time.Now()
+	if filter != nil && filter.ExpiresAfter != nil {
+		expiresAfter = *filter.ExpiresAfter
+	}
+
+	mods := []qm.QueryMod{
+		qm.Where(t.grantee+" = ?", grantee.Bytes()),
+		qm.Where(t.expiresAt+" > ?", expiresAfter),
+	}
+	if filter != nil && filter.ExpiresBefore != nil {
+		mods = append(mods, qm.Where(t.expiresAt+" < ?", *filter.ExpiresBefore))
+	}
+	if templateID != nil {
+		mods = append(mods, qm.Where(t.templateID+" = ?", templateID))
+	}
+
+	return mods
+}
+
+// pageMod restricts the table to the SACDs that come after the cursor, or before it if before
+// is set.
+func (t *sacdTable) pageMod(c *SacdCursor, before bool) qm.QueryMod {
+	older, assetOp := "<", ">"
+	// Whether the rows of this table set at the same time as the cursor are on the requested side.
+	sameTime := t.rank > c.Rank
+	if before {
+		older, assetOp = ">", "<"
+		sameTime = t.rank < c.Rank
+	}
+
+	switch {
+	case t.rank == c.Rank:
+		return qm.Where(
+			fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND %[3]s %[4]s ?)", t.createdAt, older, t.asset, assetOp),
+			c.CreatedAt, c.CreatedAt, t.assetArg(c),
+		)
+	case sameTime:
+		return qm.Where(fmt.Sprintf("%s %s= ?", t.createdAt, older), c.CreatedAt)
+	default:
+		return qm.Where(fmt.Sprintf("%s %s ?", t.createdAt, older), c.CreatedAt)
+	}
+}
+
+// GetReceivedSacds lists the SACDs held by the grantee on vehicles, accounts and connections,
+// newest first.
+func (r *Repository) GetReceivedSacds(ctx context.Context, grantee common.Address, first *int, after *string, last *int, before *string, filterBy *gmodel.ReceivedSacdsFilter) (*gmodel.ReceivedSacdConnection, error) {
+	pHelp := helpers.PaginationHelper[SacdCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var afterCursor, beforeCursor *SacdCursor
+	if after != nil {
+		afterCursor, err = pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if before != nil {
+		beforeCursor, err = pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var templateID []byte
+	if filterBy != nil && filterBy.TemplateID != nil {
+		templateID, err = helpers.ConvertTokenIDToID(filterBy.TemplateID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var totalCount int64
+	var page []*received
+
+	for _, t := range sacdTables {
+		if filterBy != nil && filterBy.Scope != nil && *filterBy.Scope != t.scope {
+			continue
+		}
+
+		mods := t.filterMods(grantee, filterBy, templateID)
+
+		count, err := t.count(ctx, r.PDB.DBS().Reader, mods)
+		if err != nil {
+			return nil, err
+		}
+		totalCount += count
+
+		if afterCursor != nil {
+			mods = append(mods, t.pageMod(afterCursor, false))
+		}
+		if beforeCursor != nil {
+			mods = append(mods, t.pageMod(beforeCursor, true))
+		}
+
+		orderBy := fmt.Sprintf("%s DESC, %s ASC", t.createdAt, t.asset)
+		if last != nil {
+			orderBy = fmt.Sprintf("%s ASC, %s DESC", t.createdAt, t.asset)
+		}
+
+		// Any one table may fill the page, so each is asked for a full page plus one.
+		rows, err := t.all(ctx, r.PDB.DBS().Reader, append(mods, qm.Limit(limit+1), qm.OrderBy(orderBy)))
+		if err != nil {
+			return nil, err
+		}
+		page = append(page, rows...)
+	}
+
+	slices.SortFunc(page, func(a, b *received) int {
+		if last != nil {
+			return compareSacdCursors(b.cursor, a.cursor)
+		}
+		return compareSacdCursors(a.cursor, b.cursor)
+	})
+
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(page) > limit {
+		hasNext = true
+		page = page[:limit]
+	} else if last != nil && len(page) > limit {
+		hasPrevious = true
+		page = page[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(page)
+	}
+
+	if err := r.loadTemplates(ctx, page); err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gmodel.ReceivedSacdEdge, len(page))
+	nodes := make([]*gmodel.ReceivedSacd, len(page))
+
+	for i, rs := range page {
+		crsr, err := pHelp.EncodeCursor(rs.cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		edges[i] = &gmodel.ReceivedSacdEdge{
+			Node:   rs.node,
+			Cursor: crsr,
+		}
+		nodes[i] = rs.node
+	}
+
+	var endCur, startCur *string
+
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.ReceivedSacdConnection{
+		TotalCount: int(totalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo: &gmodel.PageInfo{
+			StartCursor:     startCur,
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+		},
+	}, nil
+}
+
+func (r *Repository) loadTemplates(ctx context.Context, page []*received) error {
+	var templateIDs []any
+	for _, rs := range page {
+		if rs.templateID.Valid {
+			templateIDs = append(templateIDs, rs.templateID.Bytes)
+		}
+	}
+
+	if len(templateIDs) == 0 {
+		return nil
+	}
+
+	templates, err := models.Templates(
+		qm.WhereIn(models.TemplateColumns.ID+" IN ?", templateIDs...),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return fmt.Errorf("failed to load templates: %w", err)
+	}
+
+	byID := make(map[string]*models.Template, len(templates))
+	for _, t := range templates {
+		byID[string(t.ID)] = t
+	}
+
+	for _, rs := range page {
+		if !rs.templateID.Valid {
+			continue
+		}
+		if t, ok := byID[string(rs.templateID.Bytes)]; ok {
+			rs.node.Sacd.Template, err = vehiclesacd.TemplateToAPI(t)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package grantee
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+var (
+	owner   = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	grantee = common.HexToAddress("0x1234567890123456789012345678901234567890")
+)
+
+type GranteeRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *GranteeRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = &Repository{base.NewRepository(s.pdb, s.settings, &logger)}
+}
+
+// TearDownTest after each test truncate tables
+func (s *GranteeRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *GranteeRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestGranteeRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(GranteeRepoTestSuite))
+}
+
+func (s *GranteeRepoTestSuite) insertVehicles(ids ...int) {
+	m := models.Manufacturer{
+		ID:    131,
+		Name:  "Toyota",
+		Owner: owner.Bytes(),
+		Slug:  "toyota",
+	}
+	s.Require().NoError(m.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	for _, id := range ids {
+		v := models.Vehicle{
+			ID:             id,
+			ManufacturerID: 131,
+			OwnerAddress:   owner.Bytes(),
+			MintedAt:       time.Now(),
+		}
+		s.Require().NoError(v.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+}
+
+func (s *GranteeRepoTestSuite) TestGetReceivedSacds() {
+	s.insertVehicles(1, 2)
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	expiresAt := createdAt.Add(time.Hour)
+
+	templateID, err := helpers.ConvertTokenIDToID(big.NewInt(7))
+	s.Require().NoError(err)
+	tmpl := models.Template{
+		ID:          templateID,
+		Creator:     owner.Bytes(),
+		Asset:       owner.Bytes(),
+		Permissions: "1100",
+		Cid:         "QmTemplate",
+		CreatedAt:   createdAt,
+	}
+	s.Require().NoError(tmpl.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	connID, err := helpers.ConvertTokenIDToID(big.NewInt(9))
+	s.Require().NoError(err)
+	conn := models.Connection{
+		Address:  common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes(),
+		Owner:    owner.Bytes(),
+		MintedAt: createdAt,
+		ID:       connID,
+	}
+	s.Require().NoError(conn.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	// All set at the same time, except vehicle-1, which is older.
+	vehicleSacds := []models.VehicleSacd{
+		{VehicleID: 2, Grantee: grantee.Bytes(), Permissions: "1100", Source: "vehicle-2", CreatedAt: createdAt, ExpiresAt: expiresAt, TemplateID: null.BytesFrom(templateID)},
+		{VehicleID: 1, Grantee: grantee.Bytes(), Permissions: "1100", Source: "vehicle-1", CreatedAt: createdAt.Add(-time.Minute), ExpiresAt: expiresAt},
+		// Expired.
+		{VehicleID: 1, Grantee: owner.Bytes(), Permissions: "1100", Source: "other-grantee", CreatedAt: createdAt.Add(-time.Hour), ExpiresAt: createdAt.Add(-time.Minute)},
+	}
+	for _, vs := range vehicleSacds {
+		s.Require().NoError(vs.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	as := models.AccountSacd{Account: owner.Bytes(), Grantee: grantee.Bytes(), Permissions: "1100", Source: "account", CreatedAt: createdAt, ExpiresAt: expiresAt.Add(time.Hour)}
+	s.Require().NoError(as.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	cs := models.ConnectionSacd{ConnectionID: connID, Grantee: grantee.Bytes(), Permissions: "1100", Source: "connection", CreatedAt: createdAt, ExpiresAt: expiresAt}
+	s.Require().NoError(cs.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	sources := func(res *gmodel.ReceivedSacdConnection) []string {
+		out := make([]string, len(res.Nodes))
+		for i, n := range res.Nodes {
+			out[i] = n.Sacd.Source
+		}
+		return out
+	}
+
+	first := 2
+	res, err := s.repo.GetReceivedSacds(s.ctx, grantee, &first, nil, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Equal(4, res.TotalCount)
+	s.Equal([]string{"vehicle-2", "account"}, sources(res))
+	s.Equal(2, *res.Nodes[0].VehicleTokenID)
+	s.Require().NotNil(res.Nodes[0].Sacd.Template)
+	s.Equal("QmTemplate", res.Nodes[0].Sacd.Template.Cid)
+	s.Equal(owner, *res.Nodes[1].Account)
+	s.True(res.PageInfo.HasNextPage)
+
+	res, err = s.repo.GetReceivedSacds(s.ctx, grantee, &first, res.PageInfo.EndCursor, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Equal([]string{"connection", "vehicle-1"}, sources(res))
+	s.Equal(big.NewInt(9), res.Nodes[0].ConnectionTokenID)
+	s.False(res.PageInfo.HasNextPage)
+
+	res, err = s.repo.GetReceivedSacds(s.ctx, grantee, nil, nil, &first, res.PageInfo.StartCursor, nil)
+	s.Require().NoError(err)
+	s.Equal([]string{"vehicle-2", "account"}, sources(res))
+	s.False(res.PageInfo.HasPreviousPage)
+
+	scope := gmodel.SacdScopeVehicle
+	res, err = s.repo.GetReceivedSacds(s.ctx, grantee, &first, nil, nil, nil, &gmodel.ReceivedSacdsFilter{Scope: &scope})
+	s.Require().NoError(err)
+	s.Equal(2, res.TotalCount)
+	s.Equal([]string{"vehicle-2", "vehicle-1"}, sources(res))
+
+	res, err = s.repo.GetReceivedSacds(s.ctx, grantee, &first, nil, nil, nil, &gmodel.ReceivedSacdsFilter{TemplateID: big.NewInt(7)})
+	s.Require().NoError(err)
+	s.Equal([]string{"vehicle-2"}, sources(res))
+
+	after := expiresAt.Add(time.Minute)
+	res, err = s.repo.GetReceivedSacds(s.ctx, grantee, &first, nil, nil, nil, &gmodel.ReceivedSacdsFilter{ExpiresAfter: &after})
+	s.Require().NoError(err)
+	s.Equal([]string{"account"}, sources(res))
+
+	res, err = s.repo.GetReceivedSacds(s.ctx, owner, &first, nil, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Zero(res.TotalCount)
+
+	// Include expired grants.
+	since := createdAt.Add(-2 * time.Hour)
+	res, err = s.repo.GetReceivedSacds(s.ctx, owner, &first, nil, nil, nil, &gmodel.ReceivedSacdsFilter{ExpiresAfter: &since})
+	s.Require().NoError(err)
+	s.Equal([]string{"other-grantee"}, sources(res))
+}
+
+func (s *GranteeRepoTestSuite) TestGetReceivedPrivileges() {
+	s.insertVehicles(1, 2)
+
+	setAt := time.Now().UTC().Truncate(time.Second)
+
+	privs := []models.Privilege{
+		{TokenID: 1, PrivilegeID: 1, UserAddress: grantee.Bytes(), SetAt: setAt, ExpiresAt: setAt.Add(time.Hour)},
+		{TokenID: 1, PrivilegeID: 4, UserAddress: grantee.Bytes(), SetAt: setAt, ExpiresAt: setAt.Add(2 * time.Hour)},
+		{TokenID: 2, PrivilegeID: 1, UserAddress: grantee.Bytes(), SetAt: setAt.Add(-time.Minute), ExpiresAt: setAt.Add(time.Hour)},
+		{TokenID: 2, PrivilegeID: 4, UserAddress: grantee.Bytes(), SetAt: setAt, ExpiresAt: setAt.Add(-time.Minute)},
+	}
+	for _, p := range privs {
+		s.Require().NoError(p.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	first := 2
+	res, err := s.repo.GetReceivedPrivileges(s.ctx, grantee, &first, nil, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Equal(3, res.TotalCount)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal(1, res.Nodes[0].VehicleTokenID)
+	s.Equal(1, res.Nodes[0].Privilege.ID)
+	s.Equal(4, res.Nodes[1].Privilege.ID)
+	s.Equal(gmodel.PermissionNameAlltimeLocation, res.Nodes[1].Privilege.PermissionList[0].Name)
+
+	res, err = s.repo.GetReceivedPrivileges(s.ctx, grantee, &first, res.PageInfo.EndCursor, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(2, res.Nodes[0].VehicleTokenID)
+	s.False(res.PageInfo.HasNextPage)
+
+	id := 4
+	res, err = s.repo.GetReceivedPrivileges(s.ctx, grantee, &first, nil, nil, nil, &gmodel.ReceivedPrivilegesFilter{PrivilegeID: &id})
+	s.Require().NoError(err)
+	s.Equal(1, res.TotalCount)
+
+	before := setAt.Add(90 * time.Minute)
+	res, err = s.repo.GetReceivedPrivileges(s.ctx, grantee, &first, nil, nil, nil, &gmodel.ReceivedPrivilegesFilter{ExpiresBefore: &before})
+	s.Require().NoError(err)
+	s.Equal(2, res.TotalCount)
+}
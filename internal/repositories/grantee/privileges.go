@@ -0,0 +1,157 @@
+package grantee
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/internal/repositories/vehicleprivilege"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PrivilegeCursor orders privileges newest first, then by vehicle and privilege id.
+type PrivilegeCursor struct {
+	SetAt       time.Time
+	TokenID     int
+	PrivilegeID int
+}
+
+// privilegeKeyClause compares privileges to a cursor. Past the time, the vehicle and privilege
+// ids are compared as a row.
+func privilegeKeyClause(timeOp, idOp string) string {
+	return fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND (%[3]s, %[4]s) %[5]s (?, ?))",
+		models.PrivilegeTableColumns.SetAt, timeOp, models.PrivilegeTableColumns.TokenID, models.PrivilegeTableColumns.PrivilegeID, idOp)
+}
+
+// GetReceivedPrivileges lists the legacy privileges held by the grantee, newest first.
+func (r *Repository) GetReceivedPrivileges(ctx context.Context, grantee common.Address, first *int, after *string, last *int, before *string, filterBy *gmodel.ReceivedPrivilegesFilter) (*gmodel.ReceivedPrivilegeConnection, error) {
+	pHelp := helpers.PaginationHelper[PrivilegeCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAfter := time.Now()
+	if filterBy != nil && filterBy.ExpiresAfter != nil {
+		expiresAfter = *filterBy.ExpiresAfter
+	}
+
+	queryMods := []qm.QueryMod{
+		models.PrivilegeWhere.UserAddress.EQ(grantee.Bytes()),
+		models.PrivilegeWhere.ExpiresAt.GT(expiresAfter),
+	}
+	if filterBy != nil {
+		if filterBy.ExpiresBefore != nil {
+			queryMods = append(queryMods, models.PrivilegeWhere.ExpiresAt.LT(*filterBy.ExpiresBefore))
+		}
+		if filterBy.PrivilegeID != nil {
+			queryMods = append(queryMods, models.PrivilegeWhere.PrivilegeID.EQ(*filterBy.PrivilegeID))
+		}
+	}
+
+	totalCount, err := models.Privileges(queryMods...).Count(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		afterCursor, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Where(privilegeKeyClause("<", ">"), afterCursor.SetAt, afterCursor.SetAt, afterCursor.TokenID, afterCursor.PrivilegeID),
+		)
+	}
+
+	if before != nil {
+		beforeCursor, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Where(privilegeKeyClause(">", "<"), beforeCursor.SetAt, beforeCursor.SetAt, beforeCursor.TokenID, beforeCursor.PrivilegeID),
+		)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s ASC, %s ASC", models.PrivilegeColumns.SetAt, models.PrivilegeColumns.TokenID, models.PrivilegeColumns.PrivilegeID)
+	if last != nil {
+		orderBy = fmt.Sprintf("%s ASC, %s DESC, %s DESC", models.PrivilegeColumns.SetAt, models.PrivilegeColumns.TokenID, models.PrivilegeColumns.PrivilegeID)
+	}
+
+	queryMods = append(queryMods,
+		// Use limit + 1 here to check if there's another page.
+		qm.Limit(limit+1),
+		qm.OrderBy(orderBy),
+	)
+
+	page, err := models.Privileges(queryMods...).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(page) == limit+1 {
+		hasNext = true
+		page = page[:limit]
+	} else if last != nil && len(page) == limit+1 {
+		hasPrevious = true
+		page = page[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(page)
+	}
+
+	edges := make([]*gmodel.ReceivedPrivilegeEdge, len(page))
+	nodes := make([]*gmodel.ReceivedPrivilege, len(page))
+
+	for i, p := range page {
+		crsr, err := pHelp.EncodeCursor(PrivilegeCursor{SetAt: p.SetAt, TokenID: p.TokenID, PrivilegeID: p.PrivilegeID})
+		if err != nil {
+			return nil, err
+		}
+
+		node := &gmodel.ReceivedPrivilege{
+			VehicleTokenID: p.TokenID,
+			Privilege:      vehicleprivilege.PrivilegeToAPI(p),
+		}
+
+		edges[i] = &gmodel.ReceivedPrivilegeEdge{
+			Node:   node,
+			Cursor: crsr,
+		}
+		nodes[i] = node
+	}
+
+	var endCur, startCur *string
+
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.ReceivedPrivilegeConnection{
+		TotalCount: int(totalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo: &gmodel.PageInfo{
+			StartCursor:     startCur,
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+		},
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aarondl/sqlboiler/v4/types"
 	"github.com/ericlagergren/decimal"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 )
 
 // weiPerEther is the number of wei in an ether.
@@ -265,6 +266,53 @@ func (r *Repository) GetEarningsByUserAddress(ctx context.Context, user common.A
 	}, nil
 }
 
+// GetEarningsByUserAddresses summarizes the earnings of several users in one query. Users
+// that never received rewards get an empty summary, as in GetEarningsByUserAddress.
+func (r *Repository) GetEarningsByUserAddresses(ctx context.Context, users []common.Address) (map[common.Address]*gmodel.UserRewards, error) {
+	userBytes := make([][]byte, len(users))
+	for i, user := range users {
+		userBytes[i] = user.Bytes()
+	}
+
+	var summaries []struct {
+		ReceivedByAddress []byte `boil:"received_by_address"`
+		EarningsSummary   `boil:",bind"`
+	}
+
+	err := models.Rewards(
+		qm.Select(
+			models.RewardColumns.ReceivedByAddress,
+			fmt.Sprintf(
+				"COALESCE(sum(%s + %s + %s), 0) as token_sum",
+				models.RewardColumns.StreakEarnings, models.RewardColumns.AftermarketEarnings, models.RewardColumns.SyntheticEarnings,
+			),
+			"count(*) as total_count",
+		),
+		qm.Where(models.RewardColumns.ReceivedByAddress+" = ANY(?)", pq.ByteaArray(userBytes)),
+		qm.GroupBy(models.RewardColumns.ReceivedByAddress),
+	).Bind(ctx, r.PDB.DBS().Reader, &summaries)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[common.Address]*gmodel.UserRewards, len(users))
+	for _, user := range users {
+		out[user] = &gmodel.UserRewards{
+			TotalTokens: new(decimal.Big),
+			History:     &gmodel.EarningsConnection{},
+			User:        user,
+		}
+	}
+
+	for _, s := range summaries {
+		user := common.BytesToAddress(s.ReceivedByAddress)
+		out[user].TotalTokens = weiToToken(s.TokenSum)
+		out[user].History.TotalCount = s.TotalCount
+	}
+
+	return out, nil
+}
+
 func (r *Repository) PaginateGetEarningsByUsersDevices(ctx context.Context, userDeviceEarnings *gmodel.UserRewards, first *int, after *string, last *int, before *string) (*gmodel.EarningsConnection, error) {
 	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize) // return early if both first and last are provided
 	if err != nil {
@@ -0,0 +1,198 @@
+package sacdhistory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+// EntryCursor orders entries by the time of the change, and then by the order in which they were
+// recorded.
+type EntryCursor struct {
+	ChangedAt time.Time
+	ID        int64
+}
+
+func entryToAPIResponse(h *models.SacdHistory) (*gmodel.SacdHistoryEntry, error) {
+	entry := &gmodel.SacdHistoryEntry{
+		Cause:           h.Cause,
+		Grantee:         common.BytesToAddress(h.Grantee),
+		PrivilegeID:     h.PrivilegeID.Ptr(),
+		Source:          h.Source.Ptr(),
+		ExpiresAt:       h.ExpiresAt,
+		Timestamp:       h.ChangedAt,
+		TransactionHash: h.TransactionHash.Bytes,
+	}
+
+	if h.Permissions.Valid {
+		b, ok := new(big.Int).SetString(h.Permissions.String, 2)
+		if !ok {
+			return nil, fmt.Errorf("couldn't parse permission string %q as binary", h.Permissions.String)
+		}
+		perms := "0x" + b.Text(16)
+		entry.Permissions = &perms
+	}
+
+	if h.TemplateID.Valid {
+		entry.TemplateID = new(big.Int).SetBytes(h.TemplateID.Bytes)
+	}
+
+	if h.BlockNumber.Valid {
+		bn := int(h.BlockNumber.Int64)
+		entry.BlockNumber = &bn
+	}
+
+	return entry, nil
+}
+
+// GetHistoryForVehicle returns the changes to the SACDs and privileges on the vehicle, most recent
+// first. If grantee is set, only changes to its grants are returned.
+func (r *Repository) GetHistoryForVehicle(ctx context.Context, vehicleID int, grantee *common.Address, first *int, after *string, last *int, before *string) (*gmodel.SacdHistoryEntryConnection, error) {
+	return r.getHistory(ctx, models.SacdHistoryWhere.VehicleID.EQ(null.IntFrom(vehicleID)), grantee, first, after, last, before)
+}
+
+// GetHistoryForAccount returns the changes to the account SACDs granted by the address, most
+// recent first.
+func (r *Repository) GetHistoryForAccount(ctx context.Context, account common.Address, grantee *common.Address, first *int, after *string, last *int, before *string) (*gmodel.SacdHistoryEntryConnection, error) {
+	return r.getHistory(ctx, models.SacdHistoryWhere.Account.EQ(null.BytesFrom(account.Bytes())), grantee, first, after, last, before)
+}
+
+// GetHistoryForConnection returns the changes to the SACDs on the connection, most recent first.
+func (r *Repository) GetHistoryForConnection(ctx context.Context, connectionID []byte, grantee *common.Address, first *int, after *string, last *int, before *string) (*gmodel.SacdHistoryEntryConnection, error) {
+	return r.getHistory(ctx, models.SacdHistoryWhere.ConnectionID.EQ(null.BytesFrom(connectionID)), grantee, first, after, last, before)
+}
+
+func (r *Repository) getHistory(ctx context.Context, where qm.QueryMod, grantee *common.Address, first *int, after *string, last *int, before *string) (*gmodel.SacdHistoryEntryConnection, error) {
+	pHelp := helpers.PaginationHelper[EntryCursor]{}
+
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	queryMods := []qm.QueryMod{where}
+	if grantee != nil {
+		queryMods = append(queryMods, models.SacdHistoryWhere.Grantee.EQ(grantee.Bytes()))
+	}
+
+	totalCount, err := models.SacdHistories(queryMods...).Count(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		afterCursor, err := pHelp.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Expr(
+				models.SacdHistoryWhere.ChangedAt.EQ(afterCursor.ChangedAt),
+				models.SacdHistoryWhere.ID.LT(afterCursor.ID),
+				qm.Or2(models.SacdHistoryWhere.ChangedAt.LT(afterCursor.ChangedAt)),
+			),
+		)
+	}
+
+	if before != nil {
+		beforeCursor, err := pHelp.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		queryMods = append(queryMods,
+			qm.Expr(
+				models.SacdHistoryWhere.ChangedAt.EQ(beforeCursor.ChangedAt),
+				models.SacdHistoryWhere.ID.GT(beforeCursor.ID),
+				qm.Or2(models.SacdHistoryWhere.ChangedAt.GT(beforeCursor.ChangedAt)),
+			),
+		)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s DESC", models.SacdHistoryColumns.ChangedAt, models.SacdHistoryColumns.ID)
+	if last != nil {
+		orderBy = fmt.Sprintf("%s ASC, %s ASC", models.SacdHistoryColumns.ChangedAt, models.SacdHistoryColumns.ID)
+	}
+
+	queryMods = append(queryMods,
+		// Use limit + 1 here to check if there's another page.
+		qm.Limit(limit+1),
+		qm.OrderBy(orderBy),
+	)
+
+	page, err := models.SacdHistories(queryMods...).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// We assume that cursors come from real elements.
+	hasNext := before != nil
+	hasPrevious := after != nil
+
+	if first != nil && len(page) == limit+1 {
+		hasNext = true
+		page = page[:limit]
+	} else if last != nil && len(page) == limit+1 {
+		hasPrevious = true
+		page = page[:limit]
+	}
+
+	if last != nil {
+		slices.Reverse(page)
+	}
+
+	edges := make([]*gmodel.SacdHistoryEntryEdge, len(page))
+	nodes := make([]*gmodel.SacdHistoryEntry, len(page))
+
+	for i, h := range page {
+		crsr, err := pHelp.EncodeCursor(EntryCursor{ChangedAt: h.ChangedAt, ID: h.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		ge, err := entryToAPIResponse(h)
+		if err != nil {
+			return nil, err
+		}
+
+		edges[i] = &gmodel.SacdHistoryEntryEdge{
+			Node:   ge,
+			Cursor: crsr,
+		}
+		nodes[i] = ge
+	}
+
+	var endCur, startCur *string
+
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.SacdHistoryEntryConnection{
+		TotalCount: int(totalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo: &gmodel.PageInfo{
+			StartCursor:     startCur,
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+		},
+	}, nil
+}
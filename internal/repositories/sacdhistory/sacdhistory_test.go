@@ -0,0 +1,115 @@
+package sacdhistory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type SacdHistoryRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *SacdHistoryRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = &Repository{base.NewRepository(s.pdb, s.settings, &logger)}
+}
+
+// TearDownTest after each test truncate tables
+func (s *SacdHistoryRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *SacdHistoryRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestSacdHistoryRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(SacdHistoryRepoTestSuite))
+}
+
+func (s *SacdHistoryRepoTestSuite) TestGetHistoryForVehicle() {
+	grantee := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	other := common.HexToAddress("0x55b6D41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	entries := []models.SacdHistory{
+		{Grantee: grantee.Bytes(), Cause: "set", Permissions: null.StringFrom("1100"), ChangedAt: start, BlockNumber: null.Int64From(100)},
+		{Grantee: other.Bytes(), Cause: "set", PrivilegeID: null.IntFrom(1), ChangedAt: start.Add(time.Hour), BlockNumber: null.Int64From(200)},
+		{Grantee: grantee.Bytes(), Cause: "renounced", Permissions: null.StringFrom("1100"), ChangedAt: start.Add(2 * time.Hour), BlockNumber: null.Int64From(300)},
+		// Recorded after the renouncement, but it happened earlier.
+		{Grantee: other.Bytes(), Cause: "expired", PrivilegeID: null.IntFrom(1), ChangedAt: start.Add(90 * time.Minute)},
+	}
+	for _, e := range entries {
+		e.VehicleID = null.IntFrom(10)
+		e.ExpiresAt = start.Add(90 * time.Minute)
+		if e.BlockNumber.Valid {
+			e.TransactionHash = null.BytesFrom(common.BigToHash(big.NewInt(e.BlockNumber.Int64)).Bytes())
+		}
+		s.Require().NoError(e.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	first := 2
+	res, err := s.repo.GetHistoryForVehicle(s.ctx, 10, nil, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(4, res.TotalCount)
+	s.True(res.PageInfo.HasNextPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal("renounced", res.Nodes[0].Cause)
+	s.Equal("0xc", *res.Nodes[0].Permissions)
+	s.Equal("expired", res.Nodes[1].Cause)
+	s.Equal(1, *res.Nodes[1].PrivilegeID)
+	s.Nil(res.Nodes[1].BlockNumber)
+
+	res, err = s.repo.GetHistoryForVehicle(s.ctx, 10, nil, &first, res.PageInfo.EndCursor, nil, nil)
+	s.Require().NoError(err)
+
+	s.False(res.PageInfo.HasNextPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal(other, res.Nodes[0].Grantee)
+	s.Equal(100, *res.Nodes[1].BlockNumber)
+
+	res, err = s.repo.GetHistoryForVehicle(s.ctx, 10, &grantee, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(2, res.TotalCount)
+	s.Equal("renounced", res.Nodes[0].Cause)
+	s.Equal("set", res.Nodes[1].Cause)
+
+	res, err = s.repo.GetHistoryForAccount(s.ctx, grantee, nil, &first, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Zero(res.TotalCount)
+}
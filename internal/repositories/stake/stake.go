@@ -140,6 +140,78 @@ func (r *Repository) GetStakes(ctx context.Context, first *int, after *string, l
 		slices.Reverse(all)
 	}
 
+	return r.createStakesResponse(totalCount, all, hasNext, hasPrevious), nil
+}
+
+// GetStakesForOwners returns, for each owner, the page that GetStakes would return with an owner
+// filter, using a single query for the stakes.
+func (r *Repository) GetStakesForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.StakeConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.Stakes(mods...) },
+		models.StakeColumns.Owner, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	rankWhere := models.StakeColumns.Owner + " = ANY(?)"
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterID, err := helpers.CursorToID(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += " AND " + models.StakeColumns.ID + " < ?"
+		args = append(args, afterID)
+	}
+
+	if before != nil {
+		beforeID, err := helpers.CursorToID(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += " AND " + models.StakeColumns.ID + " > ?"
+		args = append(args, beforeID)
+	}
+
+	orderBy := "DESC"
+	if last != nil {
+		orderBy = "ASC"
+	}
+
+	all, err := models.Stakes(
+		// Use limit + 1 here to check if there's another page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.Stakes), models.StakeColumns.ID, models.StakeColumns.Owner,
+			models.StakeColumns.ID+" "+orderBy, rankWhere, limit+1, args...),
+		qm.OrderBy(models.StakeColumns.ID+" "+orderBy),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.StakeSlice, len(owners))
+	for _, st := range all {
+		owner := common.BytesToAddress(st.Owner)
+		byOwner[owner] = append(byOwner[owner], st)
+	}
+
+	out := make(map[common.Address]*gmodel.StakeConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		out[owner] = r.createStakesResponse(counts[owner], page, hasNext, hasPrevious)
+	}
+
+	return out, nil
+}
+
+func (r *Repository) createStakesResponse(totalCount int64, all models.StakeSlice, hasNext bool, hasPrevious bool) *gmodel.StakeConnection {
 	var endCur, startCur *string
 	if len(all) != 0 {
 		ec := helpers.IDToCursor(all[len(all)-1].ID)
@@ -163,7 +235,7 @@ func (r *Repository) GetStakes(ctx context.Context, first *int, after *string, l
 		nodes[i] = dlv
 	}
 
-	res := &gmodel.StakeConnection{
+	return &gmodel.StakeConnection{
 		Edges: edges,
 		Nodes: nodes,
 		PageInfo: &gmodel.PageInfo{
@@ -174,6 +246,4 @@ func (r *Repository) GetStakes(ctx context.Context, first *int, after *string, l
 		},
 		TotalCount: int(totalCount),
 	}
-
-	return res, nil
 }
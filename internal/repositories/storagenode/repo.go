@@ -1,12 +1,17 @@
 package storagenode
 
 import (
+	"context"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/DIMO-Network/cloudevent"
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -44,3 +49,124 @@ func (r *Repository) ToAPI(v *models.StorageNode) *gmodel.StorageNode {
 		MintedAt: v.MintedAt,
 	}
 }
+
+type Cursor struct {
+	MintedAt time.Time
+	ID       []byte
+}
+
+var pageHelper = helpers.PaginationHelper[Cursor]{}
+
+// GetStorageNodesForOwners returns, for each owner, the storage nodes it owns, most recently
+// minted first. The nodes come from a single query.
+func (r *Repository) GetStorageNodesForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.StorageNodeConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.StorageNodes(mods...) },
+		models.StorageNodeColumns.Owner, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	rankWhere := models.StorageNodeColumns.Owner + " = ANY(?)"
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterCur, err := pageHelper.DecodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += fmt.Sprintf(" AND ((%[1]s = ? AND %[2]s > ?) OR %[1]s < ?)", models.StorageNodeColumns.MintedAt, models.StorageNodeColumns.ID)
+		args = append(args, afterCur.MintedAt, afterCur.ID, afterCur.MintedAt)
+	}
+
+	if before != nil {
+		beforeCur, err := pageHelper.DecodeCursor(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere += fmt.Sprintf(" AND ((%[1]s = ? AND %[2]s < ?) OR %[1]s > ?)", models.StorageNodeColumns.MintedAt, models.StorageNodeColumns.ID)
+		args = append(args, beforeCur.MintedAt, beforeCur.ID, beforeCur.MintedAt)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s ASC", models.StorageNodeColumns.MintedAt, models.StorageNodeColumns.ID)
+	if last != nil {
+		orderBy = fmt.Sprintf("%s ASC, %s DESC", models.StorageNodeColumns.MintedAt, models.StorageNodeColumns.ID)
+	}
+
+	all, err := models.StorageNodes(
+		// Use limit + 1 here to check if there's another page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.StorageNodes), models.StorageNodeColumns.ID, models.StorageNodeColumns.Owner,
+			orderBy, rankWhere, limit+1, args...),
+		qm.OrderBy(orderBy),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.StorageNodeSlice, len(owners))
+	for _, sn := range all {
+		owner := common.BytesToAddress(sn.Owner)
+		byOwner[owner] = append(byOwner[owner], sn)
+	}
+
+	out := make(map[common.Address]*gmodel.StorageNodeConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		conn, err := r.createStorageNodesResponse(counts[owner], page, hasNext, hasPrevious)
+		if err != nil {
+			return nil, err
+		}
+		out[owner] = conn
+	}
+
+	return out, nil
+}
+
+func (r *Repository) createStorageNodesResponse(totalCount int64, all models.StorageNodeSlice, hasNext bool, hasPrevious bool) (*gmodel.StorageNodeConnection, error) {
+	nodes := make([]*gmodel.StorageNode, len(all))
+	edges := make([]*gmodel.StorageNodeEdge, len(all))
+
+	for i, sn := range all {
+		node := r.ToAPI(sn)
+
+		crsr, err := pageHelper.EncodeCursor(Cursor{
+			MintedAt: sn.MintedAt,
+			ID:       sn.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[i] = node
+		edges[i] = &gmodel.StorageNodeEdge{
+			Node:   node,
+			Cursor: crsr,
+		}
+	}
+
+	var endCur, startCur *string
+
+	if len(edges) != 0 {
+		startCur = &edges[0].Cursor
+		endCur = &edges[len(edges)-1].Cursor
+	}
+
+	return &gmodel.StorageNodeConnection{
+		Edges: edges,
+		Nodes: nodes,
+		PageInfo: &gmodel.PageInfo{
+			EndCursor:       endCur,
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+			StartCursor:     startCur,
+		},
+		TotalCount: int(totalCount),
+	}, nil
+}
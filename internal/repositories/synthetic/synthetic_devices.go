@@ -187,6 +187,86 @@ func (r *Repository) GetSyntheticDevices(ctx context.Context, first *int, last *
 	return r.createSyntheticDevicesResponse(totalCount, all, hasNext, hasPrevious)
 }
 
+// GetSyntheticDevicesForOwners returns, for each owner, the page that GetSyntheticDevices would
+// return with an owner filter, using a single query for the devices. A synthetic device belongs
+// to the owner of its vehicle.
+func (r *Repository) GetSyntheticDevicesForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.SyntheticDeviceConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid first/last argument: %w", err)
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.SyntheticDevices(mods...) },
+		models.VehicleTableColumns.OwnerAddress, owners, qm.InnerJoin(vehicleJoin))
+	if err != nil {
+		r.Log.Err(err).Msg("failed to get synthetic device counts")
+		return nil, base.InternalError
+	}
+
+	rankWhere := models.VehicleTableColumns.OwnerAddress + " = ANY(?)"
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterID, err := helpers.CursorToID(*after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid after cursor: %w", err)
+		}
+		rankWhere += " AND " + models.SyntheticDeviceTableColumns.ID + " < ?"
+		args = append(args, afterID)
+	}
+
+	if before != nil {
+		beforeID, err := helpers.CursorToID(*before)
+		if err != nil {
+			return nil, fmt.Errorf("invalid before cursor: %w", err)
+		}
+		rankWhere += " AND " + models.SyntheticDeviceTableColumns.ID + " > ?"
+		args = append(args, beforeID)
+	}
+
+	orderBy := " DESC"
+	if last != nil {
+		orderBy = " ASC"
+	}
+
+	var all []struct {
+		models.SyntheticDevice `boil:",bind"`
+		OwnerAddress           []byte `boil:"owner_address"`
+	}
+	err = models.SyntheticDevices(
+		qm.Select(helpers.WithSchema(models.TableNames.SyntheticDevices)+".*", models.VehicleTableColumns.OwnerAddress),
+		qm.InnerJoin(vehicleJoin),
+		// Use limit + 1 here to check if there's another page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.SyntheticDevices)+" INNER JOIN "+vehicleJoin,
+			models.SyntheticDeviceTableColumns.ID, models.VehicleTableColumns.OwnerAddress,
+			models.SyntheticDeviceTableColumns.ID+orderBy, rankWhere, limit+1, args...),
+		qm.OrderBy(models.SyntheticDeviceTableColumns.ID+orderBy),
+	).Bind(ctx, r.PDB.DBS().Reader, &all)
+	if err != nil {
+		r.Log.Err(err).Msg("failed to get synthetic devices")
+		return nil, base.InternalError
+	}
+
+	byOwner := make(map[common.Address]models.SyntheticDeviceSlice, len(owners))
+	for i := range all {
+		owner := common.BytesToAddress(all[i].OwnerAddress)
+		byOwner[owner] = append(byOwner[owner], &all[i].SyntheticDevice)
+	}
+
+	out := make(map[common.Address]*gmodel.SyntheticDeviceConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		conn, err := r.createSyntheticDevicesResponse(counts[owner], page, hasNext, hasPrevious)
+		if err != nil {
+			return nil, err
+		}
+		out[owner] = conn
+	}
+
+	return out, nil
+}
+
 func queryModsFromFilters(filterBy *gmodel.SyntheticDevicesFilter) []qm.QueryMod {
 	if filterBy == nil {
 		return nil
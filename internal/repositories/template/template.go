@@ -11,6 +11,7 @@ import (
 
 	"github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
 	"github.com/DIMO-Network/identity-api/internal/repositories"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
@@ -43,14 +44,18 @@ func New(db *base.Repository) *Repository {
 
 func (r *Repository) ToAPI(template *models.Template) *model.Template {
 	tokenID := new(big.Int).SetBytes(template.ID)
+	// The consumer stores the mask in binary, so this only fails on a corrupt row, which then
+	// shows no permissions.
+	perms, _ := permissions.FromBinary(template.Permissions)
 
 	return &model.Template{
-		TokenID:     tokenID,
-		Creator:     common.BytesToAddress(template.Creator),
-		Asset:       common.BytesToAddress(template.Asset),
-		Permissions: template.Permissions,
-		Cid:         template.Cid,
-		CreatedAt:   template.CreatedAt,
+		TokenID:        tokenID,
+		Creator:        common.BytesToAddress(template.Creator),
+		Asset:          common.BytesToAddress(template.Asset),
+		Permissions:    template.Permissions,
+		PermissionList: permissions.ToAPI(perms),
+		Cid:            template.Cid,
+		CreatedAt:      template.CreatedAt,
 	}
 }
 
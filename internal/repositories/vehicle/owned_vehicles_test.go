@@ -501,3 +501,124 @@ func Test_GetOwnedVehicles_Filters(t *testing.T) {
 		}
 	}
 }
+
+func (s *OwnedVehiclesRepoTestSuite) TestGetVehicles_PrivilegedWith() {
+	_, grantee, err := helpers.GenerateWallet()
+	s.Require().NoError(err)
+	_, ownerA, err := helpers.GenerateWallet()
+	s.Require().NoError(err)
+	_, ownerB, err := helpers.GenerateWallet()
+	s.Require().NoError(err)
+
+	m := models.Manufacturer{
+		ID:       131,
+		Name:     "Toyota",
+		Owner:    ownerA.Bytes(),
+		MintedAt: time.Now(),
+		Slug:     "toyota",
+	}
+	s.Require().NoError(m.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	// Vehicle | Owner   | Grants to the grantee
+	// --------+---------+------------------------------------------------------------
+	// 1       | A       | privilege NONLOCATION_TELEMETRY, vehicle SACD COMMANDS
+	// 2       | A       | privilege NONLOCATION_TELEMETRY
+	// 3       | B       | account SACD NONLOCATION_TELEMETRY and COMMANDS, from B
+	// 4       | grantee |
+	// 5       | A       | expired vehicle SACD NONLOCATION_TELEMETRY and COMMANDS
+	for id, owner := range map[int]*common.Address{1: ownerA, 2: ownerA, 3: ownerB, 4: grantee, 5: ownerA} {
+		v := models.Vehicle{
+			ID:             id,
+			ManufacturerID: 131,
+			OwnerAddress:   owner.Bytes(),
+			MintedAt:       time.Now(),
+		}
+		s.Require().NoError(v.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	for _, id := range []int{1, 2} {
+		p := models.Privilege{
+			TokenID:     id,
+			PrivilegeID: 1,
+			UserAddress: grantee.Bytes(),
+			SetAt:       time.Now(),
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+		s.Require().NoError(p.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	for id, expiresAt := range map[int]time.Time{1: time.Now().Add(time.Hour), 5: time.Now().Add(-time.Hour)} {
+		perms := "110000"
+		if id == 5 {
+			perms = "111100"
+		}
+		vs := models.VehicleSacd{
+			VehicleID:   id,
+			Grantee:     grantee.Bytes(),
+			Permissions: perms,
+			Source:      "ipfs://vehicle",
+			CreatedAt:   time.Now().Add(-2 * time.Hour),
+			ExpiresAt:   expiresAt,
+		}
+		s.Require().NoError(vs.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	as := models.AccountSacd{
+		Account:     ownerB.Bytes(),
+		Grantee:     grantee.Bytes(),
+		Permissions: "111100",
+		Source:      "ipfs://account",
+		CreatedAt:   time.Now(),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+	s.Require().NoError(as.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	tokenIDs := func(res *gmodel.VehicleConnection) []int {
+		out := make([]int, len(res.Nodes))
+		for i, v := range res.Nodes {
+			out[i] = v.TokenID
+		}
+		return out
+	}
+
+	first := 2
+	filter := &gmodel.VehiclesFilter{PrivilegedWith: &gmodel.PrivilegedWithFilter{
+		Address:     *grantee,
+		Permissions: []gmodel.PermissionName{gmodel.PermissionNameNonlocationTelemetry, gmodel.PermissionNameCommands},
+	}}
+
+	res, err := s.repo.GetVehicles(s.ctx, &first, nil, nil, nil, filter)
+	s.Require().NoError(err)
+	s.Equal(3, res.TotalCount)
+	s.Equal([]int{4, 3}, tokenIDs(res))
+	s.True(res.PageInfo.HasNextPage)
+
+	res, err = s.repo.GetVehicles(s.ctx, &first, res.PageInfo.EndCursor, nil, nil, filter)
+	s.Require().NoError(err)
+	s.Equal([]int{1}, tokenIDs(res))
+	s.False(res.PageInfo.HasNextPage)
+
+	first = 10
+	filter.PrivilegedWith.Permissions = []gmodel.PermissionName{gmodel.PermissionNameNonlocationTelemetry}
+	res, err = s.repo.GetVehicles(s.ctx, &first, nil, nil, nil, filter)
+	s.Require().NoError(err)
+	s.Equal(4, res.TotalCount)
+	s.Equal([]int{4, 3, 2, 1}, tokenIDs(res))
+
+	// Vehicle 3 is only covered by an account SACD.
+	res, err = s.repo.GetVehicles(s.ctx, &first, nil, nil, nil, &gmodel.VehiclesFilter{Privileged: grantee})
+	s.Require().NoError(err)
+	s.Equal(4, res.TotalCount)
+	s.Equal([]int{4, 3, 2, 1}, tokenIDs(res))
+
+	// Combined with the broader filter, which counts with DISTINCT.
+	filter.Privileged = grantee
+	res, err = s.repo.GetVehicles(s.ctx, &first, nil, nil, nil, filter)
+	s.Require().NoError(err)
+	s.Equal(4, res.TotalCount)
+	s.Equal([]int{4, 3, 2, 1}, tokenIDs(res))
+
+	filter.PrivilegedWith.Permissions = nil
+	_, err = s.repo.GetVehicles(s.ctx, &first, nil, nil, nil, filter)
+	s.Error(err)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/DIMO-Network/cloudevent"
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
 	"github.com/DIMO-Network/identity-api/internal/repositories"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
@@ -117,6 +118,15 @@ func (r *Repository) createVehiclesResponse(totalCount int64, vehicles models.Ve
 // @Param last [*int] "the number of devices to return from previous pages"
 // @Param before [*string] "base64 string representing a device tokenID. Pointer to where we start fetching devices from previous pages"
 func (r *Repository) GetVehicles(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *gmodel.VehiclesFilter) (*gmodel.VehicleConnection, error) {
+	return r.getVehicles(ctx, false, first, after, last, before, filterBy)
+}
+
+// GetBurnedVehicles lists burned vehicles, using the same filters and ordering as GetVehicles.
+func (r *Repository) GetBurnedVehicles(ctx context.Context, first *int, after *string, last *int, before *string, filterBy *gmodel.VehiclesFilter) (*gmodel.VehicleConnection, error) {
+	return r.getVehicles(ctx, true, first, after, last, before, filterBy)
+}
+
+func (r *Repository) getVehicles(ctx context.Context, burned bool, first *int, after *string, last *int, before *string, filterBy *gmodel.VehiclesFilter) (*gmodel.VehicleConnection, error) {
 	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
 	if err != nil {
 		return nil, err
@@ -127,6 +137,12 @@ func (r *Repository) GetVehicles(ctx context.Context, first *int, after *string,
 	if err != nil {
 		return nil, err
 	}
+
+	if burned {
+		queryMods = append(queryMods, models.VehicleWhere.BurnedAt.IsNotNull())
+	} else {
+		queryMods = append(queryMods, models.VehicleWhere.BurnedAt.IsNull())
+	}
 	if filterBy != nil && filterBy.Privileged != nil {
 		totalCount, err = models.Vehicles(
 			// We're performing this because SQLBoiler doesn't understand DISTINCT ON. If we use
@@ -197,7 +213,82 @@ func (r *Repository) GetVehicles(ctx context.Context, first *int, after *string,
 	return r.createVehiclesResponse(totalCount, all, hasNext, hasPrevious)
 }
 
-func (r *Repository) GetVehicle(ctx context.Context, tokenID *int, tokenDID *string) (*gmodel.Vehicle, error) {
+// GetVehiclesForOwners returns, for each owner, the page that GetVehicles would return with an
+// owner filter. The pages come from a single query that ranks each owner's vehicles, so listing
+// the vehicles of many accounts doesn't cost a query per account.
+func (r *Repository) GetVehiclesForOwners(ctx context.Context, owners []common.Address, first *int, after *string, last *int, before *string) (map[common.Address]*gmodel.VehicleConnection, error) {
+	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := base.CountPerOwner(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder { return models.Vehicles(mods...) },
+		models.VehicleColumns.OwnerAddress, owners, models.VehicleWhere.BurnedAt.IsNull())
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy := "DESC"
+	if last != nil {
+		orderBy = "ASC"
+	}
+
+	rankWhere := []string{models.VehicleColumns.OwnerAddress + " = ANY(?)", models.VehicleColumns.BurnedAt + " IS NULL"}
+	args := []any{base.OwnerArray(owners)}
+
+	if after != nil {
+		afterID, err := helpers.CursorToID(*after)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere = append(rankWhere, models.VehicleColumns.ID+" < ?")
+		args = append(args, afterID)
+	}
+
+	if before != nil {
+		beforeID, err := helpers.CursorToID(*before)
+		if err != nil {
+			return nil, err
+		}
+
+		rankWhere = append(rankWhere, models.VehicleColumns.ID+" > ?")
+		args = append(args, beforeID)
+	}
+
+	all, err := models.Vehicles(
+		// Use limit + 1 here to check if there's another page.
+		base.RankedPerOwner(helpers.WithSchema(models.TableNames.Vehicles), models.VehicleColumns.ID, models.VehicleColumns.OwnerAddress,
+			models.VehicleColumns.ID+" "+orderBy, strings.Join(rankWhere, " AND "), limit+1, args...),
+		qm.OrderBy(models.VehicleColumns.ID+" "+orderBy),
+	).All(ctx, r.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := make(map[common.Address]models.VehicleSlice, len(owners))
+	for _, v := range all {
+		owner := common.BytesToAddress(v.OwnerAddress)
+		byOwner[owner] = append(byOwner[owner], v)
+	}
+
+	out := make(map[common.Address]*gmodel.VehicleConnection, len(owners))
+	for _, owner := range owners {
+		page, hasNext, hasPrevious := base.TrimPage(byOwner[owner], limit, first, last, after, before)
+		conn, err := r.createVehiclesResponse(counts[owner], page, hasNext, hasPrevious)
+		if err != nil {
+			return nil, err
+		}
+		out[owner] = conn
+	}
+
+	return out, nil
+}
+
+// GetVehicle looks up a vehicle by token id or DID. Burned vehicles are only returned if
+// includeBurned is set.
+func (r *Repository) GetVehicle(ctx context.Context, tokenID *int, tokenDID *string, includeBurned bool) (*gmodel.Vehicle, error) {
 	if base.CountTrue(tokenID != nil, tokenDID != nil) != 1 {
 		return nil, fmt.Errorf("provide exactly one of `tokenID` or `tokenDID`")
 	}
@@ -225,6 +316,9 @@ func (r *Repository) GetVehicle(ctx context.Context, tokenID *int, tokenDID *str
 		}
 		return nil, err
 	}
+	if v.BurnedAt.Valid && !includeBurned {
+		return nil, repositories.ErrNotFound
+	}
 	var imageURI string
 
 	if v.ImageURI.Valid {
@@ -263,7 +357,10 @@ func (r *Repository) queryModsFromFilters(filter *gmodel.VehiclesFilter) ([]qm.Q
 		queryMods = append(queryMods,
 			// SELECT DISTINCT ON (vehicles.id) identity_api.vehicles.*
 			// LEFT OUTER JOIN identity_api.privileges ON vehicles.id = privileges.token_id
+			// LEFT OUTER JOIN identity_api.vehicle_sacds ON vehicles.id = vehicle_sacds.vehicle_id
+			// LEFT OUTER JOIN identity_api.account_sacds ON vehicles.owner_address = account_sacds.account
 			// WHERE vehicles.owner_address = <filter.Privileged> OR (privileges.user_address = <filter.Privileged> AND privileges.expires_at >= <time.Now()> )
+			//   OR (vehicle_sacds.grantee = <filter.Privileged> AND ...) OR (account_sacds.grantee = <filter.Privileged> AND ...)
 			qm.Select("DISTINCT ON ("+models.VehicleTableColumns.ID+") "+helpers.WithSchema(models.TableNames.Vehicles)+".*"),
 			qm.LeftOuterJoin(
 				helpers.WithSchema(models.TableNames.Privileges)+" ON "+models.VehicleTableColumns.ID+" = "+models.PrivilegeTableColumns.TokenID,
@@ -271,6 +368,10 @@ func (r *Repository) queryModsFromFilters(filter *gmodel.VehiclesFilter) ([]qm.Q
 			qm.LeftOuterJoin(
 				helpers.WithSchema(models.TableNames.VehicleSacds)+" ON "+models.VehicleTableColumns.ID+" = "+models.VehicleSacdColumns.VehicleID,
 			),
+			// Account SACDs cover every vehicle owned by the granting account.
+			qm.LeftOuterJoin(
+				helpers.WithSchema(models.TableNames.AccountSacds)+" ON "+models.VehicleTableColumns.OwnerAddress+" = "+models.AccountSacdTableColumns.Account,
+			),
 			qm.Expr(
 				models.VehicleWhere.OwnerAddress.EQ(addr.Bytes()),
 				qm.Or2(
@@ -285,10 +386,24 @@ func (r *Repository) queryModsFromFilters(filter *gmodel.VehiclesFilter) ([]qm.Q
 						models.VehicleSacdWhere.ExpiresAt.GT(time.Now()),
 					),
 				),
+				qm.Or2(
+					qm.Expr(
+						models.AccountSacdWhere.Grantee.EQ(addr.Bytes()),
+						models.AccountSacdWhere.ExpiresAt.GT(time.Now()),
+					),
+				),
 			),
 		)
 	}
 
+	if filter.PrivilegedWith != nil {
+		mods, err := privilegedWithQueryMods(filter.PrivilegedWith, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		queryMods = append(queryMods, mods...)
+	}
+
 	if filter.Make != nil {
 		queryMods = append(queryMods, models.VehicleWhere.Make.EQ(null.StringFrom(*filter.Make)))
 	}
@@ -326,6 +441,62 @@ func (r *Repository) queryModsFromFilters(filter *gmodel.VehiclesFilter) ([]qm.Q
 	return queryMods, nil
 }
 
+// holdsPermissionClause matches vehicles on which the address, bound to the first, second and
+// fourth parameters, holds a permission. Unlike the privileged filter, this uses EXISTS rather
+// than joins, so that a clause per permission doesn't multiply the rows.
+//
+// SACD masks are stored as bit strings, most significant bit first. Reversed as text, the pair
+// of bits for a permission starts at character 2*index+1.
+var holdsPermissionClause = fmt.Sprintf(`(%[1]s = ?
+	OR EXISTS (SELECT 1 FROM %[2]s WHERE %[3]s = %[4]s AND %[5]s = ? AND %[6]s = ? AND %[7]s > ?)
+	OR EXISTS (SELECT 1 FROM %[8]s WHERE %[9]s = %[4]s AND %[10]s = ? AND %[11]s > ? AND substr(reverse(%[12]s::text), ?, 2) = '11')
+	OR EXISTS (SELECT 1 FROM %[13]s WHERE %[14]s = %[1]s AND %[15]s = ? AND %[16]s > ? AND substr(reverse(%[17]s::text), ?, 2) = '11'))`,
+	models.VehicleTableColumns.OwnerAddress,
+	helpers.WithSchema(models.TableNames.Privileges),
+	models.PrivilegeTableColumns.TokenID,
+	models.VehicleTableColumns.ID,
+	models.PrivilegeTableColumns.UserAddress,
+	models.PrivilegeTableColumns.PrivilegeID,
+	models.PrivilegeTableColumns.ExpiresAt,
+	helpers.WithSchema(models.TableNames.VehicleSacds),
+	models.VehicleSacdTableColumns.VehicleID,
+	models.VehicleSacdTableColumns.Grantee,
+	models.VehicleSacdTableColumns.ExpiresAt,
+	models.VehicleSacdTableColumns.Permissions,
+	helpers.WithSchema(models.TableNames.AccountSacds),
+	models.AccountSacdTableColumns.Account,
+	models.AccountSacdTableColumns.Grantee,
+	models.AccountSacdTableColumns.ExpiresAt,
+	models.AccountSacdTableColumns.Permissions,
+)
+
+// privilegedWithQueryMods returns a clause per requested permission, so that each may be held
+// through a different grant.
+func privilegedWithQueryMods(filter *gmodel.PrivilegedWithFilter, now time.Time) ([]qm.QueryMod, error) {
+	if len(filter.Permissions) == 0 {
+		return nil, errors.New("privilegedWith.permissions must not be empty")
+	}
+
+	addr := filter.Address.Bytes()
+
+	var queryMods []qm.QueryMod
+	for _, name := range filter.Permissions {
+		p, ok := permissions.ByName(string(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown permission %s", name)
+		}
+		pos := 2*p.Index + 1
+		queryMods = append(queryMods, qm.Where(holdsPermissionClause,
+			addr,
+			addr, p.Index, now,
+			addr, now, pos,
+			addr, now, pos,
+		))
+	}
+
+	return queryMods, nil
+}
+
 // ToAPI converts a vehicle to a corresponding graphql model.
 func (r *Repository) ToAPI(v *models.Vehicle, imageURI string, dataURI string) (*gmodel.Vehicle, error) {
 	nameList := mnemonic.FromInt32WithObfuscation(int32(v.ID))
@@ -359,6 +530,11 @@ func (r *Repository) ToAPI(v *models.Vehicle, imageURI string, dataURI string) (
 		ImageURI:       imageURI,
 		Image:          imageURI,
 		DataURI:        dataURI,
+		BurnedAt:       v.BurnedAt.Ptr(),
+	}
+
+	if v.BurnTransactionHash.Valid {
+		out.BurnTransactionHash = v.BurnTransactionHash.Bytes
 	}
 
 	if v.StorageNodeID.Valid {
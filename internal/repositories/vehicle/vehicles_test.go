@@ -12,6 +12,7 @@ import (
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/config"
 	test "github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/DIMO-Network/mnemonic"
@@ -1480,6 +1481,57 @@ func (o *AccessibleVehiclesRepoTestSuite) Test_GetAccessibleVehiclesFilters() {
 	}
 }
 
+func (o *AccessibleVehiclesRepoTestSuite) Test_BurnedVehicles() {
+	_, wallet, err := test.GenerateWallet()
+	o.Require().NoError(err)
+
+	m := models.Manufacturer{
+		ID:       1,
+		Owner:    wallet.Bytes(),
+		Name:     "Toyota",
+		MintedAt: time.Now(),
+		Slug:     "toyota",
+	}
+	o.Require().NoError(m.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	live := models.Vehicle{ID: 1, ManufacturerID: 1, OwnerAddress: wallet.Bytes(), MintedAt: time.Now()}
+	o.Require().NoError(live.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	burned := models.Vehicle{
+		ID:                  2,
+		ManufacturerID:      1,
+		OwnerAddress:        wallet.Bytes(),
+		MintedAt:            time.Now(),
+		BurnedAt:            null.TimeFrom(time.Now()),
+		BurnTransactionHash: null.BytesFrom(common.HexToHash("0x02").Bytes()),
+	}
+	o.Require().NoError(burned.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	first := 10
+
+	res, err := o.repo.GetVehicles(o.ctx, &first, nil, nil, nil, &gmodel.VehiclesFilter{Owner: wallet})
+	o.Require().NoError(err)
+	o.Require().Len(res.Nodes, 1)
+	o.Equal(1, res.Nodes[0].TokenID)
+	o.Equal(1, res.TotalCount)
+
+	res, err = o.repo.GetBurnedVehicles(o.ctx, &first, nil, nil, nil, &gmodel.VehiclesFilter{Owner: wallet})
+	o.Require().NoError(err)
+	o.Require().Len(res.Nodes, 1)
+	o.Equal(2, res.Nodes[0].TokenID)
+	o.NotNil(res.Nodes[0].BurnedAt)
+	o.Equal(burned.BurnTransactionHash.Bytes, res.Nodes[0].BurnTransactionHash)
+
+	tokenID := 2
+
+	_, err = o.repo.GetVehicle(o.ctx, &tokenID, nil, false)
+	o.ErrorIs(err, repositories.ErrNotFound)
+
+	v, err := o.repo.GetVehicle(o.ctx, &tokenID, nil, true)
+	o.Require().NoError(err)
+	o.Equal(*wallet, v.Owner)
+}
+
 // requireEqualVehicles is a helper function to compare two slices of VehicleEdges
 func requireEqualVehicles(t *testing.T, expected, actual []*gmodel.VehicleEdge) {
 	t.Helper()
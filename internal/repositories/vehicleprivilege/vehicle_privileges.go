@@ -8,6 +8,7 @@ import (
 
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -26,12 +27,14 @@ type PrivilegeCursor struct {
 	User        []byte
 }
 
-func privilegeToAPIResponse(pr *models.Privilege) *gmodel.Privilege {
+// PrivilegeToAPI converts a privilege to its API form.
+func PrivilegeToAPI(pr *models.Privilege) *gmodel.Privilege {
 	return &gmodel.Privilege{
-		ID:        pr.PrivilegeID,
-		User:      common.Address(pr.UserAddress),
-		SetAt:     pr.SetAt,
-		ExpiresAt: pr.ExpiresAt,
+		ID:             pr.PrivilegeID,
+		User:           common.Address(pr.UserAddress),
+		SetAt:          pr.SetAt,
+		ExpiresAt:      pr.ExpiresAt,
+		PermissionList: permissions.PrivilegeToAPI(pr.PrivilegeID),
 	}
 }
 
@@ -51,7 +54,7 @@ func (p *Repository) createPrivilegeResponse(privs models.PrivilegeSlice, totalC
 	nodes := make([]*gmodel.Privilege, len(privs))
 
 	for i, dp := range privs {
-		gp := privilegeToAPIResponse(dp)
+		gp := PrivilegeToAPI(dp)
 
 		crsr, err := pHelper.EncodeCursor(PrivilegeCursor{
 			SetAt:       dp.SetAt,
@@ -9,6 +9,7 @@ import (
 	"github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/config"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/DIMO-Network/shared/pkg/db"
@@ -129,20 +130,22 @@ func (s *VehiclesPrivilegesRepoTestSuite) Test_GetVehiclePrivileges_Success() {
 		Edges: []*model.PrivilegeEdge{
 			{
 				Node: &model.Privilege{
-					ID:        1,
-					User:      *wallet,
-					SetAt:     currTime,
-					ExpiresAt: expiresAt,
+					ID:             1,
+					User:           *wallet,
+					SetAt:          currTime,
+					ExpiresAt:      expiresAt,
+					PermissionList: permissions.PrivilegeToAPI(1),
 				},
 				Cursor: cursor,
 			},
 		},
 		Nodes: []*model.Privilege{
 			{
-				ID:        1,
-				User:      *wallet,
-				SetAt:     currTime,
-				ExpiresAt: expiresAt,
+				ID:             1,
+				User:           *wallet,
+				SetAt:          currTime,
+				ExpiresAt:      expiresAt,
+				PermissionList: permissions.PrivilegeToAPI(1),
 			},
 		},
 		PageInfo: &model.PageInfo{
@@ -242,20 +245,22 @@ func (s *VehiclesPrivilegesRepoTestSuite) Test_Privileges_NoExpiredPrivilege_Pag
 		Edges: []*model.PrivilegeEdge{
 			{
 				Node: &model.Privilege{
-					ID:        1,
-					User:      *wallet2,
-					SetAt:     currTime,
-					ExpiresAt: expiresAt,
+					ID:             1,
+					User:           *wallet2,
+					SetAt:          currTime,
+					ExpiresAt:      expiresAt,
+					PermissionList: permissions.PrivilegeToAPI(1),
 				},
 				Cursor: cursor,
 			},
 		},
 		Nodes: []*model.Privilege{
 			{
-				ID:        1,
-				User:      *wallet2,
-				SetAt:     currTime,
-				ExpiresAt: expiresAt,
+				ID:             1,
+				User:           *wallet2,
+				SetAt:          currTime,
+				ExpiresAt:      expiresAt,
+				PermissionList: permissions.PrivilegeToAPI(1),
 			},
 		},
 		PageInfo: &model.PageInfo{
@@ -355,20 +360,22 @@ func (s *VehiclesPrivilegesRepoTestSuite) Test_Privileges_Pagination_Success() {
 		Edges: []*model.PrivilegeEdge{
 			{
 				Node: &model.Privilege{
-					ID:        1,
-					User:      *wallet2,
-					SetAt:     currTime,
-					ExpiresAt: expiresAt,
+					ID:             1,
+					User:           *wallet2,
+					SetAt:          currTime,
+					ExpiresAt:      expiresAt,
+					PermissionList: permissions.PrivilegeToAPI(1),
 				},
 				Cursor: cursor,
 			},
 		},
 		Nodes: []*model.Privilege{
 			{
-				ID:        1,
-				User:      *wallet2,
-				SetAt:     currTime,
-				ExpiresAt: expiresAt,
+				ID:             1,
+				User:           *wallet2,
+				SetAt:          currTime,
+				ExpiresAt:      expiresAt,
+				PermissionList: permissions.PrivilegeToAPI(1),
 			},
 		},
 		PageInfo: &model.PageInfo{
@@ -393,20 +400,22 @@ func (s *VehiclesPrivilegesRepoTestSuite) Test_Privileges_Pagination_Success() {
 		Edges: []*model.PrivilegeEdge{
 			{
 				Node: &model.Privilege{
-					ID:        2,
-					User:      *wallet2,
-					SetAt:     currTime,
-					ExpiresAt: expiresAt,
+					ID:             2,
+					User:           *wallet2,
+					SetAt:          currTime,
+					ExpiresAt:      expiresAt,
+					PermissionList: permissions.PrivilegeToAPI(2),
 				},
 				Cursor: cursor,
 			},
 		},
 		Nodes: []*model.Privilege{
 			{
-				ID:        2,
-				User:      *wallet2,
-				SetAt:     currTime,
-				ExpiresAt: expiresAt,
+				ID:             2,
+				User:           *wallet2,
+				SetAt:          currTime,
+				ExpiresAt:      expiresAt,
+				PermissionList: permissions.PrivilegeToAPI(2),
 			},
 		},
 		PageInfo: &model.PageInfo{
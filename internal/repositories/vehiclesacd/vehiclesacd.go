@@ -1,6 +1,7 @@
 package vehiclesacd
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
@@ -11,11 +12,13 @@ import (
 
 	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/permissions"
+	"github.com/DIMO-Network/identity-api/internal/repositories/accountsacd"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
 	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/volatiletech/sqlboiler/queries"
 )
 
 type Repository struct {
@@ -25,57 +28,133 @@ type Repository struct {
 type SacdCursor struct {
 	CreatedAt time.Time
 	Grantee   []byte
+	// Account is set for account SACDs, which sort after a vehicle SACD for the same grantee set
+	// at the same time.
+	Account bool
 }
 
-func sacdToAPIResponse(pr *models.VehicleSacd) (*gmodel.Sacd, error) {
+// compareCursors orders SACDs newest first, then by grantee.
+func compareCursors(a, b SacdCursor) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.Grantee, b.Grantee); c != 0 {
+		return c
+	}
+	switch {
+	case a.Account == b.Account:
+		return 0
+	case b.Account:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// row is a SACD that applies to a vehicle: either one set on the vehicle, or an account SACD set
+// by its owner.
+type row struct {
+	vehicle  *models.VehicleSacd
+	account  *models.AccountSacd
+	template *models.Template
+}
+
+func (r *row) cursor() SacdCursor {
+	if r.account != nil {
+		return SacdCursor{CreatedAt: r.account.CreatedAt, Grantee: r.account.Grantee, Account: true}
+	}
+	return SacdCursor{CreatedAt: r.vehicle.CreatedAt, Grantee: r.vehicle.Grantee}
+}
+
+func (r *row) templateID() null.Bytes {
+	if r.account != nil {
+		return r.account.TemplateID
+	}
+	return r.vehicle.TemplateID
+}
+
+func (r *row) toAPI() (*gmodel.Sacd, error) {
+	var sacd *gmodel.Sacd
+	var err error
+	if r.account != nil {
+		sacd, err = accountsacd.SacdToAPI(r.account)
+	} else {
+		sacd, err = SacdToAPI(r.vehicle)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if r.template != nil {
+		sacd.Template, err = TemplateToAPI(r.template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sacd, nil
+}
+
+// TemplateToAPI converts the template of a SACD to its API form.
+func TemplateToAPI(template *models.Template) (*gmodel.Template, error) {
+	perms, ok := permissions.FromBinary(template.Permissions)
+	if !ok {
+		return nil, fmt.Errorf("couldn't parse template permission string %q as binary", template.Permissions)
+	}
+
+	return &gmodel.Template{
+		TokenID:        new(big.Int).SetBytes(template.ID),
+		Creator:        common.BytesToAddress(template.Creator),
+		Asset:          common.BytesToAddress(template.Asset),
+		Permissions:    template.Permissions,
+		PermissionList: permissions.ToAPI(perms),
+		Cid:            template.Cid,
+		CreatedAt:      template.CreatedAt,
+	}, nil
+}
+
+// SacdToAPI converts a vehicle SACD to its API form, including its template if it has been
+// loaded.
+func SacdToAPI(pr *models.VehicleSacd) (*gmodel.Sacd, error) {
 	b, ok := new(big.Int).SetString(pr.Permissions, 2)
 	if !ok {
 		return nil, fmt.Errorf("couldn't parse permission string %q as binary", pr.Permissions)
 	}
 
 	sacd := &gmodel.Sacd{
-		Grantee:     common.BytesToAddress(pr.Grantee),
-		Permissions: "0x" + b.Text(16),
-		Source:      pr.Source,
-		CreatedAt:   pr.CreatedAt,
-		ExpiresAt:   pr.ExpiresAt,
+		Grantee:        common.BytesToAddress(pr.Grantee),
+		Permissions:    "0x" + b.Text(16),
+		PermissionList: permissions.ToAPI(permissions.FromMask(b)),
+		Source:         pr.Source,
+		CreatedAt:      pr.CreatedAt,
+		ExpiresAt:      pr.ExpiresAt,
+		Scope:          gmodel.SacdScopeVehicle,
+		VehicleID:      &pr.VehicleID,
 	}
 
 	// Include template information if available
 	if pr.R != nil && pr.R.Template != nil {
-		template := pr.R.Template
-		templateID := new(big.Int).SetBytes(template.ID)
-
-		sacd.Template = &gmodel.Template{
-			TokenID:     templateID,
-			Creator:     common.BytesToAddress(template.Creator),
-			Asset:       common.BytesToAddress(template.Asset),
-			Permissions: template.Permissions,
-			Cid:         template.Cid,
-			CreatedAt:   template.CreatedAt,
+		template, err := TemplateToAPI(pr.R.Template)
+		if err != nil {
+			return nil, err
 		}
+		sacd.Template = template
 	}
 
 	return sacd, nil
 }
 
-func (p *Repository) createSacdResponse(sacds models.VehicleSacdSlice, totalCount int64, hasNext, hasPrevious bool, pHelper helpers.PaginationHelper[SacdCursor]) (*gmodel.SacdConnection, error) {
+func (p *Repository) createSacdResponse(rows []*row, totalCount int64, hasNext, hasPrevious bool, pHelper helpers.PaginationHelper[SacdCursor]) (*gmodel.SacdConnection, error) {
 	var endCur, startCur *string
 
-	if len(sacds) != 0 {
-		ec, err := pHelper.EncodeCursor(SacdCursor{
-			CreatedAt: sacds[len(sacds)-1].CreatedAt,
-			Grantee:   sacds[len(sacds)-1].Grantee,
-		})
+	if len(rows) != 0 {
+		ec, err := pHelper.EncodeCursor(rows[len(rows)-1].cursor())
 		if err != nil {
 			return nil, err
 		}
 		endCur = &ec
 
-		sc, err := pHelper.EncodeCursor(SacdCursor{
-			CreatedAt: sacds[0].CreatedAt,
-			Grantee:   sacds[0].Grantee,
-		})
+		sc, err := pHelper.EncodeCursor(rows[0].cursor())
 		if err != nil {
 			return nil, err
 		}
@@ -83,19 +162,16 @@ func (p *Repository) createSacdResponse(sacds models.VehicleSacdSlice, totalCoun
 		startCur = &sc
 	}
 
-	edges := make([]*gmodel.SacdEdge, len(sacds))
-	nodes := make([]*gmodel.Sacd, len(sacds))
+	edges := make([]*gmodel.SacdEdge, len(rows))
+	nodes := make([]*gmodel.Sacd, len(rows))
 
-	for i, dp := range sacds {
-		gp, err := sacdToAPIResponse(dp)
+	for i, r := range rows {
+		gp, err := r.toAPI()
 		if err != nil {
 			return nil, err
 		}
 
-		crsr, err := pHelper.EncodeCursor(SacdCursor{
-			CreatedAt: dp.CreatedAt,
-			Grantee:   dp.Grantee,
-		})
+		crsr, err := pHelper.EncodeCursor(r.cursor())
 		if err != nil {
 			return nil, err
 		}
@@ -122,7 +198,9 @@ func (p *Repository) createSacdResponse(sacds models.VehicleSacdSlice, totalCoun
 	return res, nil
 }
 
-func (p *Repository) GetSacdsForVehicle(ctx context.Context, tokenID int, first *int, after *string, last *int, before *string) (*gmodel.SacdConnection, error) {
+// GetSacdsForVehicle lists the active SACDs that apply to the vehicle: those set on it, and the
+// account SACDs set by its owner. The two are paged through together.
+func (p *Repository) GetSacdsForVehicle(ctx context.Context, tokenID int, owner common.Address, first *int, after *string, last *int, before *string) (*gmodel.SacdConnection, error) {
 	pHelp := helpers.PaginationHelper[SacdCursor]{}
 
 	limit, err := helpers.ValidateFirstLast(first, last, base.MaxPageSize)
@@ -130,15 +208,26 @@ func (p *Repository) GetSacdsForVehicle(ctx context.Context, tokenID int, first
 		return nil, err
 	}
 
-	queryMods := []qm.QueryMod{
+	now := time.Now()
+
+	vehicleMods := []qm.QueryMod{
 		models.VehicleSacdWhere.VehicleID.EQ(tokenID),
-		models.VehicleSacdWhere.ExpiresAt.GT(time.Now()),
+		models.VehicleSacdWhere.ExpiresAt.GT(now),
+	}
+	accountMods := []qm.QueryMod{
+		models.AccountSacdWhere.Account.EQ(owner.Bytes()),
+		models.AccountSacdWhere.ExpiresAt.GT(now),
 	}
 
-	totalCount, err := models.VehicleSacds(queryMods...).Count(ctx, p.PDB.DBS().Reader)
+	vehicleCount, err := models.VehicleSacds(vehicleMods...).Count(ctx, p.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+	accountCount, err := models.AccountSacds(accountMods...).Count(ctx, p.PDB.DBS().Reader)
 	if err != nil {
 		return nil, err
 	}
+	totalCount := vehicleCount + accountCount
 
 	if totalCount == 0 {
 		return &gmodel.SacdConnection{
@@ -155,14 +244,28 @@ func (p *Repository) GetSacdsForVehicle(ctx context.Context, tokenID int, first
 			return nil, err
 		}
 
-		queryMods = append(
-			queryMods,
+		// An account SACD comes after a vehicle SACD with the same time and grantee.
+		accountGrantee := models.AccountSacdWhere.Grantee.GT(afterCursor.Grantee)
+		if !afterCursor.Account {
+			accountGrantee = models.AccountSacdWhere.Grantee.GTE(afterCursor.Grantee)
+		}
+
+		vehicleMods = append(
+			vehicleMods,
 			qm.Expr(
 				models.VehicleSacdWhere.CreatedAt.EQ(afterCursor.CreatedAt),
 				models.VehicleSacdWhere.Grantee.GT(afterCursor.Grantee),
 				qm.Or2(models.VehicleSacdWhere.CreatedAt.LT(afterCursor.CreatedAt)),
 			),
 		)
+		accountMods = append(
+			accountMods,
+			qm.Expr(
+				models.AccountSacdWhere.CreatedAt.EQ(afterCursor.CreatedAt),
+				accountGrantee,
+				qm.Or2(models.AccountSacdWhere.CreatedAt.LT(afterCursor.CreatedAt)),
+			),
+		)
 	}
 
 	if before != nil {
@@ -171,66 +274,63 @@ func (p *Repository) GetSacdsForVehicle(ctx context.Context, tokenID int, first
 			return nil, err
 		}
 
-		queryMods = append(
-			queryMods,
+		vehicleGrantee := models.VehicleSacdWhere.Grantee.LT(beforeCursor.Grantee)
+		if beforeCursor.Account {
+			vehicleGrantee = models.VehicleSacdWhere.Grantee.LTE(beforeCursor.Grantee)
+		}
+
+		vehicleMods = append(
+			vehicleMods,
 			qm.Expr(
 				models.VehicleSacdWhere.CreatedAt.EQ(beforeCursor.CreatedAt),
-				models.VehicleSacdWhere.Grantee.LT(beforeCursor.Grantee),
+				vehicleGrantee,
 				qm.Or2(models.VehicleSacdWhere.CreatedAt.GT(beforeCursor.CreatedAt)),
 			),
 		)
+		accountMods = append(
+			accountMods,
+			qm.Expr(
+				models.AccountSacdWhere.CreatedAt.EQ(beforeCursor.CreatedAt),
+				models.AccountSacdWhere.Grantee.LT(beforeCursor.Grantee),
+				qm.Or2(models.AccountSacdWhere.CreatedAt.GT(beforeCursor.CreatedAt)),
+			),
+		)
 	}
 
-	orderBy := fmt.Sprintf("%s DESC, %s ASC", models.VehicleSacdColumns.CreatedAt, models.VehicleSacdColumns.Grantee)
+	vehicleOrderBy := fmt.Sprintf("%s DESC, %s ASC", models.VehicleSacdColumns.CreatedAt, models.VehicleSacdColumns.Grantee)
+	accountOrderBy := fmt.Sprintf("%s DESC, %s ASC", models.AccountSacdColumns.CreatedAt, models.AccountSacdColumns.Grantee)
 	if last != nil {
-		orderBy = fmt.Sprintf("%s ASC, %s DESC", models.VehicleSacdColumns.CreatedAt, models.VehicleSacdColumns.Grantee)
+		vehicleOrderBy = fmt.Sprintf("%s ASC, %s DESC", models.VehicleSacdColumns.CreatedAt, models.VehicleSacdColumns.Grantee)
+		accountOrderBy = fmt.Sprintf("%s ASC, %s DESC", models.AccountSacdColumns.CreatedAt, models.AccountSacdColumns.Grantee)
 	}
 
-	queryMods = append(queryMods,
-		qm.Limit(limit+1),
-		qm.OrderBy(orderBy),
-	)
-
-	page, err := models.VehicleSacds(queryMods...).All(ctx, p.PDB.DBS().Reader)
+	// Each source can fill the page on its own, so fetch a full page plus one from both and
+	// merge them.
+	vehicleSacds, err := models.VehicleSacds(append(vehicleMods, qm.Limit(limit+1), qm.OrderBy(vehicleOrderBy))...).All(ctx, p.PDB.DBS().Reader)
+	if err != nil {
+		return nil, err
+	}
+	accountSacds, err := models.AccountSacds(append(accountMods, qm.Limit(limit+1), qm.OrderBy(accountOrderBy))...).All(ctx, p.PDB.DBS().Reader)
 	if err != nil {
 		return nil, err
 	}
 
-	// Load template relationships for SACDs that have them
-	// Custom implementation to work around SQLBoiler null.Bytes map key issue
-	if len(page) > 0 {
-		var templateIDs []any
-		sacdsByTemplateID := make(map[string]*models.VehicleSacd)
-
-		// Collect unique template IDs and build lookup map
-		for _, sacd := range page {
-			if !queries.IsNil(sacd.TemplateID) {
-				templateIDBytes := sacd.TemplateID.Bytes
-				templateIDs = append(templateIDs, templateIDBytes)
-				sacdsByTemplateID[string(templateIDBytes)] = sacd
-			}
-		}
+	page := make([]*row, 0, len(vehicleSacds)+len(accountSacds))
+	for _, vs := range vehicleSacds {
+		page = append(page, &row{vehicle: vs})
+	}
+	for _, as := range accountSacds {
+		page = append(page, &row{account: as})
+	}
 
-		// Load templates if any SACDs have template IDs
-		if len(templateIDs) > 0 {
-			templates, err := models.Templates(
-				qm.WhereIn(models.TemplateColumns.ID+" IN ?", templateIDs...),
-			).All(ctx, p.PDB.DBS().Reader)
-
-			if err != nil {
-				return nil, fmt.Errorf("failed to load templates: %w", err)
-			}
-
-			// Manually associate templates with SACDs
-			for _, template := range templates {
-				if sacd, exists := sacdsByTemplateID[string(template.ID)]; exists {
-					if sacd.R == nil {
-						sacd.R = sacd.R.NewStruct()
-					}
-					sacd.R.Template = template
-				}
-			}
+	slices.SortFunc(page, func(a, b *row) int {
+		if last != nil {
+			return compareCursors(b.cursor(), a.cursor())
 		}
+		return compareCursors(a.cursor(), b.cursor())
+	})
+	if len(page) > limit+1 {
+		page = page[:limit+1]
 	}
 
 	if len(page) == 0 {
@@ -257,21 +357,76 @@ func (p *Repository) GetSacdsForVehicle(ctx context.Context, tokenID int, first
 		slices.Reverse(page)
 	}
 
+	if err := p.loadTemplates(ctx, page); err != nil {
+		return nil, err
+	}
+
 	return p.createSacdResponse(page, totalCount, hasNext, hasPrevious, pHelp)
 }
 
-func (p *Repository) GetSacdForVehicleAndGrantee(ctx context.Context, tokenID int, grantee common.Address) (*gmodel.Sacd, error) {
+// loadTemplates attaches their templates to the SACDs that have one. SQLBoiler's eager loading
+// can't be used here, since it keys on null.Bytes.
+func (p *Repository) loadTemplates(ctx context.Context, rows []*row) error {
+	var templateIDs []any
+	for _, r := range rows {
+		if id := r.templateID(); id.Valid {
+			templateIDs = append(templateIDs, id.Bytes)
+		}
+	}
+
+	if len(templateIDs) == 0 {
+		return nil
+	}
+
+	templates, err := models.Templates(
+		qm.WhereIn(models.TemplateColumns.ID+" IN ?", templateIDs...),
+	).All(ctx, p.PDB.DBS().Reader)
+	if err != nil {
+		return fmt.Errorf("failed to load templates: %w", err)
+	}
+
+	byID := make(map[string]*models.Template, len(templates))
+	for _, t := range templates {
+		byID[string(t.ID)] = t
+	}
+
+	for _, r := range rows {
+		if id := r.templateID(); id.Valid {
+			r.template = byID[string(id.Bytes)]
+		}
+	}
+
+	return nil
+}
+
+// GetSacdForVehicleAndGrantee returns the active SACD for the grantee on the vehicle. A SACD set
+// on the vehicle takes precedence over an account SACD set by its owner.
+func (p *Repository) GetSacdForVehicleAndGrantee(ctx context.Context, tokenID int, owner, grantee common.Address) (*gmodel.Sacd, error) {
+	now := time.Now()
+
 	vs, err := models.VehicleSacds(
 		models.VehicleSacdWhere.VehicleID.EQ(tokenID),
 		models.VehicleSacdWhere.Grantee.EQ(grantee.Bytes()),
-		models.VehicleSacdWhere.ExpiresAt.GT(time.Now()),
+		models.VehicleSacdWhere.ExpiresAt.GT(now),
+	).One(ctx, p.PDB.DBS().Reader)
+	if err == nil {
+		return SacdToAPI(vs)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to search for SACD: %w", err)
+	}
+
+	as, err := models.AccountSacds(
+		models.AccountSacdWhere.Account.EQ(owner.Bytes()),
+		models.AccountSacdWhere.Grantee.EQ(grantee.Bytes()),
+		models.AccountSacdWhere.ExpiresAt.GT(now),
 	).One(ctx, p.PDB.DBS().Reader)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to search for SACD: %w", err)
+		return nil, fmt.Errorf("failed to search for account SACD: %w", err)
 	}
 
-	return sacdToAPIResponse(vs)
+	return accountsacd.SacdToAPI(as)
 }
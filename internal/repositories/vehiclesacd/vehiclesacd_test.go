@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
 	"github.com/DIMO-Network/identity-api/internal/config"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
 	"github.com/DIMO-Network/identity-api/internal/repositories/base"
@@ -61,6 +62,14 @@ func TestVehiclesPrivilegesRepoTestSuite(t *testing.T) {
 	suite.Run(t, new(VehiclesSacdRepoTestSuite))
 }
 
+func vehicleRows(sacds models.VehicleSacdSlice) []*row {
+	rows := make([]*row, len(sacds))
+	for i, vs := range sacds {
+		rows[i] = &row{vehicle: vs}
+	}
+	return rows
+}
+
 func (s *VehiclesSacdRepoTestSuite) TestSacdToAPIResponse_WithoutTemplate() {
 	sacd := &models.VehicleSacd{
 		VehicleID:   1,
@@ -71,7 +80,7 @@ func (s *VehiclesSacdRepoTestSuite) TestSacdToAPIResponse_WithoutTemplate() {
 		ExpiresAt:   time.Now().Add(time.Hour),
 	}
 
-	result, err := sacdToAPIResponse(sacd)
+	result, err := SacdToAPI(sacd)
 	s.NoError(err)
 	s.NotNil(result)
 	s.Nil(result.Template)
@@ -109,7 +118,7 @@ func (s *VehiclesSacdRepoTestSuite) TestSacdToAPIResponse_WithTemplate() {
 	sacd.R = sacd.R.NewStruct()
 	sacd.R.Template = template
 
-	result, err := sacdToAPIResponse(sacd)
+	result, err := SacdToAPI(sacd)
 	s.NoError(err)
 	s.NotNil(result)
 	s.NotNil(result.Template)
@@ -135,7 +144,7 @@ func (s *VehiclesSacdRepoTestSuite) TestSacdToAPIResponse_WithRButNoTemplate() {
 	sacd.R = sacd.R.NewStruct()
 	sacd.R.Template = nil
 
-	result, err := sacdToAPIResponse(sacd)
+	result, err := SacdToAPI(sacd)
 	s.NoError(err)
 	s.NotNil(result)
 	s.Nil(result.Template)
@@ -151,7 +160,7 @@ func (s *VehiclesSacdRepoTestSuite) TestSacdToAPIResponse_InvalidPermissions() {
 		ExpiresAt:   time.Now().Add(time.Hour),
 	}
 
-	result, err := sacdToAPIResponse(sacd)
+	result, err := SacdToAPI(sacd)
 	s.Error(err)
 	s.Nil(result)
 	s.Contains(err.Error(), "couldn't parse permission string")
@@ -226,7 +235,7 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_Success() {
 	}
 
 	first := 10
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, nil, nil, nil)
 	s.NoError(err)
 
 	s.NotNil(res)
@@ -284,7 +293,7 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_NoSacds() {
 	}
 
 	first := 10
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, nil, nil, nil)
 	s.NoError(err)
 
 	s.NotNil(res)
@@ -349,7 +358,7 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_OnlyExpiredSacds() {
 
 	// Test GetSacdsForVehicle - should return empty because SACD is expired
 	first := 10
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, nil, nil, nil)
 	s.NoError(err)
 
 	// Verify empty response (expired SACDs are filtered out)
@@ -424,7 +433,7 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_Pagination_FirstAfter
 	}
 
 	limit := 1
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &limit, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &limit, nil, nil, nil)
 	s.NoError(err)
 
 	// Should return the most recent SACD first (DESC order by created_at)
@@ -441,7 +450,7 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_Pagination_FirstAfter
 	s.Equal("0xa", res.Nodes[0].Permissions) // 1010 binary = a hex
 
 	// Test second page using after cursor
-	res2, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &limit, res.PageInfo.EndCursor, nil, nil)
+	res2, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &limit, res.PageInfo.EndCursor, nil, nil)
 	s.NoError(err)
 
 	s.NotNil(res2)
@@ -522,12 +531,12 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_Pagination_LastBefore
 
 	// Get all SACDs first to get a cursor
 	firstAll := 10
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &firstAll, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &firstAll, nil, nil, nil)
 	s.NoError(err)
 	s.Len(res.Nodes, 2)
 
 	limit := 1
-	res2, err := s.repo.GetSacdsForVehicle(s.ctx, 1, nil, nil, &limit, res.PageInfo.EndCursor)
+	res2, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, nil, nil, &limit, res.PageInfo.EndCursor)
 	s.NoError(err)
 
 	s.NotNil(res2)
@@ -591,7 +600,7 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_WithoutTemplate() {
 	}
 
 	first := 10
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, nil, nil, nil)
 	s.NoError(err)
 
 	s.NotNil(res)
@@ -637,7 +646,7 @@ func (s *VehiclesSacdRepoTestSuite) TestCreateSacdResponse() {
 
 	pHelper := helpers.PaginationHelper[SacdCursor]{}
 
-	result, err := s.repo.createSacdResponse(sacds, 2, true, false, pHelper)
+	result, err := s.repo.createSacdResponse(vehicleRows(sacds), 2, true, false, pHelper)
 	s.NoError(err)
 
 	s.NotNil(result)
@@ -670,7 +679,7 @@ func (s *VehiclesSacdRepoTestSuite) TestCreateSacdResponse() {
 func (s *VehiclesSacdRepoTestSuite) TestCreateSacdResponse_EmptySacds() {
 	pHelper := helpers.PaginationHelper[SacdCursor]{}
 
-	result, err := s.repo.createSacdResponse(models.VehicleSacdSlice{}, 0, false, false, pHelper)
+	result, err := s.repo.createSacdResponse(nil, 0, false, false, pHelper)
 	s.NoError(err)
 
 	s.NotNil(result)
@@ -734,12 +743,12 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_InvalidCursor() {
 
 	invalidCursor := "invalid-cursor!!!"
 	first := 10
-	_, err = s.repo.GetSacdsForVehicle(s.ctx, 1, &first, &invalidCursor, nil, nil)
+	_, err = s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, &invalidCursor, nil, nil)
 	s.Error(err)
 	s.Contains(err.Error(), "illegal base64 data")
 
 	last := 10
-	_, err = s.repo.GetSacdsForVehicle(s.ctx, 1, nil, nil, &last, &invalidCursor)
+	_, err = s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, nil, nil, &last, &invalidCursor)
 	s.Error(err)
 	s.Contains(err.Error(), "illegal base64 data")
 }
@@ -763,7 +772,7 @@ func (s *VehiclesSacdRepoTestSuite) TestCreateSacdResponse_InvalidPermissions()
 
 	pHelper := helpers.PaginationHelper[SacdCursor]{}
 
-	result, err := s.repo.createSacdResponse(sacds, 1, false, false, pHelper)
+	result, err := s.repo.createSacdResponse(vehicleRows(sacds), 1, false, false, pHelper)
 	s.Error(err)
 	s.Nil(result)
 	s.Contains(err.Error(), "couldn't parse permission string")
@@ -772,7 +781,7 @@ func (s *VehiclesSacdRepoTestSuite) TestCreateSacdResponse_InvalidPermissions()
 func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_VehicleNotFound() {
 	nonExistentVehicleID := 99999
 	first := 10
-	res, err := s.repo.GetSacdsForVehicle(s.ctx, nonExistentVehicleID, &first, nil, nil, nil)
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, nonExistentVehicleID, common.Address{}, &first, nil, nil, nil)
 	s.NoError(err)
 
 	s.NotNil(res)
@@ -780,3 +789,89 @@ func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_VehicleNotFound() {
 	s.Len(res.Edges, 0)
 	s.Len(res.Nodes, 0)
 }
+
+func (s *VehiclesSacdRepoTestSuite) TestGetSacdsForVehicle_IncludesAccountSacds() {
+	_, ownerWallet, err := helpers.GenerateWallet()
+	s.NoError(err)
+	_, otherOwner, err := helpers.GenerateWallet()
+	s.NoError(err)
+
+	grantee1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	grantee2 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	currTime := time.Now().UTC().Truncate(time.Second)
+	expiresAt := currTime.Add(time.Hour)
+
+	m := models.Manufacturer{
+		ID:       131,
+		Name:     "Toyota",
+		Owner:    common.FromHex("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		MintedAt: currTime,
+		Slug:     "toyota",
+	}
+	s.Require().NoError(m.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	vehicle := models.Vehicle{
+		ID:             1,
+		ManufacturerID: 131,
+		OwnerAddress:   ownerWallet.Bytes(),
+		MintedAt:       currTime,
+	}
+	s.Require().NoError(vehicle.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	vs := models.VehicleSacd{
+		VehicleID:   1,
+		Grantee:     grantee1.Bytes(),
+		Permissions: "1100",
+		Source:      "vehicle-source",
+		CreatedAt:   currTime,
+		ExpiresAt:   expiresAt,
+	}
+	s.Require().NoError(vs.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+
+	// Set at the same time as the vehicle SACD, for the same grantee.
+	accountSacds := []models.AccountSacd{
+		{Account: ownerWallet.Bytes(), Grantee: grantee1.Bytes(), Permissions: "110000", Source: "account-source-1", CreatedAt: currTime, ExpiresAt: expiresAt},
+		{Account: ownerWallet.Bytes(), Grantee: grantee2.Bytes(), Permissions: "110000", Source: "account-source-2", CreatedAt: currTime.Add(-time.Minute), ExpiresAt: expiresAt},
+		{Account: otherOwner.Bytes(), Grantee: grantee2.Bytes(), Permissions: "110000", Source: "other-owner", CreatedAt: currTime, ExpiresAt: expiresAt},
+	}
+	for _, as := range accountSacds {
+		s.Require().NoError(as.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	sources := func(res *gmodel.SacdConnection) []string {
+		out := make([]string, len(res.Nodes))
+		for i, n := range res.Nodes {
+			out[i] = n.Source
+		}
+		return out
+	}
+
+	first := 2
+	res, err := s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Equal(3, res.TotalCount)
+	s.Equal([]string{"vehicle-source", "account-source-1"}, sources(res))
+	s.Equal(gmodel.SacdScopeVehicle, res.Nodes[0].Scope)
+	s.Equal(gmodel.SacdScopeAccount, res.Nodes[1].Scope)
+	s.True(res.PageInfo.HasNextPage)
+
+	res, err = s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, &first, res.PageInfo.EndCursor, nil, nil)
+	s.Require().NoError(err)
+	s.Equal([]string{"account-source-2"}, sources(res))
+	s.False(res.PageInfo.HasNextPage)
+
+	res, err = s.repo.GetSacdsForVehicle(s.ctx, 1, *ownerWallet, nil, nil, &first, res.PageInfo.StartCursor)
+	s.Require().NoError(err)
+	s.Equal([]string{"vehicle-source", "account-source-1"}, sources(res))
+	s.False(res.PageInfo.HasPreviousPage)
+
+	sacd, err := s.repo.GetSacdForVehicleAndGrantee(s.ctx, 1, *ownerWallet, grantee1)
+	s.Require().NoError(err)
+	s.Equal(gmodel.SacdScopeVehicle, sacd.Scope)
+
+	sacd, err = s.repo.GetSacdForVehicleAndGrantee(s.ctx, 1, *ownerWallet, grantee2)
+	s.Require().NoError(err)
+	s.Equal(gmodel.SacdScopeAccount, sacd.Scope)
+	s.Equal("account-source-2", sacd.Source)
+}
@@ -0,0 +1,66 @@
+package vehicletransfer
+
+import (
+	"context"
+
+	gmodel "github.com/DIMO-Network/identity-api/graph/model"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Repository struct {
+	*base.Repository
+}
+
+// Columns places vehicle transfers on chain.
+var Columns = base.TransferColumns{
+	BlockNumber: models.VehicleTransferColumns.BlockNumber,
+	LogIndex:    models.VehicleTransferColumns.LogIndex,
+	ID:          models.VehicleTransferColumns.ID,
+}
+
+func transferCursor(vt *models.VehicleTransfer) base.TransferCursor {
+	return base.NewTransferCursor(vt.BlockNumber, vt.LogIndex, vt.ID)
+}
+
+func transferToAPIResponse(vt *models.VehicleTransfer) *gmodel.VehicleTransfer {
+	return &gmodel.VehicleTransfer{
+		From:            common.BytesToAddress(vt.FromAddress),
+		To:              common.BytesToAddress(vt.ToAddress),
+		BlockNumber:     int(vt.BlockNumber),
+		BlockTimestamp:  vt.BlockTime,
+		TransactionHash: vt.TransactionHash,
+	}
+}
+
+// GetTransfersForVehicle returns the transfer history of the given vehicle, most recent first.
+func (r *Repository) GetTransfersForVehicle(ctx context.Context, tokenID int, first *int, after *string, last *int, before *string) (*gmodel.VehicleTransferConnection, error) {
+	page, err := base.GetTransferPage(ctx, r.PDB.DBS().Reader,
+		func(mods ...qm.QueryMod) base.Binder {
+			return models.VehicleTransfers(append(mods, models.VehicleTransferWhere.VehicleID.EQ(tokenID))...)
+		},
+		Columns, transferCursor, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gmodel.VehicleTransferEdge, len(page.Rows))
+	nodes := make([]*gmodel.VehicleTransfer, len(page.Rows))
+
+	for i, vt := range page.Rows {
+		nodes[i] = transferToAPIResponse(vt)
+		edges[i] = &gmodel.VehicleTransferEdge{
+			Node:   nodes[i],
+			Cursor: page.Cursors[i],
+		}
+	}
+
+	return &gmodel.VehicleTransferConnection{
+		TotalCount: int(page.TotalCount),
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   page.PageInfo,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package vehicletransfer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/repositories/base"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type VehicleTransferRepoTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pdb       db.Store
+	container *postgres.PostgresContainer
+	repo      *Repository
+	settings  config.Settings
+}
+
+func (s *VehicleTransferRepoTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.pdb, s.container = helpers.StartContainerDatabase(s.ctx, s.T(), "../../../migrations")
+
+	s.settings = config.Settings{
+		DIMORegistryAddr:    "0x4de1bcf2b7e851e31216fc07989caa902a604784",
+		DIMORegistryChainID: 80001,
+	}
+	logger := zerolog.Nop()
+	s.repo = &Repository{base.NewRepository(s.pdb, s.settings, &logger)}
+}
+
+// TearDownTest after each test truncate tables
+func (s *VehicleTransferRepoTestSuite) TearDownTest() {
+	s.Require().NoError(s.container.Restore(s.ctx))
+}
+
+// TearDownSuite cleanup at end by terminating container
+func (s *VehicleTransferRepoTestSuite) TearDownSuite() {
+	fmt.Printf("shutting down postgres at with session: %s \n", s.container.SessionID())
+
+	if err := s.container.Terminate(s.ctx); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+// Test Runner
+func TestVehicleTransferRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(VehicleTransferRepoTestSuite))
+}
+
+func (s *VehicleTransferRepoTestSuite) insertTransfers(vehicleID int, owners []common.Address) {
+	for _, t := range helpers.TransferChain(int64(vehicleID), owners) {
+		vt := models.VehicleTransfer{
+			VehicleID:       vehicleID,
+			FromAddress:     t.From.Bytes(),
+			ToAddress:       t.To.Bytes(),
+			BlockNumber:     t.BlockNumber,
+			BlockTime:       t.BlockTime,
+			TransactionHash: t.TransactionHash,
+		}
+		s.Require().NoError(vt.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+}
+
+func (s *VehicleTransferRepoTestSuite) TestGetTransfersForVehicle() {
+	owners := []common.Address{
+		{},
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		common.HexToAddress("0x3333333333333333333333333333333333333333"),
+	}
+	s.insertTransfers(1, owners)
+	s.insertTransfers(2, owners[:2])
+
+	first := 2
+	res, err := s.repo.GetTransfersForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(3, res.TotalCount)
+	s.True(res.PageInfo.HasNextPage)
+	s.False(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal(300, res.Nodes[0].BlockNumber)
+	s.Equal(owners[2], res.Nodes[0].From)
+	s.Equal(owners[3], res.Nodes[0].To)
+	s.Equal(200, res.Nodes[1].BlockNumber)
+
+	res, err = s.repo.GetTransfersForVehicle(s.ctx, 1, &first, res.PageInfo.EndCursor, nil, nil)
+	s.Require().NoError(err)
+
+	s.False(res.PageInfo.HasNextPage)
+	s.True(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(100, res.Nodes[0].BlockNumber)
+	s.Equal(common.Address{}, res.Nodes[0].From)
+
+	last := 2
+	res, err = s.repo.GetTransfersForVehicle(s.ctx, 1, nil, nil, &last, res.PageInfo.StartCursor)
+	s.Require().NoError(err)
+
+	s.True(res.PageInfo.HasNextPage)
+	s.False(res.PageInfo.HasPreviousPage)
+	s.Require().Len(res.Nodes, 2)
+	s.Equal(300, res.Nodes[0].BlockNumber)
+	s.Equal(200, res.Nodes[1].BlockNumber)
+}
+
+func (s *VehicleTransferRepoTestSuite) TestGetTransfersForVehicle_SameTransaction() {
+	owners := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		common.HexToAddress("0x3333333333333333333333333333333333333333"),
+	}
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	txHash := common.BigToHash(common.Big1).Bytes()
+
+	// Inserted out of order, so that only the log index puts them right.
+	for _, logIndex := range []int{5, 3} {
+		from, to := owners[0], owners[1]
+		if logIndex == 5 {
+			from, to = owners[1], owners[2]
+		}
+		vt := models.VehicleTransfer{
+			VehicleID:       1,
+			FromAddress:     from.Bytes(),
+			ToAddress:       to.Bytes(),
+			BlockNumber:     100,
+			BlockTime:       blockTime,
+			TransactionHash: txHash,
+			LogIndex:        null.IntFrom(logIndex),
+		}
+		s.Require().NoError(vt.Insert(s.ctx, s.pdb.DBS().Writer, boil.Infer()))
+	}
+
+	first := 1
+	res, err := s.repo.GetTransfersForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(2, res.TotalCount)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(owners[2], res.Nodes[0].To)
+
+	res, err = s.repo.GetTransfersForVehicle(s.ctx, 1, &first, res.PageInfo.EndCursor, nil, nil)
+	s.Require().NoError(err)
+
+	s.False(res.PageInfo.HasNextPage)
+	s.Require().Len(res.Nodes, 1)
+	s.Equal(owners[0], res.Nodes[0].From)
+	s.Equal(owners[1], res.Nodes[0].To)
+}
+
+func (s *VehicleTransferRepoTestSuite) TestGetTransfersForVehicle_None() {
+	first := 10
+	res, err := s.repo.GetTransfersForVehicle(s.ctx, 1, &first, nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Zero(res.TotalCount)
+	s.Empty(res.Nodes)
+	s.Empty(res.Edges)
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/goccy/go-json"
+)
+
+// BlockBatcher collects the contract events of a block and applies them in one database
+// transaction once the block's end-of-block event arrives, or once an event from a later block
+// does.
+//
+// An event whose entity has an event waiting in the dead-letter queue isn't added to the block;
+// it goes straight to fallback, which queues it behind the earlier one.
+//
+// Each flush stores the offset of the block's last Kafka message. Buffered events have already
+// been acknowledged to Kafka, but the consumer resumes from the stored offset, so a block that
+// hasn't been flushed when the process stops is delivered again.
+type BlockBatcher struct {
+	consumer *ContractsEventsConsumer
+	fallback func(ctx context.Context, event *cloudevent.RawEvent) error
+	blocked  func(ctx context.Context, event *cloudevent.RawEvent) (bool, error)
+
+	mu      sync.Mutex
+	block   int64
+	pending []*cloudevent.RawEvent
+	// last is the message that delivered the newest buffered event.
+	last kafka.Message
+}
+
+// NewBlockBatcher creates a batcher that feeds blocks to the consumer. If a block fails as a
+// whole, its events are passed to fallback one at a time so that only the failing ones are lost
+// or dead-lettered. blocked reports whether an event has to wait for a dead letter.
+func NewBlockBatcher(consumer *ContractsEventsConsumer, fallback func(ctx context.Context, event *cloudevent.RawEvent) error, blocked func(ctx context.Context, event *cloudevent.RawEvent) (bool, error)) *BlockBatcher {
+	return &BlockBatcher{consumer: consumer, fallback: fallback, blocked: blocked}
+}
+
+func (b *BlockBatcher) Process(ctx context.Context, event *cloudevent.RawEvent) error {
+	if !b.consumer.isChainEvent(event) {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if event.Type != contractEventCEType {
+		b.last, _ = kafka.MessageFromContext(ctx)
+		return b.flush(ctx)
+	}
+
+	var data cmodels.ContractEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return err
+	}
+
+	if data.Block.Number != nil {
+		if number := data.Block.Number.Int64(); number != b.block {
+			if err := b.flush(ctx); err != nil {
+				return err
+			}
+			b.block = number
+		}
+	}
+
+	b.last, _ = kafka.MessageFromContext(ctx)
+
+	blocked, err := b.blocked(ctx, event)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		// The offset is stored with the block, so the event must not claim it.
+		return b.fallback(kafka.WithMessage(ctx, kafka.Message{}), event)
+	}
+
+	b.pending = append(b.pending, event)
+
+	return nil
+}
+
+func (b *BlockBatcher) flush(ctx context.Context) error {
+	events := b.pending
+	b.pending = nil
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	// The offset stored for the block is that of its last event, not of the event that
+	// triggered the flush.
+	ctx = kafka.WithMessage(ctx, b.last)
+
+	err := b.consumer.ProcessBlock(ctx, events)
+	if err == nil {
+		return nil
+	}
+
+	b.consumer.log.Err(err).Int64("blockNumber", b.block).Int("events", len(events)).Msg("Failed to process block, retrying its events individually.")
+
+	// The events share one offset, so the retries must not claim it one by one.
+	eventCtx := kafka.WithMessage(ctx, kafka.Message{})
+
+	var errs []error
+	for _, event := range events {
+		if err := b.fallback(eventCtx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Store the offset once every event has been applied or dead-lettered.
+	if err := b.consumer.ProcessBlock(ctx, nil); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
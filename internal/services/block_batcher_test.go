@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func notBlocked(context.Context, *cloudevent.RawEvent) (bool, error) {
+	return false, nil
+}
+
+func Test_BlockBatcher_FailedBlockFallsBackToSingleEvents(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	settings := config.Settings{
+		VehicleNFTAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	var fallback []string
+	batcher := NewBlockBatcher(contractEventConsumer, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		fallback = append(fallback, event.ID)
+		return contractEventConsumer.Process(ctx, event)
+	}, notBlocked)
+
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	newOwner := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	m := models.Manufacturer{ID: 131, Name: "Toyota", Owner: owner.Bytes(), MintedAt: time.Now(), Slug: "toyota"}
+	require.NoError(t, m.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	v := models.Vehicle{ID: 100, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: time.Now()}
+	require.NoError(t, v.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	ced := contractEventData
+	ced.EventName = "Transfer"
+	ced.Block.Number = big.NewInt(5)
+
+	good := prepareEvent(t, ced, TransferData{From: owner, To: newOwner, TokenID: big.NewInt(100)})
+	good.ID = "good"
+
+	bad := prepareEvent(t, ced, "not a transfer")
+	bad.ID = "bad"
+
+	require.NoError(t, batcher.Process(ctx, &good))
+	require.NoError(t, batcher.Process(ctx, &bad))
+	assert.Empty(t, fallback)
+
+	// Nothing is written until the block ends.
+	require.NoError(t, v.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, owner.Bytes(), v.OwnerAddress)
+
+	endOfBlock := cloudevent.RawEvent{CloudEventHeader: cloudevent.CloudEventHeader{Source: good.Source, Type: "zone.dimo.contract.block"}}
+	require.Error(t, batcher.Process(ctx, &endOfBlock))
+	assert.Equal(t, []string{"good", "bad"}, fallback)
+
+	require.NoError(t, v.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, newOwner.Bytes(), v.OwnerAddress)
+}
+
+func Test_BlockBatcher_BlockedEventBypassesBlock(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	settings := config.Settings{
+		VehicleNFTAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	// Vehicle 100 has a dead letter; vehicle 101 doesn't.
+	blocked := func(_ context.Context, event *cloudevent.RawEvent) (bool, error) {
+		return contractEventConsumer.EntityKey(event) == "vehicle/100", nil
+	}
+
+	var fallback []string
+	batcher := NewBlockBatcher(contractEventConsumer, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		_, fromKafka := kafka.MessageFromContext(ctx)
+		assert.False(t, fromKafka)
+		fallback = append(fallback, event.ID)
+		return nil
+	}, blocked)
+
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	newOwner := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	m := models.Manufacturer{ID: 131, Name: "Toyota", Owner: owner.Bytes(), MintedAt: time.Now(), Slug: "toyota"}
+	require.NoError(t, m.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	v100 := models.Vehicle{ID: 100, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: time.Now()}
+	require.NoError(t, v100.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+	v101 := models.Vehicle{ID: 101, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: time.Now()}
+	require.NoError(t, v101.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	ced := contractEventData
+	ced.EventName = "Transfer"
+	ced.Block.Number = big.NewInt(5)
+
+	held := prepareEvent(t, ced, TransferData{From: owner, To: newOwner, TokenID: big.NewInt(100)})
+	held.ID = "held"
+	free := prepareEvent(t, ced, TransferData{From: owner, To: newOwner, TokenID: big.NewInt(101)})
+	free.ID = "free"
+
+	msgCtx := kafka.WithMessage(ctx, kafka.Message{Topic: "contract-events", Partition: 0, Offset: 7})
+	require.NoError(t, batcher.Process(msgCtx, &held))
+	require.NoError(t, batcher.Process(msgCtx, &free))
+	assert.Equal(t, []string{"held"}, fallback)
+
+	endOfBlock := cloudevent.RawEvent{CloudEventHeader: cloudevent.CloudEventHeader{Source: free.Source, Type: "zone.dimo.contract.block"}}
+	require.NoError(t, batcher.Process(ctx, &endOfBlock))
+	assert.Equal(t, []string{"held"}, fallback)
+
+	require.NoError(t, v100.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, owner.Bytes(), v100.OwnerAddress)
+	require.NoError(t, v101.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, newOwner.Bytes(), v101.OwnerAddress)
+}
@@ -3,6 +3,7 @@ package connection
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 
 	"github.com/DIMO-Network/identity-api/internal/helpers"
@@ -11,28 +12,25 @@ import (
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/DIMO-Network/shared/pkg/db"
-
 	"github.com/rs/zerolog"
 )
 
 type Handler struct {
-	DBS    db.Store
 	Logger *zerolog.Logger
 }
 
-func (h *Handler) Handle(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) Handle(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	switch ev.EventSignature {
 	case ConnectionMintedEventID:
-		return h.HandleLicenseMinted(ctx, ev)
+		return h.HandleLicenseMinted(ctx, tx, ev)
 	case TransferEventID:
-		return h.HandleTransfer(ctx, ev)
+		return h.HandleTransfer(ctx, tx, ev)
 	default:
 		return nil
 	}
 }
 
-func (h *Handler) HandleLicenseMinted(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) HandleLicenseMinted(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	var lm ConnectionMinted
 	err := json.Unmarshal(ev.Arguments, &lm)
 	if err != nil {
@@ -51,7 +49,7 @@ func (h *Handler) HandleLicenseMinted(ctx context.Context, ev *cmodels.ContractE
 		MintedAt: ev.Block.Time,
 	}
 
-	err = conn.Upsert(ctx, h.DBS.DBS().Writer, false, []string{dmodels.ConnectionColumns.ID}, boil.None(), boil.Infer())
+	err = conn.Upsert(ctx, tx, false, []string{dmodels.ConnectionColumns.ID}, boil.None(), boil.Infer())
 	if err != nil {
 		return err
 	}
@@ -61,7 +59,7 @@ func (h *Handler) HandleLicenseMinted(ctx context.Context, ev *cmodels.ContractE
 	return nil
 }
 
-func (h *Handler) HandleTransfer(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) HandleTransfer(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	var t Transfer
 	err := json.Unmarshal(ev.Arguments, &t)
 	if err != nil {
@@ -83,7 +81,7 @@ func (h *Handler) HandleTransfer(ctx context.Context, ev *cmodels.ContractEventD
 		Owner: t.To.Bytes(),
 	}
 
-	_, err = conn.Update(ctx, h.DBS.DBS().Writer, boil.Whitelist(dmodels.ConnectionColumns.Owner))
+	_, err = conn.Update(ctx, tx, boil.Whitelist(dmodels.ConnectionColumns.Owner))
 	return err
 }
 
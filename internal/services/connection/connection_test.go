@@ -26,13 +26,25 @@ func TestHandleMintAndTransfer(t *testing.T) {
 	log := zerolog.Nop()
 
 	h := Handler{
-		DBS:    pdb,
 		Logger: &log,
 	}
 
+	handle := func(ev *models.ContractEventData) error {
+		tx, err := pdb.DBS().Writer.BeginTx(t.Context(), nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() //nolint
+
+		if err := h.Handle(t.Context(), tx, ev); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
 	// Case taken from
 	// https://amoy.polygonscan.com/tx/0x344a769602df87e9c46f6f7f8752f6bb13ce6f9ae53e7598513af6c280c007a7
-	err := h.Handle(t.Context(), &models.ContractEventData{
+	err := handle(&models.ContractEventData{
 		EventSignature: common.HexToHash("0x16e7256a94e935dc419efa2b47bdb62ec5023b40947b172169eeb37b9b132686"),
 		Block: models.Block{
 			Time: time.Date(2025, 5, 4, 9, 0, 0, 0, time.UTC),
@@ -73,7 +85,7 @@ func TestHandleMintAndTransfer(t *testing.T) {
 		t.Errorf("Unexpected owner %q", c.Owner)
 	}
 
-	err = h.Handle(t.Context(), &models.ContractEventData{
+	err = handle(&models.ContractEventData{
 		EventSignature: common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
 		Block: models.Block{
 			Time: time.Date(2025, 5, 4, 11, 0, 0, 0, time.UTC),
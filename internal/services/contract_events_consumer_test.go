@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -37,6 +39,8 @@ const (
 )
 
 var (
+	preparedEvents atomic.Int64
+
 	zeroDecimal = types.NewDecimal(decimal.New(0, 0))
 	mintedAt    = time.Now()
 	cloudEvent  = cloudevent.RawEvent{
@@ -55,7 +59,8 @@ var (
 		TransactionHash: common.HexToHash("0x811a85e24d0129a2018c9a6668652db63d73bc6d1c76f21b07da2162c6bfea7d"),
 		EventSignature:  common.HexToHash("0xd624fd4c3311e1803d230d97ce71fd60c4f658c30a31fbe08edcb211fd90f63f"),
 		Block: cmodels.Block{
-			Time: mintedAt,
+			Number: big.NewInt(1),
+			Time:   mintedAt,
 		},
 	}
 )
@@ -63,12 +68,15 @@ var (
 // prepareEvent turns ContractEventData (the block time, number, etc) and the event arguments (from, to, tokenId, etc)
 // into a cloudevent.RawEvent like the processor expects.
 //
-// Note that this relies on the global variable cloudEvent to fill in the top level object.
+// Note that this relies on the global variable cloudEvent to fill in the top level object. Each
+// call gets a fresh event id, since the processor skips ids it has already applied.
 func prepareEvent(t *testing.T, contractEventData cmodels.ContractEventData, args any) cloudevent.RawEvent {
 	// Copy, just in case.
 	ce := cloudEvent
 	ced := contractEventData
 
+	ce.ID = fmt.Sprintf("%s-%d", cloudEvent.ID, preparedEvents.Add(1))
+
 	argBytes, err := json.Marshal(args)
 	require.NoError(t, err)
 
@@ -214,6 +222,78 @@ func TestHandleAftermarketDevicePairedEvent(t *testing.T) {
 	assert.Equal(t, aftermarketDevicePairData.VehicleNode.Int64(), int64(ad.R.Vehicle.ID))
 }
 
+func TestHandleAftermarketDevicePairing_RecordsHistory(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	pairData := AftermarketDevicePairData{
+		Owner:                 common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		AftermarketDeviceNode: big.NewInt(1),
+		VehicleNode:           big.NewInt(11),
+	}
+
+	settings := config.Settings{
+		DIMORegistryAddr:    contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	m := models.Manufacturer{
+		ID:       130,
+		Name:     "Tesla",
+		Owner:    common.FromHex("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		MintedAt: time.Now(),
+		Slug:     "tesla",
+	}
+	require.NoError(t, m.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	v := models.Vehicle{
+		ID:             11,
+		ManufacturerID: 130,
+		OwnerAddress:   pairData.Owner.Bytes(),
+		MintedAt:       time.Now(),
+	}
+	require.NoError(t, v.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	d := models.AftermarketDevice{
+		ID:             1,
+		ManufacturerID: 130,
+		Address:        common.FromHex("0xabb3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		Owner:          pairData.Owner.Bytes(),
+		Beneficiary:    pairData.Owner.Bytes(),
+	}
+	require.NoError(t, d.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	for i, name := range []string{"AftermarketDevicePaired", "AftermarketDeviceUnpaired"} {
+		data := contractEventData
+		data.EventName = name
+		data.Block.Number = big.NewInt(int64(i + 1))
+
+		e := prepareEvent(t, data, pairData)
+		require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	}
+
+	history, err := models.AftermarketDeviceHistories(
+		models.AftermarketDeviceHistoryWhere.VehicleID.EQ(null.IntFrom(11)),
+		qm.OrderBy(models.AftermarketDeviceHistoryColumns.ID),
+	).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, history, 2)
+	assert.Equal(t, "AftermarketDevicePaired", history[0].EventName)
+	assert.Equal(t, "AftermarketDeviceUnpaired", history[1].EventName)
+	for _, h := range history {
+		assert.Equal(t, 1, h.AftermarketDeviceID)
+		assert.Equal(t, pairData.Owner.Bytes(), h.Owner.Bytes)
+	}
+	assert.EqualValues(t, 2, history[1].BlockNumber)
+
+	require.NoError(t, d.Reload(ctx, pdb.DBS().Reader))
+	assert.False(t, d.VehicleID.Valid)
+}
+
 func TestHandleAftermarketDeviceUnClaimedEvent(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
@@ -522,6 +602,14 @@ func TestHandleBeneficiarySetEvent(t *testing.T) {
 	assert.Equal(t, beneficiarySetData.NodeId.Int64(), int64(ad.ID))
 	assert.Equal(t, beneficiarySetData.Beneficiary.Bytes(), ad.Beneficiary)
 
+	changes, err := models.AftermarketDeviceBeneficiaries().All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, 100, changes[0].AftermarketDeviceID)
+	assert.Equal(t, "BeneficiarySet", changes[0].EventName)
+	assert.Equal(t, beneficiarySetData.Beneficiary.Bytes(), changes[0].Beneficiary)
+	assert.True(t, changes[0].Explicit)
 }
 
 func TestHandleClearBeneficiaryEvent(t *testing.T) {
@@ -575,6 +663,15 @@ func TestHandleClearBeneficiaryEvent(t *testing.T) {
 
 	assert.Equal(t, beneficiarySetData.NodeId.Int64(), int64(ad.ID))
 	assert.Equal(t, ad.Owner, ad.Beneficiary)
+
+	changes, err := models.AftermarketDeviceBeneficiaries().All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	// Clears are recorded with the owner as the effective beneficiary.
+	require.Len(t, changes, 1)
+	assert.Equal(t, ad.Owner, changes[0].Beneficiary)
+	assert.False(t, changes[0].Explicit)
+	assert.Equal(t, contractEventData.Block.Time.UTC().Truncate(time.Microsecond), changes[0].BlockTime.UTC().Truncate(time.Microsecond))
 }
 
 func TestHandle_SyntheticDeviceNodeMintedEvent_Success(t *testing.T) {
@@ -799,9 +896,140 @@ func Test_HandleVehicle_Transferred_Event(t *testing.T) {
 
 	assert.Equal(t, tkID, veh[0].ID)
 	assert.Equal(t, vehicleTransferredData.To.Bytes(), veh[0].OwnerAddress)
+
+	transfers, err := models.VehicleTransfers(
+		models.VehicleTransferWhere.VehicleID.EQ(tkID),
+	).All(ctx, pdb.DBS().Reader.DB)
+	assert.NoError(t, err)
+
+	assert.Len(t, transfers, 1)
+
+	assert.Equal(t, vehicleTransferredData.From.Bytes(), transfers[0].FromAddress)
+	assert.Equal(t, vehicleTransferredData.To.Bytes(), transfers[0].ToAddress)
+	assert.Equal(t, contractEventData.Block.Number.Int64(), transfers[0].BlockNumber)
+	assert.Equal(t, contractEventData.TransactionHash.Bytes(), transfers[0].TransactionHash)
+}
+
+func Test_HandleVehicle_Transferred_Event_WithoutBlockNumber(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	ced := contractEventData
+	ced.EventName = "Transfer"
+	ced.Block.Number = nil
+
+	tkID := 100
+	settings := config.Settings{
+		VehicleNFTAddr:      ced.Contract.String(),
+		DIMORegistryChainID: ced.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+	e := prepareEvent(t, ced, TransferData{
+		From:    common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		To:      common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		TokenID: big.NewInt(int64(tkID)),
+	})
+
+	err := contractEventConsumer.Process(ctx, &e)
+	require.ErrorContains(t, err, "no block number")
+
+	transfers, err := models.VehicleTransfers(models.VehicleTransferWhere.VehicleID.EQ(tkID)).Count(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.Zero(t, transfers)
+
+	events, err := models.ContractEvents().Count(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.Zero(t, events)
+}
+
+func Test_HandleVehicle_Transferred_Event_RecordsGrantHistory(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+	contractEventData.EventName = "Transfer"
+
+	tkID := 100
+	var vehicleTransferredData = TransferData{
+		From:    common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		To:      common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		TokenID: big.NewInt(int64(tkID)),
+	}
+	grantee := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	settings := config.Settings{
+		VehicleNFTAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+	e := prepareEvent(t, contractEventData, vehicleTransferredData)
+
+	m := models.Manufacturer{
+		ID:       131,
+		Name:     "Toyota",
+		Owner:    common.FromHex("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		MintedAt: time.Now(),
+		Slug:     "toyota",
+	}
+	require.NoError(t, m.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	vehicle := models.Vehicle{
+		ID:             tkID,
+		ManufacturerID: 131,
+		OwnerAddress:   vehicleTransferredData.From.Bytes(),
+		MintedAt:       time.Now(),
+	}
+	require.NoError(t, vehicle.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	priv := models.Privilege{
+		TokenID:     tkID,
+		PrivilegeID: 1,
+		UserAddress: grantee.Bytes(),
+		SetAt:       contractEventData.Block.Time.Add(-time.Hour),
+		ExpiresAt:   contractEventData.Block.Time.Add(time.Hour),
+	}
+	require.NoError(t, priv.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	// This one lapsed before the transfer.
+	expiredAt := contractEventData.Block.Time.Add(-time.Minute).UTC().Truncate(time.Second)
+	sacd := models.VehicleSacd{
+		VehicleID:   tkID,
+		Grantee:     grantee.Bytes(),
+		Permissions: big.NewInt(3888).Text(2),
+		Source:      "test-source",
+		CreatedAt:   contractEventData.Block.Time.Add(-time.Hour),
+		ExpiresAt:   expiredAt,
+	}
+	require.NoError(t, sacd.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	require.NoError(t, contractEventConsumer.Process(ctx, &e))
+
+	history, err := models.SacdHistories(qm.OrderBy(models.SacdHistoryColumns.ID)).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, history, 3)
+
+	assert.Equal(t, "transfer", history[0].Cause)
+	assert.Equal(t, null.IntFrom(1), history[0].PrivilegeID)
+	assert.False(t, history[0].Permissions.Valid)
+
+	assert.Equal(t, "expired", history[1].Cause)
+	assert.False(t, history[1].PrivilegeID.Valid)
+	assert.Equal(t, expiredAt, history[1].ChangedAt.UTC())
+	assert.False(t, history[1].BlockNumber.Valid)
+
+	assert.Equal(t, "transfer", history[2].Cause)
+	assert.Equal(t, null.Int64From(contractEventData.Block.Number.Int64()), history[2].BlockNumber)
+
+	for _, h := range history {
+		assert.Equal(t, null.IntFrom(tkID), h.VehicleID)
+		assert.Equal(t, grantee.Bytes(), h.Grantee)
+	}
 }
 
-func Test_HandleVehicle_Transferred_To_Zero_Event_ShouldDelete(t *testing.T) {
+func Test_HandleVehicle_Transferred_To_Zero_Event_ShouldTombstone(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
 	contractEventData.EventName = "Transfer"
@@ -886,24 +1114,27 @@ func Test_HandleVehicle_Transferred_To_Zero_Event_ShouldDelete(t *testing.T) {
 	err = contractEventConsumer.Process(ctx, &e)
 	assert.NoError(t, err)
 
-	exists, err := models.VehicleExists(ctx, pdb.DBS().Reader, tkID)
+	err = vehicle.Reload(ctx, pdb.DBS().Reader)
 	assert.NoError(t, err)
-	assert.False(t, exists)
+	assert.Equal(t, contractEventData.Block.Time.UTC().Truncate(time.Microsecond), vehicle.BurnedAt.Time.UTC().Truncate(time.Microsecond))
+	assert.Equal(t, contractEventData.TransactionHash.Bytes(), vehicle.BurnTransactionHash.Bytes)
+	assert.Equal(t, wallet.Bytes(), vehicle.OwnerAddress)
 
 	numPrivs, err := models.Privileges().Count(ctx, pdb.DBS().Reader)
 	assert.NoError(t, err)
 	assert.Zero(t, numPrivs)
 
+	// Rewards still point at the tombstone.
 	numRewards, err := models.Rewards().Count(ctx, pdb.DBS().Reader)
 	assert.NoError(t, err)
-	assert.Zero(t, numRewards)
+	assert.EqualValues(t, 1, numRewards)
 
 	err = dcn.Reload(ctx, pdb.DBS().Reader.DB)
 	assert.NoError(t, err)
 	assert.False(t, dcn.VehicleID.Valid)
 }
 
-func Test_HandleVehicle_Transferred_To_Zero_Event_NoDelete_SyntheticDevice(t *testing.T) {
+func Test_HandleVehicle_Transferred_To_Zero_Event_RemovesSyntheticDevice(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
 	contractEventData.EventName = "Transfer"
@@ -971,19 +1202,19 @@ func Test_HandleVehicle_Transferred_To_Zero_Event_NoDelete_SyntheticDevice(t *te
 	assert.NoError(t, err)
 
 	err = contractEventConsumer.Process(ctx, &e)
-	assert.Error(t, err)
+	assert.NoError(t, err)
 
-	veh, err := models.Vehicles(
-		models.VehicleWhere.ID.EQ(tkID),
-	).All(ctx, pdb.DBS().Reader.DB)
+	err = vehicle.Reload(ctx, pdb.DBS().Reader)
 	assert.NoError(t, err)
+	assert.True(t, vehicle.BurnedAt.Valid)
+	assert.Equal(t, wallet.Bytes(), vehicle.OwnerAddress)
 
-	assert.Len(t, veh, 1)
-	assert.Equal(t, tkID, veh[0].ID)
-	assert.Equal(t, vehicle.OwnerAddress, veh[0].OwnerAddress)
+	sdExists, err := models.SyntheticDeviceExists(ctx, pdb.DBS().Reader, sd.ID)
+	assert.NoError(t, err)
+	assert.False(t, sdExists)
 }
 
-func Test_HandleVehicle_Transferred_To_Zero_Event_NoDelete_AfterMarketDevice(t *testing.T) {
+func Test_HandleVehicle_Transferred_To_Zero_Event_UnpairsAftermarketDevice(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
 	contractEventData.EventName = "Transfer"
@@ -1060,16 +1291,25 @@ func Test_HandleVehicle_Transferred_To_Zero_Event_NoDelete_AfterMarketDevice(t *
 	assert.NoError(t, err)
 
 	err = contractEventConsumer.Process(ctx, &e)
-	assert.Error(t, err)
+	assert.NoError(t, err)
 
-	veh, err := models.Vehicles(
-		models.VehicleWhere.ID.EQ(tkID),
-	).All(ctx, pdb.DBS().Reader.DB)
+	err = vehicle.Reload(ctx, pdb.DBS().Reader)
 	assert.NoError(t, err)
+	assert.True(t, vehicle.BurnedAt.Valid)
 
-	assert.Len(t, veh, 1)
-	assert.Equal(t, tkID, veh[0].ID)
-	assert.Equal(t, vehicle.OwnerAddress, veh[0].OwnerAddress)
+	err = d.Reload(ctx, pdb.DBS().Reader)
+	assert.NoError(t, err)
+	assert.False(t, d.VehicleID.Valid)
+	assert.False(t, d.PairedAt.Valid)
+
+	history, err := models.AftermarketDeviceHistories(models.AftermarketDeviceHistoryWhere.AftermarketDeviceID.EQ(d.ID)).All(ctx, pdb.DBS().Reader)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 1) {
+		assert.Equal(t, AftermarketDeviceUnpaired.String(), history[0].EventName)
+		assert.Equal(t, null.IntFrom(tkID), history[0].VehicleID)
+		assert.Equal(t, d.Owner, history[0].Owner.Bytes)
+		assert.Equal(t, contractEventData.TransactionHash.Bytes(), history[0].TransactionHash)
+	}
 }
 
 func getCommonEntities(_ context.Context, vehicleID, aftermarketDeviceID, syntheticDeviceID int, owner, beneficiary common.Address) (models.Manufacturer, models.Manufacturer, models.Vehicle, models.AftermarketDevice, models.SyntheticDevice) {
@@ -2018,6 +2258,16 @@ func TestHandlePermissionsRenouncedEventVehicle(t *testing.T) {
 	).Count(ctx, pdb.DBS().Reader)
 	require.NoError(t, err)
 	assert.Equal(t, int64(0), count)
+
+	history, err := models.SacdHistories().All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, history, 1)
+	assert.Equal(t, "renounced", history[0].Cause)
+	assert.Equal(t, null.IntFrom(int(tokenID.Int64())), history[0].VehicleID)
+	assert.Equal(t, grantee.Bytes(), history[0].Grantee)
+	assert.Equal(t, null.StringFrom(existing.Permissions), history[0].Permissions)
+	assert.Equal(t, null.BytesFrom(contractEventData.TransactionHash.Bytes()), history[0].TransactionHash)
 }
 
 func TestHandlePermissionsRenouncedEventAccount(t *testing.T) {
@@ -2210,3 +2460,198 @@ func TestHandlePermissionsRenouncedEventConnection(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(0), count)
 }
+
+func Test_HandleDevLicense_Transferred_Event(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+	contractEventData.EventName = "Transfer"
+
+	transferData := TransferData{
+		From:    common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		To:      common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		TokenID: big.NewInt(7),
+	}
+
+	settings := config.Settings{
+		DevLicenseAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	dl := models.DeveloperLicense{
+		ID:       7,
+		Owner:    transferData.From.Bytes(),
+		ClientID: common.HexToAddress("0x0000000000000000000000000000000000000007").Bytes(),
+		MintedAt: time.Now(),
+	}
+	require.NoError(t, dl.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	e := prepareEvent(t, contractEventData, transferData)
+	require.NoError(t, contractEventConsumer.Process(ctx, &e))
+
+	require.NoError(t, dl.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, transferData.To.Bytes(), dl.Owner)
+
+	transfers, err := models.DeveloperLicenseTransfers(
+		models.DeveloperLicenseTransferWhere.DeveloperLicenseID.EQ(7),
+	).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, transfers, 1)
+	assert.Equal(t, transferData.From.Bytes(), transfers[0].FromAddress)
+	assert.Equal(t, transferData.To.Bytes(), transfers[0].ToAddress)
+	assert.Equal(t, contractEventData.Block.Number.Int64(), transfers[0].BlockNumber)
+	assert.Equal(t, contractEventData.TransactionHash.Bytes(), transfers[0].TransactionHash)
+}
+
+func Test_HandleDevLicense_Transferred_Twice_In_Transaction(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	owners := []common.Address{
+		common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		common.HexToAddress("0x66a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+	}
+
+	settings := config.Settings{
+		DevLicenseAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	dl := models.DeveloperLicense{
+		ID:       7,
+		Owner:    owners[0].Bytes(),
+		ClientID: common.HexToAddress("0x0000000000000000000000000000000000000007").Bytes(),
+		MintedAt: time.Now(),
+	}
+	require.NoError(t, dl.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	for i, logIndex := range []uint{3, 5} {
+		data := contractEventData
+		data.EventName = "Transfer"
+		data.LogIndex = &logIndex
+
+		e := prepareEvent(t, data, TransferData{From: owners[i], To: owners[i+1], TokenID: big.NewInt(7)})
+		require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	}
+
+	require.NoError(t, dl.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, owners[2].Bytes(), dl.Owner)
+
+	transfers, err := models.DeveloperLicenseTransfers(
+		qm.OrderBy(models.DeveloperLicenseTransferColumns.LogIndex),
+	).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, transfers, 2)
+	assert.Equal(t, owners[1].Bytes(), transfers[0].ToAddress)
+	assert.Equal(t, owners[2].Bytes(), transfers[1].ToAddress)
+}
+
+func Test_HandleDevLicense_Transferred_To_Zero_Event_ShouldTombstone(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+	contractEventData.EventName = "Transfer"
+
+	owner := common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+
+	settings := config.Settings{
+		DevLicenseAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	dl := models.DeveloperLicense{
+		ID:       7,
+		Owner:    owner.Bytes(),
+		ClientID: common.HexToAddress("0x0000000000000000000000000000000000000007").Bytes(),
+		MintedAt: time.Now(),
+	}
+	require.NoError(t, dl.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	s := models.Signer{DeveloperLicenseID: 7, Signer: owner.Bytes(), EnabledAt: time.Now()}
+	require.NoError(t, s.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	ru := models.RedirectURI{DeveloperLicenseID: 7, URI: "https://example.com/callback", EnabledAt: time.Now()}
+	require.NoError(t, ru.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	e := prepareEvent(t, contractEventData, TransferData{From: owner, TokenID: big.NewInt(7)})
+	require.NoError(t, contractEventConsumer.Process(ctx, &e))
+
+	require.NoError(t, dl.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, contractEventData.Block.Time.UTC().Truncate(time.Microsecond), dl.BurnedAt.Time.UTC().Truncate(time.Microsecond))
+	assert.Equal(t, owner.Bytes(), dl.Owner)
+
+	// The signers and redirect URIs are kept, but no longer enabled.
+	signer, err := models.Signers().One(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.Equal(t, contractEventData.Block.Time.UTC().Truncate(time.Microsecond), signer.DisabledAt.Time.UTC().Truncate(time.Microsecond))
+
+	uri, err := models.RedirectUris().One(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.Equal(t, contractEventData.Block.Time.UTC().Truncate(time.Microsecond), uri.DisabledAt.Time.UTC().Truncate(time.Microsecond))
+
+	transfers, err := models.DeveloperLicenseTransfers().Count(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, transfers)
+}
+
+func Test_HandleSignerDisabled_KeepsHistory(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	signer := common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+
+	settings := config.Settings{
+		DevLicenseAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	dl := models.DeveloperLicense{
+		ID:       7,
+		Owner:    signer.Bytes(),
+		ClientID: common.HexToAddress("0x0000000000000000000000000000000000000007").Bytes(),
+		MintedAt: time.Now(),
+	}
+	require.NoError(t, dl.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	enabledAt := time.Now().UTC().Truncate(time.Second)
+	disabledAt := enabledAt.Add(time.Hour)
+	reenabledAt := disabledAt.Add(time.Hour)
+
+	for _, step := range []struct {
+		name string
+		time time.Time
+	}{
+		{"SignerEnabled", enabledAt},
+		{"SignerDisabled", disabledAt},
+		{"SignerEnabled", reenabledAt},
+	} {
+		data := contractEventData
+		data.EventName = step.name
+		data.Block.Time = step.time
+
+		e := prepareEvent(t, data, SignerEnabledData{TokenID: big.NewInt(7), Signer: signer})
+		require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	}
+
+	signers, err := models.Signers(qm.OrderBy(models.SignerColumns.EnabledAt)).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	require.Len(t, signers, 2)
+	assert.Equal(t, enabledAt, signers[0].EnabledAt.UTC())
+	assert.Equal(t, disabledAt, signers[0].DisabledAt.Time.UTC())
+	assert.Equal(t, reenabledAt, signers[1].EnabledAt.UTC())
+	assert.False(t, signers[1].DisabledAt.Valid)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/goccy/go-json"
+)
+
+// recordContractEvent appends the event to the contract_events ledger. Redeliveries of the
+// same cloud event are ignored.
+func (c *ContractsEventsConsumer) recordContractEvent(ctx context.Context, tx *sql.Tx, event *cloudevent.RawEvent, data *cmodels.ContractEventData) error {
+	ce := models.ContractEvent{
+		CloudEventID:    event.ID,
+		ChainID:         data.ChainID,
+		Contract:        data.Contract.Bytes(),
+		EventName:       data.EventName,
+		EventSignature:  data.EventSignature.Bytes(),
+		TransactionHash: data.TransactionHash.Bytes(),
+		BlockNumber:     data.Block.Number.Int64(),
+		BlockHash:       data.Block.Hash.Bytes(),
+		BlockTime:       data.Block.Time,
+		Arguments:       types.JSON(data.Arguments),
+		LogIndex:        logIndex(data),
+	}
+
+	if tokenID := argumentsTokenID(data.Arguments); tokenID != nil {
+		if tb, err := helpers.ConvertTokenIDToID(tokenID); err == nil {
+			ce.TokenID = null.BytesFrom(tb)
+		}
+	}
+
+	if err := ce.Upsert(ctx, tx, false, []string{models.ContractEventColumns.CloudEventID}, boil.None(), boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record contract event: %w", err)
+	}
+
+	return nil
+}
+
+// argumentsTokenID pulls the token id out of the raw event arguments, if there is one. Key
+// casing differs between producers, so the match is case-insensitive and ignores the
+// underscores that some of our contracts use for argument names.
+func argumentsTokenID(args json.RawMessage) *big.Int {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(args, &m); err != nil {
+		return nil
+	}
+
+	for k, v := range m {
+		if !strings.EqualFold(strings.Trim(k, "_"), "tokenId") {
+			continue
+		}
+
+		tokenID := new(big.Int)
+		if err := json.Unmarshal(v, tokenID); err != nil {
+			return nil
+		}
+
+		return tokenID
+	}
+
+	return nil
+}
+
+// markApplied records the event as applied in tx. It returns true if it already had been, in
+// which case its handler must not run again. Handlers like handleNewDcnNode insert rather than
+// upsert, so redelivered events would otherwise fail.
+//
+// Events are identified by transaction hash and log index when the producer supplies the index,
+// and by cloud event id otherwise. Either unique key can reject the row.
+func (c *ContractsEventsConsumer) markApplied(ctx context.Context, tx *sql.Tx, event *cloudevent.RawEvent, data *cmodels.ContractEventData) (bool, error) {
+	res, err := tx.ExecContext(ctx, "INSERT INTO identity_api.processed_events (cloud_event_id, transaction_hash, log_index, block_number, processed_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING",
+		event.ID, data.TransactionHash.Bytes(), logIndex(data), data.Block.Number.Int64(), time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to mark event %s as applied: %w", event.ID, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n == 0, nil
+}
+
+// hasArgument reports whether the decoded arguments contain the named one, ignoring case.
+func hasArgument(args map[string]any, name string) bool {
+	for k := range args {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneProcessedEvents forgets events from blocks more than APPLIED_EVENT_RETENTION blocks old,
+// or none if that isn't set. Redeliveries of forgotten events are applied again, so the window
+// should comfortably exceed how far back Kafka redeliveries and re-indexing can reach.
+//
+// Only the first event from a block higher than any seen before prunes; the others would find
+// nothing new to delete.
+func (c *ContractsEventsConsumer) pruneProcessedEvents(ctx context.Context, tx *sql.Tx, number int64) error {
+	if c.settings.AppliedEventRetention <= 0 {
+		return nil
+	}
+
+	last := c.prunedAt.Load()
+	if number <= last || !c.prunedAt.CompareAndSwap(last, number) {
+		return nil
+	}
+
+	cutoff := number - c.settings.AppliedEventRetention
+
+	if _, err := models.ProcessedEvents(models.ProcessedEventWhere.BlockNumber.LT(cutoff)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to prune processed events: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgumentsTokenID(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want *big.Int
+	}{
+		{name: "camel case", args: `{"from":"0x0000000000000000000000000000000000000000","tokenId":42}`, want: big.NewInt(42)},
+		{name: "go field name", args: `{"TokenID":7}`, want: big.NewInt(7)},
+		{name: "underscored", args: `{"_tokenId":115792089237316195423570985008687907853269984665640564039457584007913129639935}`, want: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))},
+		{name: "missing", args: `{"vehicleId":3}`},
+		{name: "not an object", args: `[1,2]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, argumentsTokenID(json.RawMessage(tt.args)))
+		})
+	}
+}
+
+func Test_ContractEventLedger(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	settings := config.Settings{
+		VehicleNFTAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	ced := contractEventData
+	ced.EventName = "Approval"
+
+	e := prepareEvent(t, ced, map[string]any{
+		"owner":    common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		"approved": common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4"),
+		"tokenId":  big.NewInt(12),
+	})
+
+	require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	// Redelivery shouldn't create a second ledger entry.
+	require.NoError(t, contractEventConsumer.Process(ctx, &e))
+
+	ces, err := models.ContractEvents().All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	require.Len(t, ces, 1)
+
+	tokenID, err := helpers.ConvertTokenIDToID(big.NewInt(12))
+	require.NoError(t, err)
+
+	assert.Equal(t, e.ID, ces[0].CloudEventID)
+	assert.Equal(t, "Approval", ces[0].EventName)
+	assert.Equal(t, ced.Contract.Bytes(), ces[0].Contract)
+	assert.Equal(t, ced.TransactionHash.Bytes(), ces[0].TransactionHash)
+	assert.Equal(t, ced.Block.Number.Int64(), ces[0].BlockNumber)
+	assert.Equal(t, tokenID, ces[0].TokenID.Bytes)
+}
+
+func Test_AppliedEventRetention(t *testing.T) {
+	for _, reorgHandling := range []bool{false, true} {
+		t.Run(fmt.Sprintf("reorg handling %t", reorgHandling), func(t *testing.T) {
+			ctx := context.Background()
+			logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+			settings := config.Settings{
+				VehicleNFTAddr:        contractEventData.Contract.String(),
+				DIMORegistryChainID:   contractEventData.ChainID,
+				ReorgHandling:         reorgHandling,
+				ReorgDepth:            5,
+				AppliedEventRetention: 10,
+			}
+
+			pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+			contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+			for _, blockNumber := range []int64{100, 105, 111} {
+				ced := contractEventData
+				ced.EventName = "Approval"
+				ced.Block.Number = big.NewInt(blockNumber)
+				ced.Block.Hash = common.BigToHash(big.NewInt(blockNumber))
+				ced.TransactionHash = common.BigToHash(big.NewInt(blockNumber))
+
+				e := prepareEvent(t, ced, map[string]any{"tokenId": big.NewInt(12)})
+				require.NoError(t, contractEventConsumer.Process(ctx, &e))
+			}
+
+			applied, err := models.ProcessedEvents().All(ctx, pdb.DBS().Reader)
+			require.NoError(t, err)
+
+			var blocks []int64
+			for _, pe := range applied {
+				blocks = append(blocks, pe.BlockNumber)
+			}
+			assert.ElementsMatch(t, []int64{105, 111}, blocks)
+		})
+	}
+}
@@ -2,17 +2,20 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"time"
 
 	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/goccy/go-json"
 )
 
-func (c *ContractsEventsConsumer) handleNewDcnNode(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleNewDcnNode(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", NewNode.String()).Logger()
 
 	var args NewDCNNodeData
@@ -26,7 +29,14 @@ func (c *ContractsEventsConsumer) handleNewDcnNode(ctx context.Context, e *cmode
 		MintedAt:     e.Block.Time,
 	}
 
-	err := dcn.Insert(ctx, c.dbs.DBS().Writer, boil.Infer())
+	// A burned name can be minted again, in which case its tombstone comes back to life.
+	err := dcn.Upsert(ctx, tx, true, []string{models.DCNColumns.Node},
+		boil.Whitelist(
+			models.DCNColumns.OwnerAddress, models.DCNColumns.MintedAt,
+			models.DCNColumns.Expiration, models.DCNColumns.Name, models.DCNColumns.VehicleID,
+			models.DCNColumns.BurnedAt, models.DCNColumns.BurnTransactionHash,
+		),
+		boil.Infer())
 	if err != nil {
 		return err
 	}
@@ -36,7 +46,7 @@ func (c *ContractsEventsConsumer) handleNewDcnNode(ctx context.Context, e *cmode
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleNewDCNExpiration(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleNewDCNExpiration(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", NewExpiration.String()).Logger()
 
 	var args NewDCNExpirationData
@@ -49,7 +59,7 @@ func (c *ContractsEventsConsumer) handleNewDCNExpiration(ctx context.Context, e
 		Expiration: null.TimeFrom(time.Unix(int64(args.Expiration), 0)),
 	}
 
-	_, err := dcn.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.DCNColumns.Expiration))
+	_, err := dcn.Update(ctx, tx, boil.Whitelist(models.DCNColumns.Expiration))
 	if err != nil {
 		return err
 	}
@@ -59,7 +69,7 @@ func (c *ContractsEventsConsumer) handleNewDCNExpiration(ctx context.Context, e
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleNameChanged(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleNameChanged(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	eventName := NameChanged.String()
 	logger := c.log.With().Str("EventName", eventName).Logger()
 
@@ -73,7 +83,7 @@ func (c *ContractsEventsConsumer) handleNameChanged(ctx context.Context, e *cmod
 		Name: null.StringFrom(args.Name),
 	}
 
-	_, err := dcn.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.DCNColumns.Name))
+	_, err := dcn.Update(ctx, tx, boil.Whitelist(models.DCNColumns.Name))
 	if err != nil {
 		return err
 	}
@@ -83,7 +93,7 @@ func (c *ContractsEventsConsumer) handleNameChanged(ctx context.Context, e *cmod
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleVehicleIdChanged(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleVehicleIdChanged(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	eventName := VehicleIdChanged.String()
 	logger := c.log.With().Str("EventName", eventName).Logger()
 
@@ -97,7 +107,7 @@ func (c *ContractsEventsConsumer) handleVehicleIdChanged(ctx context.Context, e
 		VehicleID: null.IntFrom(int(args.VehicleID.Int64())),
 	}
 
-	_, err := dcn.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.DCNColumns.VehicleID))
+	_, err := dcn.Update(ctx, tx, boil.Whitelist(models.DCNColumns.VehicleID))
 	if err != nil {
 		return err
 	}
@@ -106,3 +116,66 @@ func (c *ContractsEventsConsumer) handleVehicleIdChanged(ctx context.Context, e
 
 	return nil
 }
+
+func (c *ContractsEventsConsumer) handleDCNTransfer(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
+	logger := c.log.With().Str("EventName", Transfer.String()).Logger()
+
+	var args TransferData
+	if err := json.Unmarshal(e.Arguments, &args); err != nil {
+		return err
+	}
+
+	// The token id is the node reinterpreted as a uint256.
+	node := common.BigToHash(args.TokenID).Bytes()
+
+	// Like vehicle transfers, the history has no foreign key, so that it outlives burned names.
+	dt := models.DCNTransfer{
+		Node:            node,
+		FromAddress:     args.From.Bytes(),
+		ToAddress:       args.To.Bytes(),
+		BlockNumber:     e.Block.Number.Int64(),
+		BlockTime:       e.Block.Time,
+		TransactionHash: e.TransactionHash.Bytes(),
+		LogIndex:        logIndex(e),
+	}
+
+	if err := dt.Upsert(ctx, tx, false,
+		[]string{models.DCNTransferColumns.Node, models.DCNTransferColumns.TransactionHash, models.DCNTransferColumns.LogIndex},
+		boil.None(), boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record DCN transfer: %w", err)
+	}
+
+	// Handle this with NewNode.
+	if args.From == zeroAddress {
+		return nil
+	}
+
+	if args.To == zeroAddress {
+		// The row stays, with its last owner, so that the name's history still resolves.
+		dcn := models.DCN{
+			Node:                node,
+			BurnedAt:            null.TimeFrom(e.Block.Time),
+			BurnTransactionHash: null.BytesFrom(e.TransactionHash.Bytes()),
+		}
+
+		if _, err := dcn.Update(ctx, tx, boil.Whitelist(models.DCNColumns.BurnedAt, models.DCNColumns.BurnTransactionHash)); err != nil {
+			return err
+		}
+
+		logger.Info().Str("Node", hexutil.Encode(node)).Msg("DCN burned.")
+		return nil
+	}
+
+	dcn := models.DCN{
+		Node:         node,
+		OwnerAddress: args.To.Bytes(),
+	}
+
+	if _, err := dcn.Update(ctx, tx, boil.Whitelist(models.DCNColumns.OwnerAddress)); err != nil {
+		return err
+	}
+
+	logger.Info().Str("Node", hexutil.Encode(node)).Msg(Transfer.String() + " Event processed successfuly")
+
+	return nil
+}
@@ -10,8 +10,10 @@ import (
 
 	"github.com/DIMO-Network/identity-api/internal/config"
 	test "github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
 	"github.com/DIMO-Network/identity-api/models"
 	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/ethereum/go-ethereum/common"
@@ -87,6 +89,86 @@ func (o *DCNConsumerTestSuite) Test_NewNode_Consume_Success() {
 	o.Equal(eventData.Owner.Bytes(), dcn[0].OwnerAddress)
 }
 
+func (o *DCNConsumerTestSuite) Test_NewNode_Redelivered_AppliedOnce() {
+	contractEventData.EventName = NewNode.String()
+	_, wallet, err := test.GenerateWallet()
+	o.NoError(err)
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+	e := prepareEvent(o.T(), contractEventData, NewDCNNodeData{Node: test.GenerateDCNNode(), Owner: *wallet})
+
+	// The insert would fail on a second run.
+	o.NoError(contractEventConsumer.Process(o.ctx, &e))
+	o.NoError(contractEventConsumer.Process(o.ctx, &e))
+
+	count, err := models.DCNS().Count(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.EqualValues(1, count)
+
+	count, err = models.ProcessedEvents().Count(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.EqualValues(1, count)
+}
+
+func (o *DCNConsumerTestSuite) Test_NewNode_SameLogUnderNewID_AppliedOnce() {
+	_, wallet, err := test.GenerateWallet()
+	o.NoError(err)
+
+	data := contractEventData
+	data.EventName = NewNode.String()
+	logIndex := uint(4)
+	data.LogIndex = &logIndex
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+	args := NewDCNNodeData{Node: test.GenerateDCNNode(), Owner: *wallet}
+
+	// Each call gets a fresh cloud event id, as when the log is indexed a second time.
+	first := prepareEvent(o.T(), data, args)
+	second := prepareEvent(o.T(), data, args)
+	o.NotEqual(first.ID, second.ID)
+
+	o.NoError(contractEventConsumer.Process(o.ctx, &first))
+	o.NoError(contractEventConsumer.Process(o.ctx, &second))
+
+	count, err := models.DCNS().Count(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.EqualValues(1, count)
+
+	pe, err := models.ProcessedEvents().One(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.Equal(first.ID, pe.CloudEventID)
+	o.Equal(data.TransactionHash.Bytes(), pe.TransactionHash)
+	o.Equal(4, pe.LogIndex.Int)
+}
+
+func (o *DCNConsumerTestSuite) Test_NewNode_KafkaOffset_SkipsAppliedMessages() {
+	contractEventData.EventName = NewNode.String()
+	_, wallet, err := test.GenerateWallet()
+	o.NoError(err)
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+
+	msg := kafka.Message{Topic: "contract-events", Partition: 2, Offset: 5}
+
+	e := prepareEvent(o.T(), contractEventData, NewDCNNodeData{Node: test.GenerateDCNNode(), Owner: *wallet})
+	o.NoError(contractEventConsumer.Process(kafka.WithMessage(o.ctx, msg), &e))
+
+	// Different events at or before the stored offset are treated as already applied.
+	for _, offset := range []int64{4, 5} {
+		msg.Offset = offset
+		e := prepareEvent(o.T(), contractEventData, NewDCNNodeData{Node: test.GenerateDCNNode(), Owner: *wallet})
+		o.NoError(contractEventConsumer.Process(kafka.WithMessage(o.ctx, msg), &e))
+	}
+
+	count, err := models.DCNS().Count(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.EqualValues(1, count)
+
+	co, err := models.FindConsumerOffset(o.ctx, o.pdb.DBS().Reader, "contract-events", 2)
+	o.NoError(err)
+	o.EqualValues(5, co.LastOffset)
+}
+
 func (o *DCNConsumerTestSuite) Test_NewDCNExpiration_Consume_Success() {
 	contractEventData.EventName = NewExpiration.String()
 
@@ -220,3 +302,180 @@ func (o *DCNConsumerTestSuite) Test_DCN_VehicleIDChanged_Consume_Success() {
 	o.Equal(owner.Bytes(), dcn[0].OwnerAddress)
 	o.Equal(vehicleID, dcn[0].R.Vehicle.ID)
 }
+
+func (o *DCNConsumerTestSuite) Test_DCNTransfer_Consume_Success() {
+	cEventData := contractEventData
+	cEventData.EventName = Transfer.String()
+
+	_, owner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	_, newOwner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	d := models.DCN{
+		Node:         test.GenerateDCNNode(),
+		OwnerAddress: owner.Bytes(),
+	}
+	o.Require().NoError(d.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+	e := prepareEvent(o.T(), cEventData, TransferData{
+		From:    *owner,
+		To:      *newOwner,
+		TokenID: new(big.Int).SetBytes(d.Node),
+	})
+
+	o.NoError(contractEventConsumer.Process(o.ctx, &e))
+
+	o.NoError(d.Reload(o.ctx, o.pdb.DBS().Reader))
+	o.Equal(newOwner.Bytes(), d.OwnerAddress)
+
+	transfers, err := models.DCNTransfers().All(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+
+	o.Require().Len(transfers, 1)
+	o.Equal(d.Node, transfers[0].Node)
+	o.Equal(owner.Bytes(), transfers[0].FromAddress)
+	o.Equal(newOwner.Bytes(), transfers[0].ToAddress)
+	o.Equal(cEventData.Block.Number.Int64(), transfers[0].BlockNumber)
+	o.Equal(cEventData.TransactionHash.Bytes(), transfers[0].TransactionHash)
+}
+
+func (o *DCNConsumerTestSuite) Test_DCNTransfer_Burn_Tombstones() {
+	cEventData := contractEventData
+	cEventData.EventName = Transfer.String()
+
+	_, owner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	d := models.DCN{
+		Node:         test.GenerateDCNNode(),
+		OwnerAddress: owner.Bytes(),
+	}
+	o.Require().NoError(d.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+	e := prepareEvent(o.T(), cEventData, TransferData{
+		From:    *owner,
+		To:      zeroAddress,
+		TokenID: new(big.Int).SetBytes(d.Node),
+	})
+
+	o.NoError(contractEventConsumer.Process(o.ctx, &e))
+
+	o.NoError(d.Reload(o.ctx, o.pdb.DBS().Reader))
+	o.Equal(owner.Bytes(), d.OwnerAddress)
+	o.Equal(cEventData.Block.Time.Unix(), d.BurnedAt.Time.Unix())
+	o.Equal(cEventData.TransactionHash.Bytes(), d.BurnTransactionHash.Bytes)
+
+	// The history is kept.
+	count, err := models.DCNTransfers(models.DCNTransferWhere.Node.EQ(d.Node)).Count(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.EqualValues(1, count)
+}
+
+func (o *DCNConsumerTestSuite) Test_NewNode_RevivesBurnedName() {
+	cEventData := contractEventData
+	cEventData.EventName = NewNode.String()
+
+	_, owner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	_, newOwner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	d := models.DCN{
+		Node:                test.GenerateDCNNode(),
+		OwnerAddress:        owner.Bytes(),
+		Name:                null.StringFrom("old.dimo"),
+		BurnedAt:            null.TimeFrom(time.Now()),
+		BurnTransactionHash: null.BytesFrom(common.HexToHash("0x1").Bytes()),
+	}
+	o.Require().NoError(d.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+	e := prepareEvent(o.T(), cEventData, NewDCNNodeData{
+		Node:  d.Node,
+		Owner: *newOwner,
+	})
+
+	o.NoError(contractEventConsumer.Process(o.ctx, &e))
+
+	o.NoError(d.Reload(o.ctx, o.pdb.DBS().Reader))
+	o.Equal(newOwner.Bytes(), d.OwnerAddress)
+	o.False(d.Name.Valid)
+	o.False(d.BurnedAt.Valid)
+	o.False(d.BurnTransactionHash.Valid)
+}
+
+// Minting a name emits a transfer from the zero address along with NewNode. The transfer only
+// goes into the history, and NewNode creates the name.
+func (o *DCNConsumerTestSuite) Test_NewNode_WithMintTransfer() {
+	_, owner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	node := test.GenerateDCNNode()
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+
+	for i, event := range []struct {
+		name string
+		args any
+	}{
+		{Transfer.String(), TransferData{From: zeroAddress, To: *owner, TokenID: new(big.Int).SetBytes(node)}},
+		{NewNode.String(), NewDCNNodeData{Node: node, Owner: *owner}},
+	} {
+		cEventData := contractEventData
+		cEventData.EventName = event.name
+		logIndex := uint(i)
+		cEventData.LogIndex = &logIndex
+
+		e := prepareEvent(o.T(), cEventData, event.args)
+		o.Require().NoError(contractEventConsumer.Process(o.ctx, &e))
+	}
+
+	d, err := models.FindDCN(o.ctx, o.pdb.DBS().Reader, node)
+	o.Require().NoError(err)
+	o.Equal(owner.Bytes(), d.OwnerAddress)
+
+	transfers, err := models.DCNTransfers(models.DCNTransferWhere.Node.EQ(node)).All(o.ctx, o.pdb.DBS().Reader)
+	o.Require().NoError(err)
+	o.Require().Len(transfers, 1)
+	o.Equal(zeroAddress.Bytes(), transfers[0].FromAddress)
+	o.Equal(owner.Bytes(), transfers[0].ToAddress)
+}
+
+// A transfer is recorded once per node, transaction and log index, even if its event is
+// delivered again under another id.
+func (o *DCNConsumerTestSuite) Test_DCNTransfer_Redelivered() {
+	cEventData := contractEventData
+	cEventData.EventName = Transfer.String()
+	logIndex := uint(7)
+	cEventData.LogIndex = &logIndex
+
+	_, owner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	_, newOwner, err := test.GenerateWallet()
+	o.NoError(err)
+
+	d := models.DCN{
+		Node:         test.GenerateDCNNode(),
+		OwnerAddress: owner.Bytes(),
+	}
+	o.Require().NoError(d.Insert(o.ctx, o.pdb.DBS().Writer, boil.Infer()))
+
+	contractEventConsumer := NewContractsEventsConsumer(o.pdb, &o.logger, &o.settings)
+	for range 2 {
+		e := prepareEvent(o.T(), cEventData, TransferData{
+			From:    *owner,
+			To:      *newOwner,
+			TokenID: new(big.Int).SetBytes(d.Node),
+		})
+		o.Require().NoError(contractEventConsumer.Process(o.ctx, &e))
+	}
+
+	count, err := models.DCNTransfers(models.DCNTransferWhere.Node.EQ(d.Node)).Count(o.ctx, o.pdb.DBS().Reader)
+	o.NoError(err)
+	o.EqualValues(1, count)
+}
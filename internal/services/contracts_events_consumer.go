@@ -2,17 +2,22 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/DIMO-Network/cloudevent"
 	"github.com/DIMO-Network/identity-api/internal/config"
 	"github.com/DIMO-Network/identity-api/internal/dbtypes"
 	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
 	"github.com/DIMO-Network/identity-api/internal/services/connection"
 	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
 	"github.com/DIMO-Network/identity-api/internal/services/staking"
@@ -35,6 +40,9 @@ type ContractsEventsConsumer struct {
 	stakingHandler     *staking.Handler
 	connsHandler       *connection.Handler
 	storageNodeHandler *storagenode.Handler
+
+	// prunedAt is the highest block for which processed events have been pruned.
+	prunedAt atomic.Int64
 }
 
 type EventName string
@@ -112,21 +120,59 @@ func NewContractsEventsConsumer(dbs db.Store, log *zerolog.Logger, settings *con
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
-		stakingHandler: &staking.Handler{
-			DBS: dbs,
-		},
-		connsHandler:       &connection.Handler{DBS: dbs, Logger: log},
-		storageNodeHandler: &storagenode.Handler{DBS: dbs, Logger: log},
+		stakingHandler:     &staking.Handler{},
+		connsHandler:       &connection.Handler{Logger: log},
+		storageNodeHandler: &storagenode.Handler{Logger: log},
 	}
 }
 
+// isChainEvent reports whether the event comes from the configured chain.
+func (c *ContractsEventsConsumer) isChainEvent(event *cloudevent.RawEvent) bool {
+	return event.Source == fmt.Sprintf("chain/%d", c.settings.DIMORegistryChainID)
+}
+
+// Process handles a single contract event inside its own database transaction.
 func (c *ContractsEventsConsumer) Process(ctx context.Context, event *cloudevent.RawEvent) error {
 	// Filter out end-of-block events.
-	if event.Type != contractEventCEType {
+	if event.Type != contractEventCEType || !c.isChainEvent(event) {
 		return nil
 	}
 
-	if event.Source != fmt.Sprintf("chain/%d", c.settings.DIMORegistryChainID) {
+	return c.ProcessBlock(ctx, []*cloudevent.RawEvent{event})
+}
+
+// ProcessBlock handles the given events, in order, inside a single database transaction. If any
+// of them fails then none of their writes are kept. If ctx carries a Kafka message, its offset is
+// stored in the same transaction, and nothing is done if that message was already applied.
+func (c *ContractsEventsConsumer) ProcessBlock(ctx context.Context, events []*cloudevent.RawEvent) error {
+	tx, err := c.dbs.DBS().Writer.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if msg, ok := kafka.MessageFromContext(ctx); ok {
+		claimed, err := kafka.ClaimOffset(ctx, tx, msg)
+		if err != nil {
+			return fmt.Errorf("failed to claim offset %d of partition %d: %w", msg.Offset, msg.Partition, err)
+		}
+		if !claimed {
+			c.log.Debug().Int32("partition", msg.Partition).Int64("offset", msg.Offset).Msg("Skipping message that was already applied.")
+			return nil
+		}
+	}
+
+	for _, event := range events {
+		if err := c.processEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *ContractsEventsConsumer) processEvent(ctx context.Context, tx *sql.Tx, event *cloudevent.RawEvent) error {
+	if event.Type != contractEventCEType || !c.isChainEvent(event) {
 		return nil
 	}
 
@@ -153,121 +199,168 @@ func (c *ContractsEventsConsumer) Process(ctx context.Context, event *cloudevent
 
 	c.log.Debug().Str("Event", string(eventName)).Str("Contract", data.Contract.Hex()).Msg("Event Received")
 
+	watchedAddrs := []common.Address{
+		registryAddr, vehicleNFTAddr, aftermarketDeviceAddr, DCNRegistryAddr, DCNResolverAddr, RewardsContractAddr, sacdAddr,
+		devLicenseAddr, stakingAddr, connAddr, manufacturerAddr, storageNodeAddr, templateAddr,
+	}
+
+	if slices.Contains(watchedAddrs, data.Contract) {
+		// Everything below records the block the event came from. Without it there's no safe
+		// way to apply the event, so it's rejected and ends up in the dead-letter queue.
+		if data.Block.Number == nil {
+			return fmt.Errorf("event %s has no block number", event.ID)
+		}
+
+		// This comes before entering the block, so that the deletions aren't journaled.
+		if err := c.pruneProcessedEvents(ctx, tx, data.Block.Number.Int64()); err != nil {
+			return err
+		}
+
+		// Entering the block first means that the ledger entry is journaled, and so goes away if
+		// the block is reverted.
+		if c.settings.ReorgHandling {
+			if err := c.enterBlock(ctx, tx, &data.Block); err != nil {
+				return err
+			}
+		}
+
+		if err := c.recordContractEvent(ctx, tx, event, &data); err != nil {
+			return err
+		}
+
+		applied, err := c.markApplied(ctx, tx, event, &data)
+		if err != nil {
+			return err
+		}
+		if applied {
+			c.log.Debug().Str("id", event.ID).Msg("Skipping event that was already applied.")
+			return nil
+		}
+
+		if err := setWatermark(ctx, tx, &data); err != nil {
+			return err
+		}
+	}
+
 	switch data.Contract {
 	case registryAddr:
 		switch eventName {
 		case ManufacturerNodeMinted:
-			return c.handleManufacturerNodeMintedEvent(ctx, &data)
+			return c.handleManufacturerNodeMintedEvent(ctx, tx, &data)
 		case DeviceDefinitionTableCreated:
-			return c.handleDeviceDefinitionTableCreated(ctx, &data)
+			return c.handleDeviceDefinitionTableCreated(ctx, tx, &data)
 		case ManufacturerTableSet:
-			return c.handleManufacturerTableSet(ctx, &data)
+			return c.handleManufacturerTableSet(ctx, tx, &data)
 
 		case VehicleNodeMinted:
-			return c.handleVehicleNodeMintedEvent(ctx, &data)
+			return c.handleVehicleNodeMintedEvent(ctx, tx, &data)
 		case VehicleNodeMintedWithDeviceDefinition:
-			return c.handleVehicleNodeMintedWithDeviceDefinitionEvent(ctx, &data)
+			return c.handleVehicleNodeMintedWithDeviceDefinitionEvent(ctx, tx, &data)
 		case VehicleAttributeSet:
-			return c.handleVehicleAttributeSetEvent(ctx, &data)
+			return c.handleVehicleAttributeSetEvent(ctx, tx, &data)
 		case DeviceDefinitionIdSet:
-			return c.handleDeviceDefinitionIdSet(ctx, &data)
+			return c.handleDeviceDefinitionIdSet(ctx, tx, &data)
 		case VehicleStorageNodeIdSet:
-			return c.handleNodeIdSetForVehicleID(ctx, &data)
+			return c.handleNodeIdSetForVehicleID(ctx, tx, &data)
 
 		case AftermarketDeviceNodeMinted:
-			return c.handleAftermarketDeviceMintedEvent(ctx, &data)
+			return c.handleAftermarketDeviceMintedEvent(ctx, tx, &data)
 		case AftermarketDeviceAttributeSet:
-			return c.handleAftermarketDeviceAttributeSetEvent(ctx, &data)
+			return c.handleAftermarketDeviceAttributeSetEvent(ctx, tx, &data)
 		case AftermarketDeviceClaimed:
-			return c.handleAftermarketDeviceClaimedEvent(ctx, &data)
+			return c.handleAftermarketDeviceClaimedEvent(ctx, tx, &data)
 		case AftermarketDeviceUnclaimed:
-			return c.handleAftermarketDeviceUnclaimedEvent(ctx, &data)
+			return c.handleAftermarketDeviceUnclaimedEvent(ctx, tx, &data)
 		case AftermarketDevicePaired:
-			return c.handleAftermarketDevicePairedEvent(ctx, &data)
+			return c.handleAftermarketDevicePairedEvent(ctx, tx, &data)
 		case AftermarketDeviceUnpaired:
-			return c.handleAftermarketDeviceUnpairedEvent(ctx, &data)
+			return c.handleAftermarketDeviceUnpairedEvent(ctx, tx, &data)
 		case BeneficiarySetEvent:
-			return c.handleBeneficiarySetEvent(ctx, &data)
+			return c.handleBeneficiarySetEvent(ctx, tx, &data)
 		case AftermarketDeviceAddressReset:
-			return c.handleAftermarketDeviceAddressResetEvent(ctx, &data)
+			return c.handleAftermarketDeviceAddressResetEvent(ctx, tx, &data)
 
 		case SyntheticDeviceNodeMinted:
-			return c.handleSyntheticDeviceNodeMintedEvent(ctx, &data)
+			return c.handleSyntheticDeviceNodeMintedEvent(ctx, tx, &data)
 		case SyntheticDeviceNodeBurned:
-			return c.handleSyntheticDeviceNodeBurnedEvent(ctx, &data)
+			return c.handleSyntheticDeviceNodeBurnedEvent(ctx, tx, &data)
 		}
 	case vehicleNFTAddr:
 		switch eventName {
 		case Transfer:
-			return c.handleVehicleTransferEvent(ctx, &data)
+			return c.handleVehicleTransferEvent(ctx, tx, &data)
 		case PrivilegeSet:
-			return c.handlePrivilegeSetEvent(ctx, &data)
+			return c.handlePrivilegeSetEvent(ctx, tx, &data)
 		}
 	case sacdAddr:
 		switch eventName {
 		case PermissionsSetEvent:
-			return c.handlePermissionsSetEvent(ctx, &data)
+			return c.handlePermissionsSetEvent(ctx, tx, &data)
 		case PermissionsRenouncedEvent:
-			return c.handlePermissionsRenouncedEvent(ctx, &data)
+			return c.handlePermissionsRenouncedEvent(ctx, tx, &data)
 		}
 	case templateAddr:
 		switch eventName {
 		case TemplateCreatedEvent:
-			return c.handleTemplateCreatedEvent(ctx, &data)
+			return c.handleTemplateCreatedEvent(ctx, tx, &data)
 		}
 	case aftermarketDeviceAddr:
 		switch eventName {
 		case Transfer:
-			return c.handleAftermarketDeviceTransferredEvent(ctx, &data)
+			return c.handleAftermarketDeviceTransferredEvent(ctx, tx, &data)
 		}
 
 	case manufacturerAddr:
 		switch eventName {
 		case Transfer:
-			return c.handleManufacturerTransferEvent(ctx, &data)
+			return c.handleManufacturerTransferEvent(ctx, tx, &data)
 		}
 	case DCNRegistryAddr:
 		switch eventName {
 		case NewNode:
-			return c.handleNewDcnNode(ctx, &data)
+			return c.handleNewDcnNode(ctx, tx, &data)
 		case NewExpiration:
-			return c.handleNewDCNExpiration(ctx, &data)
+			return c.handleNewDCNExpiration(ctx, tx, &data)
+		case Transfer:
+			return c.handleDCNTransfer(ctx, tx, &data)
 		}
 	case DCNResolverAddr:
 		switch eventName {
 		case NameChanged:
-			return c.handleNameChanged(ctx, &data)
+			return c.handleNameChanged(ctx, tx, &data)
 		case VehicleIdChanged:
-			return c.handleVehicleIdChanged(ctx, &data)
+			return c.handleVehicleIdChanged(ctx, tx, &data)
 		}
 	case RewardsContractAddr:
 		switch eventName {
 		case TokensTransferredForDevice:
-			return c.handleTokensTransferredForDevice(ctx, &data)
+			return c.handleTokensTransferredForDevice(ctx, tx, &data)
 		case TokensTransferredForConnectionStreak:
-			return c.handleTokensTransferredForConnectionStreak(ctx, &data)
+			return c.handleTokensTransferredForConnectionStreak(ctx, tx, &data)
 		}
 	case devLicenseAddr:
 		switch eventName {
 		case Issued:
-			return c.handleDevLicenseIssued(ctx, &data)
+			return c.handleDevLicenseIssued(ctx, tx, &data)
 		case LicenseAliasSet:
-			return c.handleDevLicenseAlias(ctx, &data)
+			return c.handleDevLicenseAlias(ctx, tx, &data)
+		case Transfer:
+			return c.handleDevLicenseTransfer(ctx, tx, &data)
 		case RedirectUriEnabled:
-			return c.handleRedirectEnabled(ctx, &data)
+			return c.handleRedirectEnabled(ctx, tx, &data)
 		case RedirectUriDisabled:
-			return c.handleRedirectDisabled(ctx, &data)
+			return c.handleRedirectDisabled(ctx, tx, &data)
 		case SignerEnabled:
-			return c.handleSignerEnabled(ctx, &data)
+			return c.handleSignerEnabled(ctx, tx, &data)
 		case SignerDisabled:
-			return c.handleSignerDisabled(ctx, &data)
+			return c.handleSignerDisabled(ctx, tx, &data)
 		}
 	case stakingAddr:
-		return c.stakingHandler.HandleEvent(ctx, &data)
+		return c.stakingHandler.HandleEvent(ctx, tx, &data)
 	case connAddr:
-		return c.connsHandler.Handle(ctx, &data)
+		return c.connsHandler.Handle(ctx, tx, &data)
 	case storageNodeAddr:
-		return c.storageNodeHandler.Handle(ctx, &data)
+		return c.storageNodeHandler.Handle(ctx, tx, &data)
 	}
 
 	c.log.Debug().Str("event", data.EventName).Msg("Handler not provided for event.")
@@ -275,7 +368,7 @@ func (c *ContractsEventsConsumer) Process(ctx context.Context, event *cloudevent
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleManufacturerNodeMintedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleManufacturerNodeMintedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args ManufacturerNodeMintedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -289,10 +382,10 @@ func (c *ContractsEventsConsumer) handleManufacturerNodeMintedEvent(ctx context.
 		Slug:     strings.SlugString(args.Name), // Better hope uniqueness is never a problem!
 	}
 
-	return mfr.Upsert(ctx, c.dbs.DBS().Writer, false, []string{models.ManufacturerColumns.ID}, boil.None(), boil.Infer())
+	return mfr.Upsert(ctx, tx, false, []string{models.ManufacturerColumns.ID}, boil.None(), boil.Infer())
 }
 
-func (c *ContractsEventsConsumer) handleDeviceDefinitionTableCreated(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleDeviceDefinitionTableCreated(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args DeviceDefinitionTableCreatedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -303,11 +396,11 @@ func (c *ContractsEventsConsumer) handleDeviceDefinitionTableCreated(ctx context
 		TableID: null.IntFrom(int(args.TableId.Int64())),
 	}
 
-	_, err := mfr.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.ManufacturerColumns.TableID))
+	_, err := mfr.Update(ctx, tx, boil.Whitelist(models.ManufacturerColumns.TableID))
 	return err
 }
 
-func (c *ContractsEventsConsumer) handleManufacturerTableSet(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleManufacturerTableSet(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args ManufacturerTableSetData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -318,11 +411,11 @@ func (c *ContractsEventsConsumer) handleManufacturerTableSet(ctx context.Context
 		TableID: null.IntFrom(int(args.TableId.Int64())),
 	}
 
-	_, err := mfr.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.ManufacturerColumns.TableID))
+	_, err := mfr.Update(ctx, tx, boil.Whitelist(models.ManufacturerColumns.TableID))
 	return err
 }
 
-func (c *ContractsEventsConsumer) handleVehicleNodeMintedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleVehicleNodeMintedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args VehicleNodeMintedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -339,7 +432,7 @@ func (c *ContractsEventsConsumer) handleVehicleNodeMintedEvent(ctx context.Conte
 
 	return v.Upsert(
 		ctx,
-		c.dbs.DBS().Writer,
+		tx,
 		false,
 		[]string{cols.ID},
 		boil.None(),
@@ -347,7 +440,7 @@ func (c *ContractsEventsConsumer) handleVehicleNodeMintedEvent(ctx context.Conte
 	)
 }
 
-func (c *ContractsEventsConsumer) handleVehicleNodeMintedWithDeviceDefinitionEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleVehicleNodeMintedWithDeviceDefinitionEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args VehicleNodeMintedWithDeviceDefinitionData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -365,7 +458,7 @@ func (c *ContractsEventsConsumer) handleVehicleNodeMintedWithDeviceDefinitionEve
 
 	return v.Upsert(
 		ctx,
-		c.dbs.DBS().Writer,
+		tx,
 		false,
 		[]string{cols.ID},
 		boil.None(),
@@ -373,7 +466,7 @@ func (c *ContractsEventsConsumer) handleVehicleNodeMintedWithDeviceDefinitionEve
 	)
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceMintedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceMintedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDeviceNodeMintedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -390,17 +483,21 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceMintedEvent(ctx context
 
 	cols := models.AftermarketDeviceColumns
 
-	return ad.Upsert(
+	if err := ad.Upsert(
 		ctx,
-		c.dbs.DBS().Writer,
+		tx,
 		false,
 		[]string{cols.ID},
 		boil.None(),
 		boil.Whitelist(cols.ID, cols.Address, cols.Owner, cols.MintedAt, cols.Beneficiary, cols.ManufacturerID),
-	)
+	); err != nil {
+		return err
+	}
+
+	return c.recordBeneficiaryChange(ctx, tx, e, ad.ID, args.Owner, false)
 }
 
-func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args VehicleAttributeSetData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -417,7 +514,7 @@ func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Con
 			make = null.StringFrom(args.Info)
 		}
 		veh.Make = make
-		_, err := veh.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.Make))
+		_, err := veh.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.Make))
 		return err
 	case "Model":
 		var model null.String
@@ -425,7 +522,7 @@ func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Con
 			model = null.StringFrom(args.Info)
 		}
 		veh.Model = model
-		_, err := veh.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.Model))
+		_, err := veh.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.Model))
 		return err
 	case "Year":
 		var year null.Int
@@ -437,7 +534,7 @@ func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Con
 			year = null.IntFrom(yr)
 		}
 		veh.Year = year
-		_, err := veh.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.Year))
+		_, err := veh.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.Year))
 		return err
 	case "ImageURI":
 		var imageURI null.String
@@ -449,7 +546,7 @@ func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Con
 			imageURI = null.StringFrom(args.Info)
 		}
 		veh.ImageURI = imageURI
-		_, err := veh.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.ImageURI))
+		_, err := veh.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.ImageURI))
 		return err
 	case "DefinitionURI", "DataURI":
 		// We never ended up using these.
@@ -459,7 +556,7 @@ func (c *ContractsEventsConsumer) handleVehicleAttributeSetEvent(ctx context.Con
 	}
 }
 
-func (c *ContractsEventsConsumer) handleDeviceDefinitionIdSet(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleDeviceDefinitionIdSet(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", Transfer.String()).Logger()
 
 	var args DeviceDefinitionIdSetData
@@ -474,7 +571,7 @@ func (c *ContractsEventsConsumer) handleDeviceDefinitionIdSet(ctx context.Contex
 
 	// TODO(elffjs): Should we try to update the MMY fields using Tableland?
 	// TODO(elffjs): Maybe it's interesting if the update count is zero?
-	_, err := vehicle.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.DeviceDefinitionID))
+	_, err := vehicle.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.DeviceDefinitionID))
 	if err != nil {
 		return err
 	}
@@ -484,7 +581,7 @@ func (c *ContractsEventsConsumer) handleDeviceDefinitionIdSet(ctx context.Contex
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleNodeIdSetForVehicleID(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleNodeIdSetForVehicleID(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", Transfer.String()).Logger()
 
 	var args VehicleStorageNodeIdSetData
@@ -502,7 +599,7 @@ func (c *ContractsEventsConsumer) handleNodeIdSetForVehicleID(ctx context.Contex
 		StorageNodeID: null.BytesFrom(anchorID),
 	}
 
-	_, err = vehicle.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.StorageNodeID))
+	_, err = vehicle.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.StorageNodeID))
 	if err != nil {
 		return err
 	}
@@ -514,7 +611,7 @@ func (c *ContractsEventsConsumer) handleNodeIdSetForVehicleID(ctx context.Contex
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleVehicleTransferEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleVehicleTransferEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", Transfer.String()).Logger()
 
 	var args TransferData
@@ -528,36 +625,102 @@ func (c *ContractsEventsConsumer) handleVehicleTransferEvent(ctx context.Context
 		MintedAt:     e.Block.Time,
 	}
 
+	// Record every transfer, including mints and burns. There's no foreign key to vehicles, so
+	// the history outlives the vehicle row.
+	vt := models.VehicleTransfer{
+		VehicleID:       int(args.TokenID.Int64()),
+		FromAddress:     args.From.Bytes(),
+		ToAddress:       args.To.Bytes(),
+		BlockNumber:     e.Block.Number.Int64(),
+		BlockTime:       e.Block.Time,
+		TransactionHash: e.TransactionHash.Bytes(),
+		LogIndex:        logIndex(e),
+	}
+
+	if err := vt.Upsert(ctx, tx, false,
+		[]string{models.VehicleTransferColumns.VehicleID, models.VehicleTransferColumns.TransactionHash, models.VehicleTransferColumns.LogIndex},
+		boil.None(), boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record vehicle transfer: %w", err)
+	}
+
 	// Handle this with VehicleNodeMinted.
 	if args.From == zeroAddress {
 		return nil
 	}
 
 	if args.To == zeroAddress {
-		// Will cascade to the privileges.
-		_, err := vehicle.Delete(ctx, c.dbs.DBS().Writer)
+		return c.burnVehicle(ctx, tx, vehicle.ID, e)
+	}
+
+	_, err := vehicle.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.OwnerAddress))
+	if err != nil {
 		return err
 	}
 
-	_, err := vehicle.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.VehicleColumns.OwnerAddress))
+	if err := c.removeVehicleGrants(ctx, tx, vehicle.ID, e); err != nil {
+		return err
+	}
+
+	logger.Info().Str("TokenID", args.TokenID.String()).Msg("Event processed successfuly")
+
+	return nil
+}
+
+// burnVehicle turns the vehicle into a tombstone. The row stays, with its last owner, so that
+// rewards, stakes and transfers that point at it still resolve, but grants to it are removed and
+// any DCN pointing at it is detached. Paired aftermarket devices are unpaired, and synthetic
+// devices, which can't exist without their vehicle, are removed as if they had been burned.
+func (c *ContractsEventsConsumer) burnVehicle(ctx context.Context, tx *sql.Tx, vehicleID int, e *cmodels.ContractEventData) error {
+	ads, err := models.AftermarketDevices(models.AftermarketDeviceWhere.VehicleID.EQ(null.IntFrom(vehicleID))).All(ctx, tx)
 	if err != nil {
 		return err
 	}
 
-	if _, err := models.Privileges(models.PrivilegeWhere.TokenID.EQ(int(args.TokenID.Int64()))).DeleteAll(ctx, c.dbs.DBS().Writer); err != nil {
-		return fmt.Errorf("failed to delete associated privileges: %w", err)
+	for _, ad := range ads {
+		ad.VehicleID = null.Int{}
+		ad.PairedAt = null.Time{}
+		if _, err := ad.Update(ctx, tx, boil.Whitelist(models.AftermarketDeviceColumns.VehicleID, models.AftermarketDeviceColumns.PairedAt)); err != nil {
+			return fmt.Errorf("failed to unpair aftermarket device %d: %w", ad.ID, err)
+		}
+
+		if err := c.recordAftermarketDeviceHistory(ctx, tx, e, &models.AftermarketDeviceHistory{
+			AftermarketDeviceID: ad.ID,
+			EventName:           AftermarketDeviceUnpaired.String(),
+			VehicleID:           null.IntFrom(vehicleID),
+			Owner:               null.BytesFrom(ad.Owner),
+		}); err != nil {
+			return err
+		}
 	}
 
-	if _, err := models.VehicleSacds(models.VehicleSacdWhere.VehicleID.EQ(int(args.TokenID.Int64()))).DeleteAll(ctx, c.dbs.DBS().Writer); err != nil {
-		return fmt.Errorf("failed to delete associated SACDs: %w", err)
+	if _, err := models.SyntheticDevices(models.SyntheticDeviceWhere.VehicleID.EQ(vehicleID)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to remove synthetic devices: %w", err)
 	}
 
-	logger.Info().Str("TokenID", args.TokenID.String()).Msg("Event processed successfuly")
+	if err := c.removeVehicleGrants(ctx, tx, vehicleID, e); err != nil {
+		return err
+	}
+
+	if _, err := models.DCNS(models.DCNWhere.VehicleID.EQ(null.IntFrom(vehicleID))).UpdateAll(ctx, tx, models.M{models.DCNColumns.VehicleID: nil}); err != nil {
+		return fmt.Errorf("failed to detach DCNs: %w", err)
+	}
+
+	vehicle := models.Vehicle{
+		ID:                  vehicleID,
+		BurnedAt:            null.TimeFrom(e.Block.Time),
+		BurnTransactionHash: null.BytesFrom(e.TransactionHash.Bytes()),
+	}
+
+	if _, err := vehicle.Update(ctx, tx, boil.Whitelist(models.VehicleColumns.BurnedAt, models.VehicleColumns.BurnTransactionHash)); err != nil {
+		return err
+	}
+
+	c.log.Info().Int("vehicleId", vehicleID).Msg("Vehicle burned.")
 
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDeviceAttributeSetData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -572,7 +735,7 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx c
 		ad.Serial = null.StringFrom(args.Info)
 		if _, err := ad.Update(
 			ctx,
-			c.dbs.DBS().Writer,
+			tx,
 			boil.Whitelist(models.AftermarketDeviceColumns.Serial)); err != nil {
 			return err
 		}
@@ -580,7 +743,7 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx c
 		ad.Imei = null.StringFrom(args.Info)
 		if _, err := ad.Update(
 			ctx,
-			c.dbs.DBS().Writer,
+			tx,
 			boil.Whitelist(models.AftermarketDeviceColumns.Imei)); err != nil {
 			return err
 		}
@@ -588,7 +751,7 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx c
 		ad.DevEui = null.StringFrom(args.Info)
 		if _, err := ad.Update(
 			ctx,
-			c.dbs.DBS().Writer,
+			tx,
 			boil.Whitelist(models.AftermarketDeviceColumns.DevEui)); err != nil {
 			return err
 		}
@@ -596,7 +759,7 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx c
 		ad.HardwareRevision = null.StringFrom(args.Info)
 		if _, err := ad.Update(
 			ctx,
-			c.dbs.DBS().Writer,
+			tx,
 			boil.Whitelist(models.AftermarketDeviceColumns.HardwareRevision)); err != nil {
 			return err
 		}
@@ -605,7 +768,7 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceAttributeSetEvent(ctx c
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", PermissionsSetEvent.String()).Logger()
 
 	args, templateID, err := c.parsePermissionsSetArgs(e.Arguments)
@@ -629,7 +792,14 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 			as.TemplateID = null.BytesFrom(templateID)
 		}
 
-		err := as.Upsert(ctx, c.dbs.DBS().Writer, true,
+		var prev *grant
+		if old, err := models.FindAccountSacd(ctx, tx, as.Account, as.Grantee); err == nil {
+			prev = accountSacdGrant(old)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		err := as.Upsert(ctx, tx, true,
 			[]string{models.AccountSacdColumns.Account, models.AccountSacdColumns.Grantee},
 			boil.Whitelist(
 				models.AccountSacdColumns.Permissions,
@@ -644,6 +814,10 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 			return fmt.Errorf("error upserting account SACD: %w", err)
 		}
 
+		if err := c.recordGrantSet(ctx, tx, e, prev, accountSacdGrant(&as)); err != nil {
+			return err
+		}
+
 		logger.Info().
 			Str("account", args.Asset.Hex()).
 			Str("grantee", args.Grantee.Hex()).
@@ -673,7 +847,14 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 			cs.TemplateID = null.BytesFrom(templateID)
 		}
 
-		err = cs.Upsert(ctx, c.dbs.DBS().Writer, true,
+		var prev *grant
+		if old, err := models.FindConnectionSacd(ctx, tx, cs.ConnectionID, cs.Grantee); err == nil {
+			prev = connectionSacdGrant(old)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		err = cs.Upsert(ctx, tx, true,
 			[]string{models.ConnectionSacdColumns.ConnectionID, models.ConnectionSacdColumns.Grantee},
 			boil.Whitelist(
 				models.ConnectionSacdColumns.Permissions,
@@ -688,6 +869,10 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 			return fmt.Errorf("error upserting connection SACD: %w", err)
 		}
 
+		if err := c.recordGrantSet(ctx, tx, e, prev, connectionSacdGrant(&cs)); err != nil {
+			return err
+		}
+
 		logger.Info().
 			Int64("connectionId", args.TokenId.Int64()).
 			Str("grantee", args.Grantee.Hex()).
@@ -715,7 +900,14 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 		sacd.TemplateID = null.BytesFrom(templateID)
 	}
 
-	if err := sacd.Upsert(ctx, c.dbs.DBS().Writer, true,
+	var prev *grant
+	if old, err := models.FindVehicleSacd(ctx, tx, sacd.VehicleID, sacd.Grantee); err == nil {
+		prev = vehicleSacdGrant(old)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if err := sacd.Upsert(ctx, tx, true,
 		[]string{
 			models.VehicleSacdColumns.VehicleID,
 			models.VehicleSacdColumns.Grantee,
@@ -731,6 +923,10 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 		return fmt.Errorf("error upserting vehicle SACD: %w", err)
 	}
 
+	if err := c.recordGrantSet(ctx, tx, e, prev, vehicleSacdGrant(&sacd)); err != nil {
+		return err
+	}
+
 	logger.Info().
 		Int64("vehicleId", args.TokenId.Int64()).
 		Str("grantee", args.Grantee.Hex()).
@@ -739,7 +935,7 @@ func (c *ContractsEventsConsumer) handlePermissionsSetEvent(ctx context.Context,
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handlePermissionsRenouncedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handlePermissionsRenouncedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", PermissionsRenouncedEvent.String()).Logger()
 
 	var args PermissionsRenouncedData
@@ -748,10 +944,21 @@ func (c *ContractsEventsConsumer) handlePermissionsRenouncedEvent(ctx context.Co
 	}
 
 	if args.TokenId.Sign() == 0 {
-		n, err := models.AccountSacds(
+		sacds, err := models.AccountSacds(
 			models.AccountSacdWhere.Account.EQ(args.Asset.Bytes()),
 			models.AccountSacdWhere.Grantee.EQ(args.Grantee.Bytes()),
-		).DeleteAll(ctx, c.dbs.DBS().Writer)
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range sacds {
+			if err := c.recordGrantRemoved(ctx, tx, e, accountSacdGrant(s), sacdCauseRenounced); err != nil {
+				return err
+			}
+		}
+
+		n, err := sacds.DeleteAll(ctx, tx)
 		if err != nil {
 			return fmt.Errorf("error deleting account SACD: %w", err)
 		}
@@ -771,10 +978,21 @@ func (c *ContractsEventsConsumer) handlePermissionsRenouncedEvent(ctx context.Co
 			return err
 		}
 
-		n, err := models.ConnectionSacds(
+		sacds, err := models.ConnectionSacds(
 			models.ConnectionSacdWhere.ConnectionID.EQ(connectionID),
 			models.ConnectionSacdWhere.Grantee.EQ(args.Grantee.Bytes()),
-		).DeleteAll(ctx, c.dbs.DBS().Writer)
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range sacds {
+			if err := c.recordGrantRemoved(ctx, tx, e, connectionSacdGrant(s), sacdCauseRenounced); err != nil {
+				return err
+			}
+		}
+
+		n, err := sacds.DeleteAll(ctx, tx)
 		if err != nil {
 			return fmt.Errorf("error deleting connection SACD: %w", err)
 		}
@@ -793,10 +1011,21 @@ func (c *ContractsEventsConsumer) handlePermissionsRenouncedEvent(ctx context.Co
 		return nil
 	}
 
-	n, err := models.VehicleSacds(
+	sacds, err := models.VehicleSacds(
 		models.VehicleSacdWhere.VehicleID.EQ(int(args.TokenId.Int64())),
 		models.VehicleSacdWhere.Grantee.EQ(args.Grantee.Bytes()),
-	).DeleteAll(ctx, c.dbs.DBS().Writer)
+	).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sacds {
+		if err := c.recordGrantRemoved(ctx, tx, e, vehicleSacdGrant(s), sacdCauseRenounced); err != nil {
+			return err
+		}
+	}
+
+	n, err := sacds.DeleteAll(ctx, tx)
 	if err != nil {
 		return fmt.Errorf("error deleting vehicle SACD: %w", err)
 	}
@@ -810,7 +1039,7 @@ func (c *ContractsEventsConsumer) handlePermissionsRenouncedEvent(ctx context.Co
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleTemplateCreatedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleTemplateCreatedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", TemplateCreatedEvent.String()).Logger()
 
 	var args TemplateCreatedData
@@ -839,10 +1068,10 @@ func (c *ContractsEventsConsumer) handleTemplateCreatedEvent(ctx context.Context
 		Str("asset", args.Asset.Hex()).
 		Msg("Template created successfully.")
 
-	return template.Insert(ctx, c.dbs.DBS().Writer, boil.Infer())
+	return template.Insert(ctx, tx, boil.Infer())
 }
 
-func (c *ContractsEventsConsumer) handlePrivilegeSetEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handlePrivilegeSetEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	logger := c.log.With().Str("EventName", PrivilegeSet.String()).Logger()
 
 	var args PrivilegeSetData
@@ -858,7 +1087,14 @@ func (c *ContractsEventsConsumer) handlePrivilegeSetEvent(ctx context.Context, e
 		ExpiresAt:   time.Unix(args.Expires.Int64(), 0),
 	}
 
-	if err := privilege.Upsert(ctx, c.dbs.DBS().Writer, true,
+	var prev *grant
+	if old, err := models.FindPrivilege(ctx, tx, privilege.TokenID, privilege.PrivilegeID, privilege.UserAddress); err == nil {
+		prev = privilegeGrant(old)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if err := privilege.Upsert(ctx, tx, true,
 		[]string{
 			models.PrivilegeColumns.PrivilegeID,
 			models.PrivilegeColumns.TokenID,
@@ -869,6 +1105,10 @@ func (c *ContractsEventsConsumer) handlePrivilegeSetEvent(ctx context.Context, e
 		return err
 	}
 
+	if err := c.recordGrantSet(ctx, tx, e, prev, privilegeGrant(&privilege)); err != nil {
+		return err
+	}
+
 	logger.Info().
 		Str("PrivilegeID", args.PrivId.String()).
 		Str("TokenID", args.TokenId.String()).
@@ -877,7 +1117,7 @@ func (c *ContractsEventsConsumer) handlePrivilegeSetEvent(ctx context.Context, e
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceClaimedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceClaimedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDeviceClaimedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -888,11 +1128,17 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceClaimedEvent(ctx contex
 		ClaimedAt: null.TimeFrom(e.Block.Time),
 	}
 
-	_, err := ad.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.AftermarketDeviceColumns.ClaimedAt))
-	return err
+	if _, err := ad.Update(ctx, tx, boil.Whitelist(models.AftermarketDeviceColumns.ClaimedAt)); err != nil {
+		return err
+	}
+
+	return c.recordAftermarketDeviceHistory(ctx, tx, e, &models.AftermarketDeviceHistory{
+		AftermarketDeviceID: ad.ID,
+		Owner:               null.BytesFrom(args.Owner.Bytes()),
+	})
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceUnclaimedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceUnclaimedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDeviceUnclaimedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -903,11 +1149,17 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceUnclaimedEvent(ctx cont
 		ClaimedAt: null.Time{},
 	}
 
-	_, err := ad.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.AftermarketDeviceColumns.ClaimedAt))
-	return err
+	if _, err := ad.Update(ctx, tx, boil.Whitelist(models.AftermarketDeviceColumns.ClaimedAt)); err != nil {
+		return err
+	}
+
+	return c.recordAftermarketDeviceHistory(ctx, tx, e, &models.AftermarketDeviceHistory{
+		AftermarketDeviceID: ad.ID,
+		Owner:               null.BytesFrom(args.Owner.Bytes()),
+	})
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDevicePairedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDevicePairedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDevicePairData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -919,17 +1171,25 @@ func (c *ContractsEventsConsumer) handleAftermarketDevicePairedEvent(ctx context
 		PairedAt:  null.TimeFrom(e.Block.Time),
 	}
 
-	_, err := ad.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.AftermarketDeviceColumns.VehicleID, models.AftermarketDeviceColumns.PairedAt))
+	_, err := ad.Update(ctx, tx, boil.Whitelist(models.AftermarketDeviceColumns.VehicleID, models.AftermarketDeviceColumns.PairedAt))
 	if err != nil {
 		return err
 	}
 
+	if err := c.recordAftermarketDeviceHistory(ctx, tx, e, &models.AftermarketDeviceHistory{
+		AftermarketDeviceID: ad.ID,
+		VehicleID:           ad.VehicleID,
+		Owner:               null.BytesFrom(args.Owner.Bytes()),
+	}); err != nil {
+		return err
+	}
+
 	c.log.Info().Int64("vehicleId", args.VehicleNode.Int64()).Int64("aftermarketId", args.AftermarketDeviceNode.Int64()).Msg("Aftermarket device paired.")
 
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceUnpairedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceUnpairedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDevicePairData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -937,11 +1197,37 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceUnpairedEvent(ctx conte
 
 	ad := models.AftermarketDevice{ID: int(args.AftermarketDeviceNode.Int64())}
 
-	_, err := ad.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.AftermarketDeviceColumns.VehicleID, models.AftermarketDeviceColumns.PairedAt))
-	return err
+	if _, err := ad.Update(ctx, tx, boil.Whitelist(models.AftermarketDeviceColumns.VehicleID, models.AftermarketDeviceColumns.PairedAt)); err != nil {
+		return err
+	}
+
+	return c.recordAftermarketDeviceHistory(ctx, tx, e, &models.AftermarketDeviceHistory{
+		AftermarketDeviceID: ad.ID,
+		VehicleID:           null.IntFrom(int(args.VehicleNode.Int64())),
+		Owner:               null.BytesFrom(args.Owner.Bytes()),
+	})
 }
 
-func (c *ContractsEventsConsumer) handleManufacturerTransferEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+// recordAftermarketDeviceHistory stores a claim, pairing or address change of a device, taking the
+// position from e. The event name is also taken from e unless h already has one.
+func (c *ContractsEventsConsumer) recordAftermarketDeviceHistory(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, h *models.AftermarketDeviceHistory) error {
+	if h.EventName == "" {
+		h.EventName = e.EventName
+	}
+	h.BlockNumber = e.Block.Number.Int64()
+	h.BlockTime = e.Block.Time
+	h.TransactionHash = e.TransactionHash.Bytes()
+
+	if err := h.Upsert(ctx, tx, false,
+		[]string{models.AftermarketDeviceHistoryColumns.AftermarketDeviceID, models.AftermarketDeviceHistoryColumns.BlockNumber, models.AftermarketDeviceHistoryColumns.TransactionHash, models.AftermarketDeviceHistoryColumns.EventName},
+		boil.None(), boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record aftermarket device history: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ContractsEventsConsumer) handleManufacturerTransferEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args TransferData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -959,20 +1245,20 @@ func (c *ContractsEventsConsumer) handleManufacturerTransferEvent(ctx context.Co
 
 	if args.To == zeroAddress {
 		// Must be a burn.
-		_, err := mfr.Delete(ctx, c.dbs.DBS().Writer)
+		_, err := mfr.Delete(ctx, tx)
 		return err
 	}
 
 	_, err := mfr.Update(
 		ctx,
-		c.dbs.DBS().Writer,
+		tx,
 		boil.Whitelist(models.ManufacturerColumns.Owner),
 	)
 
 	return err
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceTransferredEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceTransferredEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args TransferData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -991,20 +1277,22 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceTransferredEvent(ctx co
 
 	if args.To == zeroAddress {
 		// Must be a burn.
-		_, err := ad.Delete(ctx, c.dbs.DBS().Writer)
+		_, err := ad.Delete(ctx, tx)
 		return err
 	}
 
-	_, err := ad.Update(
+	if _, err := ad.Update(
 		ctx,
-		c.dbs.DBS().Writer,
+		tx,
 		boil.Whitelist(models.AftermarketDeviceColumns.Owner, models.AftermarketDeviceColumns.Beneficiary),
-	)
+	); err != nil {
+		return err
+	}
 
-	return err
+	return c.recordBeneficiaryChange(ctx, tx, e, ad.ID, args.To, false)
 }
 
-func (c *ContractsEventsConsumer) handleBeneficiarySetEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleBeneficiarySetEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args BeneficiarySetData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1018,7 +1306,7 @@ func (c *ContractsEventsConsumer) handleBeneficiarySetEvent(ctx context.Context,
 	ad := &models.AftermarketDevice{ID: int(args.NodeId.Int64())}
 
 	if args.Beneficiary == zeroAddress {
-		if err := ad.Reload(ctx, c.dbs.DBS().Reader); err != nil {
+		if err := ad.Reload(ctx, tx); err != nil {
 			return err
 		}
 		ad.Beneficiary = ad.Owner
@@ -1028,12 +1316,36 @@ func (c *ContractsEventsConsumer) handleBeneficiarySetEvent(ctx context.Context,
 
 	if _, err := ad.Update(
 		ctx,
-		c.dbs.DBS().Writer,
+		tx,
 		boil.Whitelist(models.AftermarketDeviceColumns.Beneficiary),
 	); err != nil {
 		return err
 	}
 
+	return c.recordBeneficiaryChange(ctx, tx, e, ad.ID, common.BytesToAddress(ad.Beneficiary), args.Beneficiary != zeroAddress)
+}
+
+// recordBeneficiaryChange stores the beneficiary that a device has after the event e. Explicit is
+// false when the beneficiary falls back to the owner.
+func (c *ContractsEventsConsumer) recordBeneficiaryChange(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, deviceID int, beneficiary common.Address, explicit bool) error {
+	b := models.AftermarketDeviceBeneficiary{
+		AftermarketDeviceID: deviceID,
+		EventName:           e.EventName,
+		Beneficiary:         beneficiary.Bytes(),
+		Explicit:            explicit,
+		BlockNumber:         e.Block.Number.Int64(),
+		BlockTime:           e.Block.Time,
+		TransactionHash:     e.TransactionHash.Bytes(),
+	}
+
+	cols := models.AftermarketDeviceBeneficiaryColumns
+
+	if err := b.Upsert(ctx, tx, false,
+		[]string{cols.AftermarketDeviceID, cols.BlockNumber, cols.TransactionHash, cols.EventName},
+		boil.None(), boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record beneficiary change: %w", err)
+	}
+
 	return nil
 }
 
@@ -1044,7 +1356,7 @@ func takeFirst(x, y *big.Int) *big.Int {
 	return y
 }
 
-func (c *ContractsEventsConsumer) handleSyntheticDeviceNodeMintedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleSyntheticDeviceNodeMintedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args SyntheticDeviceNodeMintedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1074,10 +1386,10 @@ func (c *ContractsEventsConsumer) handleSyntheticDeviceNodeMintedEvent(ctx conte
 		ConnectionID:  connectionID,
 	}
 
-	return sd.Insert(ctx, c.dbs.DBS().Writer, boil.Infer())
+	return sd.Insert(ctx, tx, boil.Infer())
 }
 
-func (c *ContractsEventsConsumer) handleSyntheticDeviceNodeBurnedEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleSyntheticDeviceNodeBurnedEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args SyntheticDeviceNodeBurnedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1087,11 +1399,11 @@ func (c *ContractsEventsConsumer) handleSyntheticDeviceNodeBurnedEvent(ctx conte
 		ID: int(args.SyntheticDeviceNode.Int64()),
 	}
 
-	_, err := sd.Delete(ctx, c.dbs.DBS().Writer)
+	_, err := sd.Delete(ctx, tx)
 	return err
 }
 
-func (c *ContractsEventsConsumer) handleTokensTransferredForDevice(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleTokensTransferredForDevice(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args TokensTransferredForDeviceData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1109,7 +1421,7 @@ func (c *ContractsEventsConsumer) handleTokensTransferredForDevice(ctx context.C
 	if common.HexToAddress(c.settings.AftermarketDeviceAddr) == args.DeviceNftProxy {
 		reward.AftermarketTokenID = null.IntFrom(int(args.DeviceNode.Int64()))
 		reward.AftermarketEarnings = dbtypes.IntToDecimal(args.Amount)
-		return reward.Upsert(ctx, c.dbs.DBS().Writer, true,
+		return reward.Upsert(ctx, tx, true,
 			[]string{cols.IssuanceWeek, cols.VehicleID},
 			boil.Whitelist(cols.AftermarketEarnings, cols.AftermarketTokenID),
 			boil.Infer())
@@ -1117,7 +1429,7 @@ func (c *ContractsEventsConsumer) handleTokensTransferredForDevice(ctx context.C
 		reward.SyntheticTokenID = null.IntFrom(int(args.DeviceNode.Int64()))
 		reward.SyntheticEarnings = dbtypes.IntToDecimal(args.Amount)
 
-		return reward.Upsert(ctx, c.dbs.DBS().Writer, true,
+		return reward.Upsert(ctx, tx, true,
 			[]string{cols.IssuanceWeek, cols.VehicleID},
 			boil.Whitelist(cols.SyntheticEarnings, cols.SyntheticTokenID),
 			boil.Infer())
@@ -1126,7 +1438,7 @@ func (c *ContractsEventsConsumer) handleTokensTransferredForDevice(ctx context.C
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleTokensTransferredForConnectionStreak(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleTokensTransferredForConnectionStreak(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args TokensTransferredForConnectionStreakData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1141,12 +1453,12 @@ func (c *ContractsEventsConsumer) handleTokensTransferredForConnectionStreak(ctx
 
 	cols := models.RewardColumns
 
-	_, err := reward.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(cols.StreakEarnings, cols.ConnectionStreak))
+	_, err := reward.Update(ctx, tx, boil.Whitelist(cols.StreakEarnings, cols.ConnectionStreak))
 
 	return err
 }
 
-func (c *ContractsEventsConsumer) handleAftermarketDeviceAddressResetEvent(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleAftermarketDeviceAddressResetEvent(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args AftermarketDeviceAddressResetData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1154,17 +1466,23 @@ func (c *ContractsEventsConsumer) handleAftermarketDeviceAddressResetEvent(ctx c
 
 	amd, err := models.AftermarketDevices(
 		models.AftermarketDeviceWhere.ID.EQ(int(args.TokenId.Int64())),
-	).One(ctx, c.dbs.DBS().Reader)
+	).One(ctx, tx)
 	if err != nil {
 		return err
 	}
 
 	amd.Address = args.AftermarketDeviceAddress.Bytes()
-	_, err = amd.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.AftermarketDeviceColumns.Address))
-	return err
+	if _, err := amd.Update(ctx, tx, boil.Whitelist(models.AftermarketDeviceColumns.Address)); err != nil {
+		return err
+	}
+
+	return c.recordAftermarketDeviceHistory(ctx, tx, e, &models.AftermarketDeviceHistory{
+		AftermarketDeviceID: amd.ID,
+		Address:             null.BytesFrom(amd.Address),
+	})
 }
 
-func (c *ContractsEventsConsumer) handleDevLicenseIssued(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleDevLicenseIssued(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args IssuedData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1177,7 +1495,7 @@ func (c *ContractsEventsConsumer) handleDevLicenseIssued(ctx context.Context, e
 		MintedAt: e.Block.Time,
 	}
 
-	err := dl.Upsert(ctx, c.dbs.DBS().Writer, false, []string{models.DeveloperLicenseColumns.ID}, boil.Blacklist(), boil.Infer())
+	err := dl.Upsert(ctx, tx, false, []string{models.DeveloperLicenseColumns.ID}, boil.Blacklist(), boil.Infer())
 	if err != nil {
 		return err
 	}
@@ -1185,7 +1503,7 @@ func (c *ContractsEventsConsumer) handleDevLicenseIssued(ctx context.Context, e
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleDevLicenseAlias(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleDevLicenseAlias(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args LicenseAliasSetData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
@@ -1208,7 +1526,7 @@ func (c *ContractsEventsConsumer) handleDevLicenseAlias(ctx context.Context, e *
 
 	c.log.Info().Int("developerLicenseId", dlID).Msgf("Developer license alias set to %q.", alias)
 
-	_, err := dl.Update(ctx, c.dbs.DBS().Writer, boil.Whitelist(models.DeveloperLicenseColumns.Alias))
+	_, err := dl.Update(ctx, tx, boil.Whitelist(models.DeveloperLicenseColumns.Alias))
 	if err != nil {
 		return err
 	}
@@ -1216,73 +1534,158 @@ func (c *ContractsEventsConsumer) handleDevLicenseAlias(ctx context.Context, e *
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleRedirectEnabled(ctx context.Context, e *cmodels.ContractEventData) error {
-	var args RedirectUriEnabledData
+func (c *ContractsEventsConsumer) handleDevLicenseTransfer(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
+	var args TransferData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
 	}
 
-	ru := models.RedirectURI{
-		DeveloperLicenseID: int(args.TokenID.Int64()),
-		URI:                args.URI,
-		EnabledAt:          e.Block.Time,
+	dlID := int(args.TokenID.Int64())
+
+	dt := models.DeveloperLicenseTransfer{
+		DeveloperLicenseID: dlID,
+		FromAddress:        args.From.Bytes(),
+		ToAddress:          args.To.Bytes(),
+		BlockNumber:        e.Block.Number.Int64(),
+		BlockTime:          e.Block.Time,
+		TransactionHash:    e.TransactionHash.Bytes(),
+		LogIndex:           logIndex(e),
 	}
 
-	err := ru.Upsert(ctx, c.dbs.DBS().Writer, false, []string{models.RedirectURIColumns.DeveloperLicenseID, models.RedirectURIColumns.URI}, boil.Blacklist(), boil.Infer())
-	if err != nil {
+	if err := dt.Upsert(ctx, tx, false,
+		[]string{models.DeveloperLicenseTransferColumns.DeveloperLicenseID, models.DeveloperLicenseTransferColumns.TransactionHash, models.DeveloperLicenseTransferColumns.LogIndex},
+		boil.None(), boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record developer license transfer: %w", err)
+	}
+
+	// Issued creates the row.
+	if args.From == zeroAddress {
+		return nil
+	}
+
+	if args.To == zeroAddress {
+		return c.burnDevLicense(ctx, tx, e, dlID)
+	}
+
+	dl := models.DeveloperLicense{
+		ID:    dlID,
+		Owner: args.To.Bytes(),
+	}
+
+	_, err := dl.Update(ctx, tx, boil.Whitelist(models.DeveloperLicenseColumns.Owner))
+	return err
+}
+
+// burnDevLicense tombstones the license. The owner is left as the last one, and signers and
+// redirect URIs that were still enabled are disabled as of the burn.
+func (c *ContractsEventsConsumer) burnDevLicense(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, dlID int) error {
+	dl := models.DeveloperLicense{
+		ID:       dlID,
+		BurnedAt: null.TimeFrom(e.Block.Time),
+	}
+
+	if _, err := dl.Update(ctx, tx, boil.Whitelist(models.DeveloperLicenseColumns.BurnedAt)); err != nil {
 		return err
 	}
 
+	if _, err := models.Signers(
+		models.SignerWhere.DeveloperLicenseID.EQ(dlID),
+		models.SignerWhere.DisabledAt.IsNull(),
+	).UpdateAll(ctx, tx, models.M{models.SignerColumns.DisabledAt: e.Block.Time}); err != nil {
+		return err
+	}
+
+	if _, err := models.RedirectUris(
+		models.RedirectURIWhere.DeveloperLicenseID.EQ(dlID),
+		models.RedirectURIWhere.DisabledAt.IsNull(),
+	).UpdateAll(ctx, tx, models.M{models.RedirectURIColumns.DisabledAt: e.Block.Time}); err != nil {
+		return err
+	}
+
+	c.log.Info().Int("developerLicenseId", dlID).Msg("Developer license burned.")
 	return nil
 }
 
-func (c *ContractsEventsConsumer) handleRedirectDisabled(ctx context.Context, e *cmodels.ContractEventData) error {
-	var args RedirectUriDisabledData
+func (c *ContractsEventsConsumer) handleRedirectEnabled(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
+	var args RedirectUriEnabledData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
 	}
 
+	dlID := int(args.TokenID.Int64())
+
+	// Enabling a URI that's already enabled keeps the original time.
+	active, err := models.RedirectUris(
+		models.RedirectURIWhere.DeveloperLicenseID.EQ(dlID),
+		models.RedirectURIWhere.URI.EQ(args.URI),
+		models.RedirectURIWhere.DisabledAt.IsNull(),
+	).Exists(ctx, tx)
+	if err != nil || active {
+		return err
+	}
+
 	ru := models.RedirectURI{
-		DeveloperLicenseID: int(args.TokenID.Int64()),
+		DeveloperLicenseID: dlID,
 		URI:                args.URI,
+		EnabledAt:          e.Block.Time,
+	}
+
+	return ru.Insert(ctx, tx, boil.Infer())
+}
+
+func (c *ContractsEventsConsumer) handleRedirectDisabled(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
+	var args RedirectUriDisabledData
+	if err := json.Unmarshal(e.Arguments, &args); err != nil {
+		return err
 	}
 
-	_, err := ru.Delete(ctx, c.dbs.DBS().Writer)
+	// Keep the row so that the URI can still be checked as of an earlier time.
+	_, err := models.RedirectUris(
+		models.RedirectURIWhere.DeveloperLicenseID.EQ(int(args.TokenID.Int64())),
+		models.RedirectURIWhere.URI.EQ(args.URI),
+		models.RedirectURIWhere.DisabledAt.IsNull(),
+	).UpdateAll(ctx, tx, models.M{models.RedirectURIColumns.DisabledAt: e.Block.Time})
 	return err
 }
 
-func (c *ContractsEventsConsumer) handleSignerEnabled(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleSignerEnabled(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args SignerEnabledData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
 	}
 
+	dlID := int(args.TokenID.Int64())
+
+	active, err := models.Signers(
+		models.SignerWhere.DeveloperLicenseID.EQ(dlID),
+		models.SignerWhere.Signer.EQ(args.Signer.Bytes()),
+		models.SignerWhere.DisabledAt.IsNull(),
+	).Exists(ctx, tx)
+	if err != nil || active {
+		return err
+	}
+
 	s := models.Signer{
-		DeveloperLicenseID: int(args.TokenID.Int64()),
+		DeveloperLicenseID: dlID,
 		Signer:             args.Signer.Bytes(),
 		EnabledAt:          e.Block.Time,
 	}
 
-	err := s.Upsert(ctx, c.dbs.DBS().Writer, false, []string{models.SignerColumns.DeveloperLicenseID, models.SignerColumns.Signer}, boil.Blacklist(), boil.Infer())
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return s.Insert(ctx, tx, boil.Infer())
 }
 
-func (c *ContractsEventsConsumer) handleSignerDisabled(ctx context.Context, e *cmodels.ContractEventData) error {
+func (c *ContractsEventsConsumer) handleSignerDisabled(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
 	var args SignerDisabledData
 	if err := json.Unmarshal(e.Arguments, &args); err != nil {
 		return err
 	}
 
-	s := models.Signer{
-		DeveloperLicenseID: int(args.TokenID.Int64()),
-		Signer:             args.Signer.Bytes(),
-	}
-
-	_, err := s.Delete(ctx, c.dbs.DBS().Writer)
+	// Keep the row so that old signatures can still be checked.
+	_, err := models.Signers(
+		models.SignerWhere.DeveloperLicenseID.EQ(int(args.TokenID.Int64())),
+		models.SignerWhere.Signer.EQ(args.Signer.Bytes()),
+		models.SignerWhere.DisabledAt.IsNull(),
+	).UpdateAll(ctx, tx, models.M{models.SignerColumns.DisabledAt: e.Block.Time})
 	return err
 }
 
@@ -1298,8 +1701,9 @@ func (c *ContractsEventsConsumer) parsePermissionsSetArgs(eventArgs []byte) (Per
 	var templateID []byte
 	var args PermissionsSetData
 
-	// Check if TemplateId field exists to determine event format
-	if _, hasTemplateId := rawArgs["TemplateId"]; hasTemplateId {
+	// Check if TemplateId field exists to determine event format. Like the decoding below, the
+	// key match is case-insensitive: the indexer uses the ABI's argument names.
+	if hasArgument(rawArgs, "TemplateId") {
 		// New format with templateId
 		var argsWithTemplate PermissionsSetWithTemplateData
 		if err := json.Unmarshal(eventArgs, &argsWithTemplate); err != nil {
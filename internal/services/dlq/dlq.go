@@ -0,0 +1,298 @@
+// Package dlq stores contract events that failed processing and retries them on a backoff
+// schedule.
+package dlq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/goccy/go-json"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// MaxAttempts is the number of failures after which an event is only retried by hand.
+	MaxAttempts = 10
+
+	baseBackoff = time.Minute
+	maxBackoff  = 6 * time.Hour
+)
+
+// ProcessFunc handles a single event. This is the signature of ContractsEventsConsumer.Process.
+type ProcessFunc func(ctx context.Context, event *cloudevent.RawEvent) error
+
+// KeyFunc names the entity that an event writes to. This is the signature of
+// ContractsEventsConsumer.EntityKey.
+type KeyFunc func(event *cloudevent.RawEvent) string
+
+// Queue wraps an event processor, parking failed events in the dead_letters table.
+//
+// Applying an event after a later one for the same entity would overwrite newer state, so while
+// an entity has an event in the queue, its later events are parked behind it instead of being
+// applied. Retries go in queue order, and an event is only retried once nothing ahead of it for
+// the same entity remains.
+type Queue struct {
+	dbs     db.Store
+	process ProcessFunc
+	key     KeyFunc
+	log     *zerolog.Logger
+
+	// mu keeps retries from interleaving with live events. Live events only take the read lock,
+	// since the caller may process them concurrently.
+	mu sync.RWMutex
+}
+
+func New(dbs db.Store, process ProcessFunc, key KeyFunc, log *zerolog.Logger) *Queue {
+	return &Queue{dbs: dbs, process: process, key: key, log: log}
+}
+
+// backoff returns the delay before the next automatic retry, or false if there shouldn't be one.
+func backoff(attempts int) (time.Duration, bool) {
+	if attempts >= MaxAttempts {
+		return 0, false
+	}
+
+	d := baseBackoff << (attempts - 1)
+	if d <= 0 || d > maxBackoff {
+		d = maxBackoff
+	}
+
+	return d, true
+}
+
+// Process runs the wrapped processor. If that fails, the event is stored for retry and the
+// error is swallowed so that the partition isn't blocked. If an earlier event for the same
+// entity is waiting in the queue, the event is stored behind it without being processed.
+func (q *Queue) Process(ctx context.Context, event *cloudevent.RawEvent) error {
+	key := q.key(event)
+
+	q.mu.RLock()
+	blocker, err := q.blocker(ctx, key, models.DeadLetterWhere.CloudEventID.NEQ(event.ID))
+	if err == nil && blocker == nil {
+		err = q.process(ctx, event)
+	}
+	q.mu.RUnlock()
+
+	if blocker != nil {
+		q.log.Info().Str("id", event.ID).Int64("blockingDeadLetterId", blocker.ID).Str("entity", key).Msg("Event's entity has a dead letter, sending it to the dead-letter queue.")
+		return q.recordFailure(ctx, event, key, fmt.Errorf("waiting for dead letter %d", blocker.ID))
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	q.log.Err(err).Str("id", event.ID).Msg("Failed to process event, sending to the dead-letter queue.")
+
+	return q.recordFailure(ctx, event, key, err)
+}
+
+// Blocked reports whether an earlier event for the event's entity is waiting in the queue. Such
+// an event must go through Process rather than be applied some other way, or it would overtake
+// the dead letter.
+func (q *Queue) Blocked(ctx context.Context, event *cloudevent.RawEvent) (bool, error) {
+	blocker, err := q.blocker(ctx, q.key(event), models.DeadLetterWhere.CloudEventID.NEQ(event.ID))
+	return blocker != nil, err
+}
+
+// blocker returns the oldest dead letter for the entity that matches the extra conditions, or
+// nil if there isn't one.
+func (q *Queue) blocker(ctx context.Context, key string, mods ...qm.QueryMod) (*models.DeadLetter, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	dl, err := models.DeadLetters(append(mods,
+		models.DeadLetterWhere.EntityKey.EQ(key),
+		qm.OrderBy(models.DeadLetterColumns.ID+" ASC"),
+	)...).One(ctx, q.dbs.DBS().Writer)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return dl, err
+}
+
+// recordFailure stores the event for retry. If it came from Kafka, the message's offset is
+// claimed in the same transaction, so that a restart doesn't deliver it again.
+func (q *Queue) recordFailure(ctx context.Context, event *cloudevent.RawEvent, key string, procErr error) error {
+	tx, err := q.dbs.DBS().Writer.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if msg, ok := kafka.MessageFromContext(ctx); ok {
+		claimed, err := kafka.ClaimOffset(ctx, tx, msg)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return nil
+		}
+	}
+
+	now := time.Now()
+
+	dl, err := models.DeadLetters(models.DeadLetterWhere.CloudEventID.EQ(event.ID)).One(ctx, tx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		eb, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		dl = &models.DeadLetter{
+			CloudEventID: event.ID,
+			EntityKey:    key,
+			Event:        types.JSON(eb),
+			CreatedAt:    now,
+		}
+	}
+
+	dl.Attempts++
+	dl.Error = procErr.Error()
+	dl.LastAttemptedAt = now
+	dl.NextAttemptAt = null.Time{}
+	if d, ok := backoff(dl.Attempts); ok {
+		dl.NextAttemptAt = null.TimeFrom(now.Add(d))
+	}
+
+	if dl.ID == 0 {
+		err = dl.Insert(ctx, tx, boil.Infer())
+	} else {
+		_, err = dl.Update(ctx, tx, boil.Whitelist(
+			models.DeadLetterColumns.Attempts,
+			models.DeadLetterColumns.Error,
+			models.DeadLetterColumns.LastAttemptedAt,
+			models.DeadLetterColumns.NextAttemptAt,
+		))
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// List returns every stored event, oldest first.
+func (q *Queue) List(ctx context.Context) (models.DeadLetterSlice, error) {
+	return models.DeadLetters(qm.OrderBy(models.DeadLetterColumns.ID+" ASC")).All(ctx, q.dbs.DBS().Reader)
+}
+
+// Retry immediately reprocesses the stored event with the given id. On success the event is
+// removed from the queue. Events waiting behind an earlier one for the same entity are refused.
+func (q *Queue) Retry(ctx context.Context, id int64) error {
+	dl, err := models.FindDeadLetter(ctx, q.dbs.DBS().Writer, id)
+	if err != nil {
+		return fmt.Errorf("failed to find dead letter %d: %w", id, err)
+	}
+
+	blocker, err := q.blocker(ctx, dl.EntityKey, models.DeadLetterWhere.ID.LT(dl.ID))
+	if err != nil {
+		return err
+	}
+	if blocker != nil {
+		return fmt.Errorf("dead letter %d is waiting for dead letter %d, which is for the same entity", dl.ID, blocker.ID)
+	}
+
+	return q.retry(ctx, dl)
+}
+
+// RetryDue reprocesses every event whose backoff has elapsed. It returns the number of events
+// that succeeded.
+func (q *Queue) RetryDue(ctx context.Context) (int, error) {
+	due, err := models.DeadLetters(
+		models.DeadLetterWhere.NextAttemptAt.LTE(null.TimeFrom(time.Now())),
+		qm.OrderBy(models.DeadLetterColumns.ID+" ASC"),
+	).All(ctx, q.dbs.DBS().Writer)
+	if err != nil {
+		return 0, err
+	}
+
+	var succeeded int
+	for _, dl := range due {
+		// Anything ahead of it that failed again in this pass is still in the queue.
+		blocker, err := q.blocker(ctx, dl.EntityKey, models.DeadLetterWhere.ID.LT(dl.ID))
+		if err != nil {
+			return succeeded, err
+		}
+		if blocker != nil {
+			continue
+		}
+
+		if err := q.retry(ctx, dl); err != nil {
+			q.log.Err(err).Int64("deadLetterId", dl.ID).Int("attempts", dl.Attempts).Msg("Dead letter retry failed.")
+			continue
+		}
+		succeeded++
+	}
+
+	return succeeded, nil
+}
+
+func (q *Queue) retry(ctx context.Context, dl *models.DeadLetter) error {
+	var event cloudevent.RawEvent
+	if err := json.Unmarshal(dl.Event, &event); err != nil {
+		return fmt.Errorf("failed to decode stored event: %w", err)
+	}
+
+	q.mu.Lock()
+	procErr := q.process(ctx, &event)
+	q.mu.Unlock()
+
+	if procErr != nil {
+		if err := q.recordFailure(ctx, &event, dl.EntityKey, procErr); err != nil {
+			return err
+		}
+		return procErr
+	}
+
+	_, err := dl.Delete(ctx, q.dbs.DBS().Writer)
+	return err
+}
+
+// Discard drops the stored event with the given id without processing it.
+func (q *Queue) Discard(ctx context.Context, id int64) error {
+	dl, err := models.FindDeadLetter(ctx, q.dbs.DBS().Writer, id)
+	if err != nil {
+		return fmt.Errorf("failed to find dead letter %d: %w", id, err)
+	}
+
+	_, err = dl.Delete(ctx, q.dbs.DBS().Writer)
+	return err
+}
+
+// Run retries due events every interval until the context is canceled.
+func (q *Queue) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := q.RetryDue(ctx)
+			if err != nil {
+				q.log.Err(err).Msg("Failed to retry dead letters.")
+			} else if n != 0 {
+				q.log.Info().Int("succeeded", n).Msg("Retried dead letters.")
+			}
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	d, ok := backoff(1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+
+	d, ok = backoff(3)
+	assert.True(t, ok)
+	assert.Equal(t, 4*time.Minute, d)
+
+	d, ok = backoff(9)
+	assert.True(t, ok)
+	assert.Equal(t, 256*time.Minute, d)
+
+	_, ok = backoff(MaxAttempts)
+	assert.False(t, ok)
+}
+
+func noKey(*cloudevent.RawEvent) string { return "" }
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, "../../../migrations")
+
+	var fail bool
+	var calls int
+	q := New(pdb, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		calls++
+		if fail {
+			return errors.New("unknown asset")
+		}
+		return nil
+	}, noKey, &logger)
+
+	event := cloudevent.RawEvent{
+		CloudEventHeader: cloudevent.CloudEventHeader{
+			ID:     "2SiTVhP3WBhfQQnnnpeBdMR7BSY",
+			Source: "chain/80001",
+			Type:   "zone.dimo.contract.event",
+		},
+		Data: []byte(`{"eventName":"PermissionsSet"}`),
+	}
+
+	fail = true
+	require.NoError(t, q.Process(ctx, &event))
+
+	dls, err := q.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+
+	dl := dls[0]
+	assert.Equal(t, event.ID, dl.CloudEventID)
+	assert.Equal(t, 1, dl.Attempts)
+	assert.Equal(t, "unknown asset", dl.Error)
+	assert.True(t, dl.NextAttemptAt.Valid)
+
+	// Nothing is due yet.
+	n, err := q.RetryDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Equal(t, 1, calls)
+
+	require.Error(t, q.Retry(ctx, dl.ID))
+	require.NoError(t, dl.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, 2, dl.Attempts)
+
+	dl.NextAttemptAt.Time = time.Now().Add(-time.Second)
+	_, err = dl.Update(ctx, pdb.DBS().Writer, boil.Whitelist(models.DeadLetterColumns.NextAttemptAt))
+	require.NoError(t, err)
+
+	fail = false
+	n, err = q.RetryDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	dls, err = q.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, dls)
+}
+
+func TestQueue_Discard(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, "../../../migrations")
+
+	q := New(pdb, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		return errors.New("bad event")
+	}, noKey, &logger)
+
+	event := cloudevent.RawEvent{
+		CloudEventHeader: cloudevent.CloudEventHeader{ID: "2SiTVhP3WBhfQQnnnpeBdMR7BSZ"},
+		Data:             []byte(`{}`),
+	}
+	require.NoError(t, q.Process(ctx, &event))
+
+	dls, err := q.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+
+	require.NoError(t, q.Discard(ctx, dls[0].ID))
+
+	dls, err = q.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, dls)
+}
+
+func TestQueue_HoldsLaterEventsForEntity(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, "../../../migrations")
+
+	failing := map[string]bool{"a": true}
+	var applied []string
+	q := New(pdb, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		if failing[event.ID] {
+			return errors.New("unknown asset")
+		}
+		applied = append(applied, event.ID)
+		return nil
+	}, func(event *cloudevent.RawEvent) string {
+		return string(event.Data)
+	}, &logger)
+
+	newEvent := func(id, entity string) *cloudevent.RawEvent {
+		return &cloudevent.RawEvent{
+			CloudEventHeader: cloudevent.CloudEventHeader{ID: id},
+			Data:             []byte(entity),
+		}
+	}
+
+	require.NoError(t, q.Process(ctx, newEvent("a", "vehicle/1")))
+	require.NoError(t, q.Process(ctx, newEvent("b", "vehicle/1")))
+	require.NoError(t, q.Process(ctx, newEvent("c", "vehicle/2")))
+
+	// b has to wait for a, but c is for another vehicle.
+	assert.Equal(t, []string{"c"}, applied)
+
+	dls, err := q.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dls, 2)
+	assert.Equal(t, "vehicle/1", dls[1].EntityKey)
+	assert.Equal(t, fmt.Sprintf("waiting for dead letter %d", dls[0].ID), dls[1].Error)
+
+	require.ErrorContains(t, q.Retry(ctx, dls[1].ID), "is waiting for dead letter")
+
+	blocked, err := q.Blocked(ctx, newEvent("d", "vehicle/1"))
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	blocked, err = q.Blocked(ctx, newEvent("e", "vehicle/2"))
+	require.NoError(t, err)
+	assert.False(t, blocked)
+
+	_, err = models.DeadLetters().UpdateAll(ctx, pdb.DBS().Writer, models.M{
+		models.DeadLetterColumns.NextAttemptAt: time.Now().Add(-time.Second),
+	})
+	require.NoError(t, err)
+
+	// Still failing, so b stays put.
+	n, err := q.RetryDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Equal(t, []string{"c"}, applied)
+
+	_, err = models.DeadLetters().UpdateAll(ctx, pdb.DBS().Writer, models.M{
+		models.DeadLetterColumns.NextAttemptAt: time.Now().Add(-time.Second),
+	})
+	require.NoError(t, err)
+
+	failing["a"] = false
+	n, err = q.RetryDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"c", "a", "b"}, applied)
+}
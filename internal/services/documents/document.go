@@ -0,0 +1,164 @@
+// Package documents fetches and parses the signed agreement documents that SACD sources and
+// template CIDs point to.
+package documents
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrInvalid is returned for documents that can't be parsed or fail validation.
+var ErrInvalid = errors.New("invalid permission document")
+
+// Document is a parsed permission document.
+type Document struct {
+	Type        string
+	Grantor     *common.Address
+	Grantee     *common.Address
+	EffectiveAt *time.Time
+	ExpiresAt   *time.Time
+	Agreements  []Agreement
+	Signature   []byte
+	// SignatureValid is true when the signature is a personal_sign of the document's data by
+	// the grantor.
+	SignatureValid bool
+}
+
+// Agreement is one of the grants described by a document.
+type Agreement struct {
+	Type string
+	// Asset is the DID of the asset the agreement covers, if it names one.
+	Asset string
+	// Permissions holds the permission names as they appear in the document.
+	Permissions []string
+}
+
+type wireDocument struct {
+	Type      string          `json:"type"`
+	Data      json.RawMessage `json:"data"`
+	Signature string          `json:"signature"`
+}
+
+type wireData struct {
+	Grantor     *wireParty      `json:"grantor"`
+	Grantee     *wireParty      `json:"grantee"`
+	EffectiveAt *time.Time      `json:"effectiveAt"`
+	ExpiresAt   *time.Time      `json:"expiresAt"`
+	Agreements  []wireAgreement `json:"agreements"`
+}
+
+type wireParty struct {
+	Address string `json:"address"`
+}
+
+type wireAgreement struct {
+	Type        string `json:"type"`
+	Asset       string `json:"asset"`
+	Permissions []struct {
+		Name string `json:"name"`
+	} `json:"permissions"`
+}
+
+// Parse decodes and validates a raw document.
+func Parse(raw []byte) (*Document, error) {
+	var wd wireDocument
+	if err := json.Unmarshal(raw, &wd); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+
+	if data := bytes.TrimSpace(wd.Data); len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, fmt.Errorf("%w: missing data", ErrInvalid)
+	}
+
+	var data wireData
+	if err := json.Unmarshal(wd.Data, &data); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+
+	doc := &Document{
+		Type:        wd.Type,
+		EffectiveAt: data.EffectiveAt,
+		ExpiresAt:   data.ExpiresAt,
+	}
+
+	var err error
+	if doc.Grantor, err = parseParty("grantor", data.Grantor); err != nil {
+		return nil, err
+	}
+	if doc.Grantee, err = parseParty("grantee", data.Grantee); err != nil {
+		return nil, err
+	}
+
+	if doc.EffectiveAt != nil && doc.ExpiresAt != nil && doc.ExpiresAt.Before(*doc.EffectiveAt) {
+		return nil, fmt.Errorf("%w: expiresAt %s is before effectiveAt %s", ErrInvalid, doc.ExpiresAt, doc.EffectiveAt)
+	}
+
+	if len(data.Agreements) == 0 {
+		return nil, fmt.Errorf("%w: no agreements", ErrInvalid)
+	}
+
+	doc.Agreements = make([]Agreement, len(data.Agreements))
+	for i, wa := range data.Agreements {
+		if wa.Type == "" {
+			return nil, fmt.Errorf("%w: agreement %d has no type", ErrInvalid, i)
+		}
+
+		a := Agreement{Type: wa.Type, Asset: wa.Asset, Permissions: make([]string, len(wa.Permissions))}
+		for j, p := range wa.Permissions {
+			if p.Name == "" {
+				return nil, fmt.Errorf("%w: agreement %d has an unnamed permission", ErrInvalid, i)
+			}
+			a.Permissions[j] = p.Name
+		}
+		doc.Agreements[i] = a
+	}
+
+	if wd.Signature != "" {
+		sig, err := hexutil.Decode(wd.Signature)
+		if err != nil || len(sig) != crypto.SignatureLength {
+			return nil, fmt.Errorf("%w: signature must be %d hex-encoded bytes", ErrInvalid, crypto.SignatureLength)
+		}
+		doc.Signature = sig
+
+		if doc.Grantor != nil {
+			signer, err := recoverSigner(wd.Data, sig)
+			doc.SignatureValid = err == nil && signer == *doc.Grantor
+		}
+	}
+
+	return doc, nil
+}
+
+func parseParty(field string, p *wireParty) (*common.Address, error) {
+	if p == nil || p.Address == "" {
+		return nil, nil
+	}
+	if !common.IsHexAddress(p.Address) {
+		return nil, fmt.Errorf("%w: %s address %q is not a hex address", ErrInvalid, field, p.Address)
+	}
+	addr := common.HexToAddress(p.Address)
+	return &addr, nil
+}
+
+// recoverSigner returns the address whose personal_sign of data produced sig.
+func recoverSigner(data, sig []byte) (common.Address, error) {
+	sig = bytes.Clone(sig)
+	// Wallets produce recovery ids of 27 and 28; go-ethereum expects 0 and 1.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(accounts.TextHash(data), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
@@ -0,0 +1,226 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCID = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+
+func signedDocument(t *testing.T, grantor common.Address, sign func([]byte) []byte) []byte {
+	t.Helper()
+
+	data := []byte(`{"grantor":{"address":"` + grantor.Hex() + `"},"grantee":{"address":"0x1111111111111111111111111111111111111111"},` +
+		`"effectiveAt":"2025-01-01T00:00:00Z","expiresAt":"2026-01-01T00:00:00Z",` +
+		`"agreements":[{"type":"permission","asset":"did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:5",` +
+		`"permissions":[{"name":"privilege:GetNonLocationHistory"},{"name":"privilege:ExecuteCommands"}]}]}`)
+
+	raw, err := json.Marshal(map[string]any{
+		"type":      "dimo.sacd",
+		"data":      json.RawMessage(data),
+		"signature": hexutil.Encode(sign(data)),
+	})
+	require.NoError(t, err)
+	return raw
+}
+
+func TestParse(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	grantor := crypto.PubkeyToAddress(key.PublicKey)
+
+	personalSign := func(data []byte) []byte {
+		sig, err := crypto.Sign(accounts.TextHash(data), key)
+		require.NoError(t, err)
+		sig[crypto.RecoveryIDOffset] += 27
+		return sig
+	}
+
+	doc, err := Parse(signedDocument(t, grantor, personalSign))
+	require.NoError(t, err)
+
+	assert.Equal(t, "dimo.sacd", doc.Type)
+	assert.Equal(t, &grantor, doc.Grantor)
+	assert.Equal(t, common.HexToAddress("0x1111111111111111111111111111111111111111"), *doc.Grantee)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), doc.ExpiresAt.UTC())
+	require.Len(t, doc.Agreements, 1)
+	assert.Equal(t, "did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:5", doc.Agreements[0].Asset)
+	assert.Equal(t, []string{"privilege:GetNonLocationHistory", "privilege:ExecuteCommands"}, doc.Agreements[0].Permissions)
+	assert.Len(t, doc.Signature, crypto.SignatureLength)
+	assert.True(t, doc.SignatureValid)
+
+	// Signed by someone other than the grantor.
+	doc, err = Parse(signedDocument(t, common.HexToAddress("0x2222222222222222222222222222222222222222"), personalSign))
+	require.NoError(t, err)
+	assert.False(t, doc.SignatureValid)
+
+	invalid := []string{
+		`not json`,
+		`{"type":"dimo.sacd"}`,
+		`{"data":{"agreements":[]}}`,
+		`{"data":{"grantor":{"address":"0xnope"},"agreements":[{"type":"permission"}]}}`,
+		`{"data":{"effectiveAt":"2026-01-01T00:00:00Z","expiresAt":"2025-01-01T00:00:00Z","agreements":[{"type":"permission"}]}}`,
+		`{"data":{"agreements":[{"type":"permission"}]},"signature":"0x1234"}`,
+	}
+	for _, raw := range invalid {
+		_, err := Parse([]byte(raw))
+		assert.ErrorIs(t, err, ErrInvalid, raw)
+	}
+}
+
+func TestLocate(t *testing.T) {
+	for uri, want := range map[string]string{
+		testCID:             testCID,
+		"ipfs://" + testCID: testCID,
+		"ipfs://ipfs/" + testCID + "/agreement.json": testCID + "/agreement.json",
+	} {
+		got, err := Locate(uri)
+		require.NoError(t, err, uri)
+		assert.Equal(t, want, got)
+	}
+
+	for _, uri := range []string{
+		"",
+		"https://example.com/agreement.json",
+		"http://169.254.169.254/latest/meta-data",
+		"ipfs://not-a-cid",
+		"ipfs://" + testCID + "/../secret",
+	} {
+		_, err := Locate(uri)
+		assert.ErrorIs(t, err, ErrUnsupportedURI, uri)
+	}
+}
+
+type countingStore struct {
+	Store
+	fetches atomic.Int32
+}
+
+func (s *countingStore) Fetch(ctx context.Context, path string) ([]byte, error) {
+	s.fetches.Add(1)
+	return s.Store.Fetch(ctx, path)
+}
+
+func TestResolver(t *testing.T) {
+	dir := t.TempDir()
+	raw := signedDocument(t, common.HexToAddress("0x3333333333333333333333333333333333333333"), func([]byte) []byte {
+		return make([]byte, crypto.SignatureLength)
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, testCID), raw, 0o600))
+
+	store := &countingStore{Store: FileStore{Dir: dir}}
+	r := NewResolver(store, time.Second)
+
+	doc, err := r.Resolve(context.Background(), "ipfs://"+testCID)
+	require.NoError(t, err)
+	assert.False(t, doc.SignatureValid)
+
+	again, err := r.Resolve(context.Background(), testCID)
+	require.NoError(t, err)
+	assert.Same(t, doc, again)
+	assert.EqualValues(t, 1, store.fetches.Load())
+
+	_, err = r.Resolve(context.Background(), "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = r.Resolve(context.Background(), "https://example.com/"+testCID)
+	assert.ErrorIs(t, err, ErrUnsupportedURI)
+	assert.EqualValues(t, 2, store.fetches.Load())
+}
+
+// blockingStore serves documents only once released, and fails fetches that have no deadline or
+// were cancelled.
+type blockingStore struct {
+	Store
+	release chan struct{}
+}
+
+func (s *blockingStore) Fetch(ctx context.Context, path string) ([]byte, error) {
+	<-s.release
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errors.New("fetch without a deadline")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.Store.Fetch(ctx, path)
+}
+
+func TestResolver_CallerCancels(t *testing.T) {
+	dir := t.TempDir()
+	raw := signedDocument(t, common.HexToAddress("0x3333333333333333333333333333333333333333"), func([]byte) []byte {
+		return make([]byte, crypto.SignatureLength)
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, testCID), raw, 0o600))
+
+	store := &blockingStore{Store: FileStore{Dir: dir}, release: make(chan struct{})}
+	r := NewResolver(store, time.Minute)
+
+	// The first caller starts the fetch and gives up on it.
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := r.Resolve(ctx, testCID)
+		firstErr <- err
+	}()
+
+	second := make(chan *Document, 1)
+	go func() {
+		doc, err := r.Resolve(context.Background(), testCID)
+		assert.NoError(t, err)
+		second <- doc
+	}()
+
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+	// The shared fetch carries on for the second caller, with the timeout but not the
+	// cancellation of the first.
+	close(store.release)
+	assert.NotNil(t, <-second)
+}
+
+func TestGatewayStore(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ipfs/" + testCID:
+			_, _ = w.Write([]byte(`{}`))
+		case "/ipfs/" + testCID + "/large.json":
+			_, _ = w.Write(make([]byte, MaxDocumentSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	store, err := NewGatewayStore(srv.URL+"/", time.Second)
+	require.NoError(t, err)
+
+	b, err := store.Fetch(context.Background(), testCID)
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(b))
+
+	_, err = store.Fetch(context.Background(), testCID+"/large.json")
+	assert.ErrorIs(t, err, ErrTooLarge)
+
+	_, err = store.Fetch(context.Background(), testCID+"/missing.json")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = NewGatewayStore("file:///etc", time.Second)
+	assert.Error(t, err)
+}
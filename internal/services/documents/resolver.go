@@ -0,0 +1,80 @@
+package documents
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	cacheSize = 1024
+	cacheTTL  = time.Hour
+)
+
+type result struct {
+	doc *Document
+	err error
+}
+
+// Resolver fetches and parses documents, caching the results. Documents are content-addressed,
+// so a cached document, or the reason it failed to parse, stays correct for as long as it is
+// kept; the TTL only bounds memory held for sources nobody asks about anymore. Fetch failures
+// aren't cached.
+type Resolver struct {
+	store   Store
+	timeout time.Duration
+	cache   *expirable.LRU[string, result]
+	group   singleflight.Group
+}
+
+// NewResolver creates a resolver that reads documents from the given store, giving up on a
+// fetch after timeout.
+func NewResolver(store Store, timeout time.Duration) *Resolver {
+	return &Resolver{
+		store:   store,
+		timeout: timeout,
+		cache:   expirable.NewLRU[string, result](cacheSize, nil, cacheTTL),
+	}
+}
+
+// Resolve returns the document at uri, which may be an ipfs:// URI or a bare CID. It returns
+// an error wrapping ErrUnsupportedURI for anything else.
+func (r *Resolver) Resolve(ctx context.Context, uri string) (*Document, error) {
+	path, err := Locate(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	if res, ok := r.cache.Get(path); ok {
+		return res.doc, res.err
+	}
+
+	// Concurrent requests for the same document share one fetch. It runs apart from the request
+	// that started it, so that this request going away doesn't fail the others; each caller
+	// only stops waiting for it.
+	ch := r.group.DoChan(path, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+		defer cancel()
+
+		raw, err := r.store.Fetch(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		doc, err := Parse(raw)
+		r.cache.Add(path, result{doc: doc, err: err})
+		return doc, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*Document), nil
+	}
+}
@@ -0,0 +1,144 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxDocumentSize is the largest document that will be read. Permission documents are small
+// JSON files, so anything bigger is rejected rather than buffered.
+const MaxDocumentSize = 1 << 20
+
+var (
+	// ErrUnsupportedURI is returned for sources that don't point at IPFS content. These are
+	// never fetched, so that grantors can't make the API request arbitrary URLs.
+	ErrUnsupportedURI = errors.New("unsupported document URI")
+	// ErrNotFound is returned when the store has no document at the given path.
+	ErrNotFound = errors.New("document not found")
+	// ErrTooLarge is returned for documents larger than MaxDocumentSize.
+	ErrTooLarge = errors.New("document too large")
+)
+
+var (
+	cidV0Regex   = regexp.MustCompile(`^Qm[1-9A-HJ-NP-Za-km-z]{44}$`)
+	cidV1Regex   = regexp.MustCompile(`^b[a-z2-7]{58,}$`)
+	segmentRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+)
+
+// Locate converts a SACD source or template CID into an IPFS content path of the form
+// <cid>[/<path>]. It accepts ipfs:// URIs and bare CIDs.
+func Locate(uri string) (string, error) {
+	uri = strings.TrimSpace(uri)
+	if rest, ok := strings.CutPrefix(uri, "ipfs://"); ok {
+		uri = strings.TrimPrefix(rest, "ipfs/")
+	}
+
+	cid, path, _ := strings.Cut(uri, "/")
+	if !cidV0Regex.MatchString(cid) && !cidV1Regex.MatchString(cid) {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedURI, uri)
+	}
+
+	if path == "" {
+		return cid, nil
+	}
+
+	for _, seg := range strings.Split(path, "/") {
+		if seg == "." || seg == ".." || !segmentRegex.MatchString(seg) {
+			return "", fmt.Errorf("%w: %q", ErrUnsupportedURI, uri)
+		}
+	}
+
+	return cid + "/" + path, nil
+}
+
+// Store fetches raw documents by IPFS content path, as returned by Locate.
+type Store interface {
+	Fetch(ctx context.Context, path string) ([]byte, error)
+}
+
+// GatewayStore fetches documents through an HTTP IPFS gateway.
+type GatewayStore struct {
+	gateway *url.URL
+	client  *http.Client
+}
+
+// NewGatewayStore creates a store that reads from the gateway at the given base URL, for example
+// https://ipfs.io/.
+func NewGatewayStore(gatewayURL string, timeout time.Duration) (*GatewayStore, error) {
+	u, err := url.Parse(gatewayURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IPFS gateway URL %q: %w", gatewayURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid IPFS gateway URL %q: must be an absolute HTTP URL", gatewayURL)
+	}
+
+	return &GatewayStore{
+		gateway: u,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *GatewayStore) Fetch(ctx context.Context, path string) ([]byte, error) {
+	u := s.gateway.JoinPath("ipfs", path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch document %s: %w", path, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("failed to fetch document %s: gateway returned status %d", path, resp.StatusCode)
+	}
+
+	return readLimited(resp.Body, path)
+}
+
+// FileStore reads documents from a local directory, laid out as <dir>/<cid>[/<path>]. It stands
+// in for a gateway in tests and local development.
+type FileStore struct {
+	Dir string
+}
+
+func (s FileStore) Fetch(_ context.Context, path string) ([]byte, error) {
+	f, err := os.Open(filepath.Join(s.Dir, filepath.FromSlash(path)))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+		}
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	return readLimited(f, path)
+}
+
+func readLimited(r io.Reader, path string) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(r, MaxDocumentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document %s: %w", path, err)
+	}
+	if len(b) > MaxDocumentSize {
+		return nil, fmt.Errorf("%w: %s", ErrTooLarge, path)
+	}
+	return b, nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/DIMO-Network/cloudevent"
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/DIMO-Network/identity-api/internal/services/storagenode"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+)
+
+// entityArgs holds every argument that identifies the entity an event writes to. Field matching
+// is case-insensitive, so it decodes the arguments of all the events below.
+type entityArgs struct {
+	TokenID               *big.Int
+	VehicleID             *big.Int
+	VehicleNodeID         *big.Int
+	VehicleNode           *big.Int
+	NodeID                *big.Int
+	AftermarketDeviceNode *big.Int
+	Asset                 common.Address
+	Node                  []byte
+}
+
+// EntityKey names the entity whose rows the event modifies, such as "vehicle/12". Events with the
+// same key have to be applied in order; events with different keys can be applied concurrently.
+// Pairings and synthetic device mints and burns change what the vehicle has attached, so they're
+// keyed by the vehicle rather than the device. Events that don't touch a vehicle, device, license,
+// DCN, connection or account are keyed by their contract.
+func (c *ContractsEventsConsumer) EntityKey(event *cloudevent.RawEvent) string {
+	var data cmodels.ContractEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return ""
+	}
+
+	fallback := "contract/" + data.Contract.Hex()
+
+	var args entityArgs
+	if err := json.Unmarshal(data.Arguments, &args); err != nil {
+		return fallback
+	}
+
+	key := func(kind string, id *big.Int) string {
+		if id == nil {
+			return fallback
+		}
+		return fmt.Sprintf("%s/%s", kind, id)
+	}
+
+	vehicleNFTAddr := common.HexToAddress(c.settings.VehicleNFTAddr)
+
+	switch data.Contract {
+	case common.HexToAddress(c.settings.DIMORegistryAddr):
+		switch EventName(data.EventName) {
+		case VehicleNodeMinted, VehicleAttributeSet:
+			return key("vehicle", args.TokenID)
+		case VehicleNodeMintedWithDeviceDefinition, DeviceDefinitionIdSet, VehicleStorageNodeIdSet:
+			return key("vehicle", args.VehicleID)
+		case AftermarketDeviceNodeMinted, AftermarketDeviceAttributeSet, AftermarketDeviceAddressReset:
+			return key("device", args.TokenID)
+		case AftermarketDeviceClaimed, AftermarketDeviceUnclaimed:
+			return key("device", args.AftermarketDeviceNode)
+		case AftermarketDevicePaired, AftermarketDeviceUnpaired:
+			return key("vehicle", args.VehicleNode)
+		case BeneficiarySetEvent:
+			return key("device", args.NodeID)
+		case SyntheticDeviceNodeMinted, SyntheticDeviceNodeBurned:
+			return key("vehicle", args.VehicleNode)
+		}
+	case vehicleNFTAddr:
+		return key("vehicle", args.TokenID)
+	case common.HexToAddress(c.settings.AftermarketDeviceAddr):
+		return key("device", args.TokenID)
+	case common.HexToAddress(c.settings.SACDAddress):
+		switch {
+		case args.TokenID == nil:
+		case args.TokenID.Sign() == 0:
+			// Account SACDs use the token ID 0 and name the account as the asset.
+			return "account/" + args.Asset.Hex()
+		case args.Asset == common.HexToAddress(c.settings.ConnectionAddr):
+			return key("connection", args.TokenID)
+		case args.Asset == vehicleNFTAddr:
+			return key("vehicle", args.TokenID)
+		}
+	case common.HexToAddress(c.settings.RewardsContractAddr):
+		return key("vehicle", args.VehicleNodeID)
+	case common.HexToAddress(c.settings.DevLicenseAddr):
+		return key("license", args.TokenID)
+	case common.HexToAddress(c.settings.DCNRegistryAddr), common.HexToAddress(c.settings.DCNResolverAddr):
+		if EventName(data.EventName) == Transfer && args.TokenID != nil {
+			return fmt.Sprintf("dcn/%x", common.BigToHash(args.TokenID))
+		}
+		if len(args.Node) != 0 {
+			return fmt.Sprintf("dcn/%x", args.Node)
+		}
+	case common.HexToAddress(c.settings.StorageNodeAddr):
+		if data.EventSignature == storagenode.NodeSetForVehicleEventID {
+			return key("vehicle", args.VehicleID)
+		}
+	}
+
+	return fallback
+}
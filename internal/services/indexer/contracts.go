@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The registry ABI comes from the generated binding. The files in abi/ only contain the events
+// that the contract event consumer handles, and their argument names have to match the JSON
+// field names that the handlers decode.
+//
+//go:embed abi/*.json
+var abiFS embed.FS
+
+func loadABI(name string) (*abi.ABI, error) {
+	f, err := abiFS.Open("abi/" + name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	a, err := abi.JSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI %s: %w", name, err)
+	}
+
+	return &a, nil
+}
+
+// ContractABIs maps every configured contract address to the ABI used to decode its logs.
+// Contracts whose address isn't set are left out.
+func ContractABIs(settings *config.Settings) (map[common.Address]*abi.ABI, error) {
+	registry, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		addr string
+		file string
+	}{
+		{settings.VehicleNFTAddr, "nft.json"},
+		{settings.AftermarketDeviceAddr, "nft.json"},
+		{settings.ManufacturerNFTAddr, "nft.json"},
+		{settings.SACDAddress, "sacd.json"},
+		{settings.TemplateAddr, "template.json"},
+		{settings.DCNRegistryAddr, "dcn_registry.json"},
+		{settings.DCNResolverAddr, "dcn_resolver.json"},
+		{settings.RewardsContractAddr, "rewards.json"},
+		{settings.DevLicenseAddr, "dev_license.json"},
+		{settings.StakingAddr, "staking.json"},
+		{settings.ConnectionAddr, "connection.json"},
+		{settings.StorageNodeAddr, "storage_node.json"},
+	}
+
+	out := make(map[common.Address]*abi.ABI)
+	if settings.DIMORegistryAddr != "" {
+		out[common.HexToAddress(settings.DIMORegistryAddr)] = registry
+	}
+
+	for _, f := range files {
+		if f.addr == "" {
+			continue
+		}
+
+		a, err := loadABI(f.file)
+		if err != nil {
+			return nil, err
+		}
+
+		out[common.HexToAddress(f.addr)] = a
+	}
+
+	return out, nil
+}
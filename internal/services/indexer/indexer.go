@@ -0,0 +1,281 @@
+// Package indexer reads contract logs directly from an Ethereum JSON-RPC node, decodes them with
+// the bundled ABIs and feeds them through the contract event processor, so that the service can
+// run without the upstream Kafka producer.
+package indexer
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+	"github.com/rs/zerolog"
+)
+
+// contractEventType is the cloud event type that ContractsEventsConsumer.Process accepts.
+const contractEventType = "zone.dimo.contract.event"
+
+// Client is the subset of the JSON-RPC API that the indexer uses. Both ethclient.Client and the
+// simulated backend's client implement it.
+type Client interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// ProcessFunc handles a single event. This is the signature of ContractsEventsConsumer.Process.
+type ProcessFunc func(ctx context.Context, event *cloudevent.RawEvent) error
+
+// Options controls the range of blocks indexed.
+type Options struct {
+	// FromBlock, if set, is the first block to index. Otherwise indexing resumes after the stored
+	// checkpoint.
+	FromBlock *uint64
+	// ToBlock, if set, is the last block to index. Otherwise the indexer follows the chain head
+	// until the context is canceled.
+	ToBlock *uint64
+	// BatchSize is the maximum number of blocks requested in a single eth_getLogs call.
+	BatchSize uint64
+	// Confirmations is how far behind the head the indexer stays.
+	Confirmations uint64
+	// PollInterval is how long to wait for new blocks once the indexer has caught up.
+	PollInterval time.Duration
+}
+
+type Indexer struct {
+	client    Client
+	dbs       db.Store
+	process   ProcessFunc
+	chainID   int64
+	contracts map[common.Address]*abi.ABI
+	addresses []common.Address
+	log       *zerolog.Logger
+}
+
+func New(client Client, dbs db.Store, process ProcessFunc, settings *config.Settings, log *zerolog.Logger) (*Indexer, error) {
+	abis, err := ContractABIs(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]common.Address, 0, len(abis))
+	for addr := range abis {
+		addresses = append(addresses, addr)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+
+	return &Indexer{
+		client:    client,
+		dbs:       dbs,
+		process:   process,
+		chainID:   settings.DIMORegistryChainID,
+		contracts: abis,
+		addresses: addresses,
+		log:       log,
+	}, nil
+}
+
+// Run indexes blocks in batches, storing a checkpoint after each one. It returns once ToBlock
+// has been indexed or the context is canceled.
+func (ix *Indexer) Run(ctx context.Context, opts Options) error {
+	if opts.BatchSize == 0 {
+		return errors.New("batch size must be positive")
+	}
+
+	next, err := ix.startBlock(ctx, opts.FromBlock)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if opts.ToBlock != nil && next > *opts.ToBlock {
+			return nil
+		}
+
+		head, err := ix.client.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get chain head: %w", err)
+		}
+
+		if head < opts.Confirmations || next > head-opts.Confirmations {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(opts.PollInterval):
+			}
+			continue
+		}
+
+		end := min(next+opts.BatchSize-1, head-opts.Confirmations)
+		if opts.ToBlock != nil {
+			end = min(end, *opts.ToBlock)
+		}
+
+		if err := ix.IndexRange(ctx, next, end); err != nil {
+			return err
+		}
+
+		next = end + 1
+	}
+}
+
+func (ix *Indexer) startBlock(ctx context.Context, fromBlock *uint64) (uint64, error) {
+	if fromBlock != nil {
+		return *fromBlock, nil
+	}
+
+	cp, err := models.FindIndexerCheckpoint(ctx, ix.dbs.DBS().Reader, ix.chainID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("no checkpoint stored for chain %d, a starting block is required", ix.chainID)
+		}
+		return 0, err
+	}
+
+	return uint64(cp.BlockNumber) + 1, nil
+}
+
+// IndexRange processes the logs of the configured contracts in the inclusive block range, in
+// chain order, and then moves the checkpoint to the end of the range.
+func (ix *Indexer) IndexRange(ctx context.Context, from, to uint64) error {
+	logs, err := ix.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: ix.addresses,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get logs for blocks %d-%d: %w", from, to, err)
+	}
+
+	slices.SortFunc(logs, func(a, b types.Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			return cmp.Compare(a.BlockNumber, b.BlockNumber)
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	var header *types.Header
+	for i := range logs {
+		lg := &logs[i]
+		if lg.Removed {
+			continue
+		}
+
+		if header == nil || header.Number.Uint64() != lg.BlockNumber {
+			header, err = ix.client.HeaderByNumber(ctx, new(big.Int).SetUint64(lg.BlockNumber))
+			if err != nil {
+				return fmt.Errorf("failed to get header for block %d: %w", lg.BlockNumber, err)
+			}
+		}
+
+		event, err := ix.Decode(lg, header)
+		if err != nil {
+			return fmt.Errorf("failed to decode log %d of transaction %s: %w", lg.Index, lg.TxHash, err)
+		}
+		if event == nil {
+			continue
+		}
+
+		if err := ix.process(ctx, event); err != nil {
+			return fmt.Errorf("failed to process log %d of transaction %s: %w", lg.Index, lg.TxHash, err)
+		}
+	}
+
+	cp := models.IndexerCheckpoint{
+		ChainID:     ix.chainID,
+		BlockNumber: int64(to),
+		UpdatedAt:   time.Now(),
+	}
+	if err := cp.Upsert(ctx, ix.dbs.DBS().Writer, true, []string{models.IndexerCheckpointColumns.ChainID}, boil.Infer(), boil.Infer()); err != nil {
+		return err
+	}
+
+	ix.log.Info().Uint64("fromBlock", from).Uint64("toBlock", to).Int("logs", len(logs)).Msg("Indexed blocks.")
+
+	return nil
+}
+
+// Decode converts a log into the cloud event the upstream producer would have emitted for it. It
+// returns nil if the log doesn't belong to a configured contract or its event isn't in the ABI.
+func (ix *Indexer) Decode(lg *types.Log, header *types.Header) (*cloudevent.RawEvent, error) {
+	a, ok := ix.contracts[lg.Address]
+	if !ok || len(lg.Topics) == 0 {
+		return nil, nil
+	}
+
+	ev, err := a.EventByID(lg.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+
+	args := make(map[string]any)
+	if err := ev.Inputs.NonIndexed().UnpackIntoMap(args, lg.Data); err != nil {
+		return nil, err
+	}
+
+	var indexed abi.Arguments
+	for _, in := range ev.Inputs {
+		if in.Indexed {
+			indexed = append(indexed, in)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, lg.Topics[1:]); err != nil {
+		return nil, err
+	}
+
+	argBytes, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := time.Unix(int64(header.Time), 0).UTC()
+
+	data, err := json.Marshal(cmodels.ContractEventData{
+		ChainID:   ix.chainID,
+		EventName: ev.RawName,
+		Block: cmodels.Block{
+			Number:     new(big.Int).SetUint64(lg.BlockNumber),
+			Hash:       lg.BlockHash,
+			Time:       blockTime,
+			ParentHash: header.ParentHash,
+		},
+		Contract:        lg.Address,
+		TransactionHash: lg.TxHash,
+		EventSignature:  lg.Topics[0],
+		Arguments:       argBytes,
+		LogIndex:        &lg.Index,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudevent.RawEvent{
+		CloudEventHeader: cloudevent.CloudEventHeader{
+			SpecVersion: "1.0",
+			Type:        contractEventType,
+			Source:      fmt.Sprintf("chain/%d", ix.chainID),
+			Subject:     lg.Address.Hex(),
+			// Stable across runs, so that re-indexing a range doesn't duplicate ledger entries.
+			ID:              fmt.Sprintf("%s-%d", lg.TxHash.Hex(), lg.Index),
+			Time:            blockTime,
+			DataContentType: "application/json",
+			Producer:        "identity-api/indexer",
+		},
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,269 @@
+package indexer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/internal/services"
+	"github.com/DIMO-Network/identity-api/internal/services/connection"
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/DIMO-Network/identity-api/internal/services/staking"
+	"github.com/DIMO-Network/identity-api/internal/services/storagenode"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/goccy/go-json"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChain wraps a simulated backend with a funded account that deploys log emitters.
+type testChain struct {
+	t       *testing.T
+	backend *simulated.Backend
+	key     *ecdsa.PrivateKey
+	from    common.Address
+	nonce   uint64
+}
+
+func newTestChain(t *testing.T) *testChain {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	return &testChain{t: t, backend: backend, key: key, from: from}
+}
+
+func (c *testChain) send(to *common.Address, data []byte) {
+	chainID, err := c.backend.Client().ChainID(context.Background())
+	require.NoError(c.t, err)
+
+	tx, err := types.SignNewTx(c.key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     c.nonce,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(1e11),
+		Gas:       500_000,
+		To:        to,
+		Data:      data,
+	})
+	require.NoError(c.t, err)
+	require.NoError(c.t, c.backend.Client().SendTransaction(context.Background(), tx))
+
+	c.nonce++
+	c.backend.Commit()
+}
+
+// deployEmitter deploys a contract that copies its calldata into memory and logs it, using
+// the first n words as topics and the rest as data.
+func (c *testChain) deployEmitter(n int) common.Address {
+	runtime := []byte{0x36, 0x60, 0x00, 0x60, 0x00, 0x37} // CALLDATACOPY(0, 0, CALLDATASIZE)
+	for i := n - 1; i >= 0; i-- {
+		runtime = append(runtime, 0x60, byte(32*i), 0x51) // MLOAD(32*i)
+	}
+	runtime = append(runtime,
+		0x60, byte(32*n), 0x36, 0x03, // CALLDATASIZE - 32*n
+		0x60, byte(32*n), // offset
+		0xa0+byte(n), // LOGn
+		0x00,
+	)
+
+	initCode := []byte{0x60, byte(len(runtime)), 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, byte(len(runtime)), 0x60, 0x00, 0xf3}
+
+	addr := crypto.CreateAddress(c.from, c.nonce)
+	c.send(nil, append(initCode, runtime...))
+
+	return addr
+}
+
+func (c *testChain) emit(contract common.Address, topics []common.Hash, data []byte) {
+	var calldata []byte
+	for _, t := range topics {
+		calldata = append(calldata, t.Bytes()...)
+	}
+	c.send(&contract, append(calldata, data...))
+}
+
+func addressTopic(a common.Address) common.Hash {
+	return common.BytesToHash(a.Bytes())
+}
+
+func TestContractABIs(t *testing.T) {
+	settings := config.Settings{
+		StakingAddr:     "0x0000000000000000000000000000000000000001",
+		ConnectionAddr:  "0x0000000000000000000000000000000000000002",
+		StorageNodeAddr: "0x0000000000000000000000000000000000000003",
+	}
+
+	abis, err := ContractABIs(&settings)
+	require.NoError(t, err)
+	require.Len(t, abis, 3)
+
+	// The hand-maintained ABIs have to agree with the generated event IDs the handlers switch on.
+	ids := map[string][]common.Hash{
+		settings.StakingAddr:     {staking.StakedEventID, staking.WithdrawnEventID, staking.StakingExtendedEventID, staking.VehicleAttachedEventID, staking.VehicleDetachedEventID},
+		settings.ConnectionAddr:  {connection.ConnectionMintedEventID, connection.TransferEventID},
+		settings.StorageNodeAddr: {storagenode.StorageNodeAnchorMintedEventID, storagenode.NodeUriUpdatedEventID, storagenode.NodeSetForVehicleEventID, storagenode.TransferEventID},
+	}
+	for addr, hashes := range ids {
+		for _, h := range hashes {
+			_, err := abis[common.HexToAddress(addr)].EventByID(h)
+			assert.NoError(t, err, "event %s missing for %s", h, addr)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	chain := newTestChain(t)
+	registry := chain.deployEmitter(2)
+	vehicleNFT := chain.deployEmitter(4)
+
+	settings := config.Settings{
+		DIMORegistryChainID: 1337,
+		DIMORegistryAddr:    registry.Hex(),
+		VehicleNFTAddr:      vehicleNFT.Hex(),
+	}
+
+	ix, err := New(chain.backend.Client(), db.Store{}, nil, &settings, &logger)
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	newOwner := common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+
+	mintEvent := ix.contracts[registry].Events["ManufacturerNodeMinted"]
+	mintData, err := mintEvent.Inputs.NonIndexed().Pack("Toyota", big.NewInt(131))
+	require.NoError(t, err)
+	chain.emit(registry, []common.Hash{mintEvent.ID, addressTopic(owner)}, mintData)
+
+	transferEvent := ix.contracts[vehicleNFT].Events["Transfer"]
+	chain.emit(vehicleNFT, []common.Hash{transferEvent.ID, addressTopic(owner), addressTopic(newOwner), common.BigToHash(big.NewInt(12))}, nil)
+
+	// Unknown event on a watched contract.
+	chain.emit(vehicleNFT, []common.Hash{common.HexToHash("0x01"), {}, {}, {}}, nil)
+
+	logs, err := chain.backend.Client().FilterLogs(ctx, ethereum.FilterQuery{Addresses: ix.addresses})
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	decode := func(lg *types.Log) (*cmodels.ContractEventData, string) {
+		header, err := chain.backend.Client().HeaderByNumber(ctx, new(big.Int).SetUint64(lg.BlockNumber))
+		require.NoError(t, err)
+
+		event, err := ix.Decode(lg, header)
+		require.NoError(t, err)
+		if event == nil {
+			return nil, ""
+		}
+
+		assert.Equal(t, "chain/1337", event.Source)
+		assert.Equal(t, contractEventType, event.Type)
+		assert.Equal(t, time.Unix(int64(header.Time), 0).UTC(), event.Time)
+
+		var data cmodels.ContractEventData
+		require.NoError(t, json.Unmarshal(event.Data, &data))
+		assert.Equal(t, lg.BlockHash, data.Block.Hash)
+		assert.Equal(t, header.ParentHash, data.Block.ParentHash)
+		assert.Equal(t, lg.TxHash, data.TransactionHash)
+		assert.Equal(t, lg.Topics[0], data.EventSignature)
+		assert.Equal(t, &lg.Index, data.LogIndex)
+
+		return &data, event.ID
+	}
+
+	data, id := decode(&logs[0])
+	require.NotNil(t, data)
+	assert.Equal(t, "ManufacturerNodeMinted", data.EventName)
+	assert.Equal(t, registry, data.Contract)
+	assert.Equal(t, logs[0].TxHash.Hex()+"-0", id)
+
+	var mint services.ManufacturerNodeMintedData
+	require.NoError(t, json.Unmarshal(data.Arguments, &mint))
+	assert.Equal(t, services.ManufacturerNodeMintedData{Name: "Toyota", TokenID: big.NewInt(131), Owner: owner}, mint)
+
+	data, _ = decode(&logs[1])
+	require.NotNil(t, data)
+	assert.Equal(t, "Transfer", data.EventName)
+
+	var transfer services.TransferData
+	require.NoError(t, json.Unmarshal(data.Arguments, &transfer))
+	assert.Equal(t, services.TransferData{From: owner, To: newOwner, TokenID: big.NewInt(12)}, transfer)
+
+	data, _ = decode(&logs[2])
+	assert.Nil(t, data)
+}
+
+func TestIndexer_Run(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, "../../../migrations")
+
+	chain := newTestChain(t)
+	registry := chain.deployEmitter(2)
+	vehicleNFT := chain.deployEmitter(4)
+
+	settings := config.Settings{
+		DIMORegistryChainID: 1337,
+		DIMORegistryAddr:    registry.Hex(),
+		VehicleNFTAddr:      vehicleNFT.Hex(),
+	}
+
+	consumer := services.NewContractsEventsConsumer(pdb, &logger, &settings)
+	ix, err := New(chain.backend.Client(), pdb, consumer.Process, &settings, &logger)
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x46a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	newOwner := common.HexToAddress("0x55a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+
+	mintEvent := ix.contracts[registry].Events["ManufacturerNodeMinted"]
+	mintData, err := mintEvent.Inputs.NonIndexed().Pack("Toyota", big.NewInt(131))
+	require.NoError(t, err)
+	chain.emit(registry, []common.Hash{mintEvent.ID, addressTopic(owner)}, mintData)
+
+	head, err := chain.backend.Client().BlockNumber(ctx)
+	require.NoError(t, err)
+
+	opts := Options{FromBlock: new(uint64), ToBlock: &head, BatchSize: 2, PollInterval: time.Millisecond}
+	require.NoError(t, ix.Run(ctx, opts))
+
+	mfr, err := models.FindManufacturer(ctx, pdb.DBS().Reader, 131)
+	require.NoError(t, err)
+	assert.Equal(t, "Toyota", mfr.Name)
+
+	v := models.Vehicle{ID: 12, ManufacturerID: 131, OwnerAddress: owner.Bytes(), MintedAt: time.Now()}
+	require.NoError(t, v.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	transferEvent := ix.contracts[vehicleNFT].Events["Transfer"]
+	chain.emit(vehicleNFT, []common.Hash{transferEvent.ID, addressTopic(owner), addressTopic(newOwner), common.BigToHash(big.NewInt(12))}, nil)
+
+	// Resume from the checkpoint.
+	head, err = chain.backend.Client().BlockNumber(ctx)
+	require.NoError(t, err)
+	require.NoError(t, ix.Run(ctx, Options{ToBlock: &head, BatchSize: 2, PollInterval: time.Millisecond}))
+
+	require.NoError(t, v.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, newOwner.Bytes(), v.OwnerAddress)
+
+	cp, err := models.FindIndexerCheckpoint(ctx, pdb.DBS().Reader, 1337)
+	require.NoError(t, err)
+	assert.Equal(t, int64(head), cp.BlockNumber)
+}
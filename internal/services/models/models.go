@@ -16,10 +16,15 @@ type ContractEventData struct {
 	TransactionHash common.Hash     `json:"transactionHash"`
 	EventSignature  common.Hash     `json:"eventSignature"`
 	Arguments       json.RawMessage `json:"arguments"`
+	// LogIndex is the position of the log in its block. Events from the Kafka producer don't
+	// carry it.
+	LogIndex *uint `json:"logIndex,omitempty"`
 }
 
 type Block struct {
 	Number *big.Int    `json:"number,omitempty"`
 	Hash   common.Hash `json:"hash,omitempty"`
 	Time   time.Time   `json:"time,omitempty"`
+	// ParentHash is the hash of the previous block. Like LogIndex, only the indexer sets it.
+	ParentHash common.Hash `json:"parentHash,omitempty"`
 }
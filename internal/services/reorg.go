@@ -0,0 +1,118 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// enterBlock prepares the database for writes coming from the given block. If we've already
+// seen a different block at this height then the chain has reorganized, and everything written
+// from that height onward is rolled back before we continue. The canonical events for those
+// blocks are expected to follow this one.
+//
+// A reorganization can also replace a block we've seen with one that has none of our events, so
+// that no event ever arrives at its height again. When the producer supplies the parent hash,
+// that case is caught by comparing it with the block we have at the height below.
+//
+// For the rest of the transaction, database triggers stamp touched rows with the block hash and
+// journal their prior state so that the block can be reverted later.
+func (c *ContractsEventsConsumer) enterBlock(ctx context.Context, tx *sql.Tx, block *cmodels.Block) error {
+	number := block.Number.Int64()
+	hash := block.Hash.Bytes()
+
+	pb, err := models.FindProcessedBlock(ctx, tx, number)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to look up processed block %d: %w", number, err)
+	}
+
+	if pb != nil && !bytes.Equal(pb.Hash, hash) {
+		c.log.Warn().Int64("blockNumber", number).Str("oldHash", fmt.Sprintf("%#x", pb.Hash)).Str("newHash", block.Hash.Hex()).Msg("Chain reorganization detected, reverting.")
+
+		if err := c.revertFrom(ctx, tx, number); err != nil {
+			return err
+		}
+		pb = nil
+	}
+
+	if pb == nil && block.ParentHash != (common.Hash{}) {
+		parent, err := models.FindProcessedBlock(ctx, tx, number-1)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to look up processed block %d: %w", number-1, err)
+		}
+
+		if parent != nil && !bytes.Equal(parent.Hash, block.ParentHash.Bytes()) {
+			c.log.Warn().Int64("blockNumber", number-1).Str("oldHash", fmt.Sprintf("%#x", parent.Hash)).Str("newHash", block.ParentHash.Hex()).Msg("Chain reorganization detected from parent hash, reverting.")
+
+			if err := c.revertFrom(ctx, tx, number-1); err != nil {
+				return err
+			}
+		}
+	}
+
+	if pb == nil {
+		newPB := models.ProcessedBlock{Number: number, Hash: hash}
+		if err := newPB.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to record processed block %d: %w", number, err)
+		}
+
+		if err := c.pruneJournal(ctx, tx, number); err != nil {
+			return err
+		}
+	}
+
+	// The triggers read these settings. Being transaction-local, they can't leak into writes
+	// that aren't part of a block.
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('identity_api.block_number', $1, true), set_config('identity_api.block_hash', $2, true)",
+		strconv.FormatInt(number, 10), hex.EncodeToString(hash)); err != nil {
+		return fmt.Errorf("failed to set current block: %w", err)
+	}
+
+	return nil
+}
+
+// revertFrom undoes all journaled writes from blocks at or above the given height.
+func (c *ContractsEventsConsumer) revertFrom(ctx context.Context, tx *sql.Tx, number int64) error {
+	oldest, err := models.ProcessedBlocks(
+		qm.OrderBy(models.ProcessedBlockColumns.Number+" ASC"),
+	).One(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to find oldest processed block: %w", err)
+	}
+
+	// The journal for older blocks has been pruned, so there's no way to revert them.
+	if oldest.Number > number {
+		return fmt.Errorf("reorganization at block %d is deeper than the journal, which starts at block %d", number, oldest.Number)
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT identity_api.revert_blocks($1)", number); err != nil {
+		return fmt.Errorf("failed to revert blocks from %d: %w", number, err)
+	}
+
+	return nil
+}
+
+// pruneJournal drops journal entries for blocks that are too deep to be reorganized.
+func (c *ContractsEventsConsumer) pruneJournal(ctx context.Context, tx *sql.Tx, number int64) error {
+	cutoff := number - c.settings.ReorgDepth
+
+	if _, err := models.BlockJournals(models.BlockJournalWhere.BlockNumber.LT(cutoff)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to prune block journal: %w", err)
+	}
+
+	if _, err := models.ProcessedBlocks(models.ProcessedBlockWhere.Number.LT(cutoff)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to prune processed blocks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Reorg_RevertsOrphanedBlock(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	settings := config.Settings{
+		VehicleNFTAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+		ReorgHandling:       true,
+		ReorgDepth:          100,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	owners := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		common.HexToAddress("0x3333333333333333333333333333333333333333"),
+		common.HexToAddress("0x4444444444444444444444444444444444444444"),
+	}
+
+	m := models.Manufacturer{
+		ID:       131,
+		Name:     "Toyota",
+		Owner:    owners[0].Bytes(),
+		MintedAt: time.Now(),
+		Slug:     "toyota",
+	}
+	require.NoError(t, m.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	v := models.Vehicle{
+		ID:             100,
+		ManufacturerID: 131,
+		OwnerAddress:   owners[0].Bytes(),
+		MintedAt:       time.Now(),
+	}
+	require.NoError(t, v.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	transfer := func(id string, blockNumber int64, blockHash common.Hash, from, to common.Address) {
+		ced := contractEventData
+		ced.EventName = "Transfer"
+		ced.Block.Number = big.NewInt(blockNumber)
+		ced.Block.Hash = blockHash
+		ced.TransactionHash = common.BytesToHash(blockHash[:16])
+
+		e := prepareEvent(t, ced, TransferData{From: from, To: to, TokenID: big.NewInt(100)})
+		e.ID = id
+
+		require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	}
+
+	hash10 := common.HexToHash("0x10")
+	hash11 := common.HexToHash("0x11")
+	hash11Fork := common.HexToHash("0x11f")
+
+	transfer("a", 10, hash10, owners[0], owners[1])
+	transfer("b", 11, hash11, owners[1], owners[2])
+
+	require.NoError(t, v.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, owners[2].Bytes(), v.OwnerAddress)
+	assert.Equal(t, hash11.Bytes(), v.LastBlockHash.Bytes)
+
+	// Block 11 gets replaced.
+	transfer("c", 11, hash11Fork, owners[1], owners[3])
+
+	require.NoError(t, v.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, owners[3].Bytes(), v.OwnerAddress)
+	assert.Equal(t, hash11Fork.Bytes(), v.LastBlockHash.Bytes)
+
+	transfers, err := models.VehicleTransfers(
+		models.VehicleTransferWhere.VehicleID.EQ(100),
+		qm.OrderBy(models.VehicleTransferColumns.BlockNumber),
+	).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	assert.Equal(t, owners[1].Bytes(), transfers[0].ToAddress)
+	assert.Equal(t, owners[3].Bytes(), transfers[1].ToAddress)
+
+	pb, err := models.FindProcessedBlock(ctx, pdb.DBS().Reader, 11)
+	require.NoError(t, err)
+	assert.Equal(t, hash11Fork.Bytes(), pb.Hash)
+
+	// The orphaned event leaves the ledger, and could be applied again if it came back.
+	ces, err := models.ContractEvents(qm.OrderBy(models.ContractEventColumns.ID)).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	require.Len(t, ces, 2)
+	assert.Equal(t, "a", ces[0].CloudEventID)
+	assert.Equal(t, "c", ces[1].CloudEventID)
+
+	applied, err := models.ProcessedEvents(models.ProcessedEventWhere.CloudEventID.EQ("b")).Exists(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	// Writes outside of event processing aren't attributed to a block.
+	journaled, err := models.BlockJournals().Count(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+
+	_, err = v.Update(ctx, pdb.DBS().Writer, boil.Infer())
+	require.NoError(t, err)
+
+	n, err := models.BlockJournals().Count(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	assert.Equal(t, journaled, n)
+}
+
+func Test_Reorg_RevertsBlockReplacedWithoutEvents(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	settings := config.Settings{
+		VehicleNFTAddr:      contractEventData.Contract.String(),
+		DIMORegistryChainID: contractEventData.ChainID,
+		ReorgHandling:       true,
+		ReorgDepth:          100,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	owners := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		common.HexToAddress("0x3333333333333333333333333333333333333333"),
+	}
+
+	m := models.Manufacturer{
+		ID:       131,
+		Name:     "Toyota",
+		Owner:    owners[0].Bytes(),
+		MintedAt: time.Now(),
+		Slug:     "toyota",
+	}
+	require.NoError(t, m.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	v := models.Vehicle{
+		ID:             100,
+		ManufacturerID: 131,
+		OwnerAddress:   owners[0].Bytes(),
+		MintedAt:       time.Now(),
+	}
+	require.NoError(t, v.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	transfer := func(blockNumber int64, blockHash, parentHash common.Hash, from, to common.Address) {
+		ced := contractEventData
+		ced.EventName = "Transfer"
+		ced.Block.Number = big.NewInt(blockNumber)
+		ced.Block.Hash = blockHash
+		ced.Block.ParentHash = parentHash
+		ced.TransactionHash = common.BytesToHash(blockHash[:16])
+
+		e := prepareEvent(t, ced, TransferData{From: from, To: to, TokenID: big.NewInt(100)})
+		require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	}
+
+	hash11 := common.HexToHash("0x11")
+	hash12 := common.HexToHash("0x12")
+
+	transfer(11, hash11, common.HexToHash("0x10"), owners[0], owners[1])
+
+	// Block 12 descends from a block 11 that we never got events for, so ours was orphaned.
+	transfer(12, hash12, common.HexToHash("0x11f"), owners[0], owners[2])
+
+	require.NoError(t, v.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, owners[2].Bytes(), v.OwnerAddress)
+
+	transfers, err := models.VehicleTransfers(models.VehicleTransferWhere.VehicleID.EQ(100)).All(ctx, pdb.DBS().Reader)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	assert.EqualValues(t, 12, transfers[0].BlockNumber)
+
+	exists, err := models.ProcessedBlockExists(ctx, pdb.DBS().Reader, 11)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,58 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const events = `{"id":"a","source":"chain/137","type":"zone.dimo.contract.event","data":{"eventName":"Transfer","block":{"number":10}}}
+{"id":"b","source":"chain/137","type":"zone.dimo.contract.event","data":{"eventName":"Transfer","block":{"number":11}}}
+
+{"id":"c","source":"chain/137","type":"zone.dimo.contract.event","data":{"eventName":"Transfer","block":{"number":12}}}
+{"id":"d","source":"chain/137","type":"zone.dimo.contract.event","data":{"eventName":"Transfer","block":{"number":13}}}
+`
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	var seen []string
+	process := func(ctx context.Context, event *cloudevent.RawEvent) error {
+		seen = append(seen, event.ID)
+		return nil
+	}
+
+	from, to := int64(11), int64(12)
+	stats, err := Run(ctx, strings.NewReader(events), process, Options{FromBlock: &from, ToBlock: &to}, &logger)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b", "c"}, seen)
+	assert.Equal(t, Stats{Read: 4, Processed: 2, Skipped: 2}, stats)
+}
+
+func TestRun_Failure(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	process := func(ctx context.Context, event *cloudevent.RawEvent) error {
+		if event.ID == "c" {
+			return errors.New("unknown asset")
+		}
+		return nil
+	}
+
+	stats, err := Run(ctx, strings.NewReader(events), process, Options{}, &logger)
+	require.ErrorContains(t, err, "line 4")
+	assert.Equal(t, Stats{Read: 3, Processed: 2}, stats)
+
+	stats, err = Run(ctx, strings.NewReader(events), process, Options{KeepGoing: true}, &logger)
+	require.NoError(t, err)
+	assert.Equal(t, Stats{Read: 4, Processed: 3, Failed: 1}, stats)
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// Causes recorded in sacd_history.
+const (
+	sacdCauseSet       = "set"
+	sacdCauseRenounced = "renounced"
+	sacdCauseTransfer  = "transfer"
+	sacdCauseExpired   = "expired"
+)
+
+// grant is a row of one of the SACD or privilege tables, in the shape of a history entry.
+type grant struct {
+	entry     models.SacdHistory
+	grantedAt time.Time
+}
+
+func vehicleSacdGrant(s *models.VehicleSacd) *grant {
+	return &grant{
+		entry: models.SacdHistory{
+			VehicleID:   null.IntFrom(s.VehicleID),
+			Grantee:     s.Grantee,
+			Permissions: null.StringFrom(s.Permissions),
+			Source:      null.StringFrom(s.Source),
+			TemplateID:  s.TemplateID,
+			ExpiresAt:   s.ExpiresAt,
+		},
+		grantedAt: s.CreatedAt,
+	}
+}
+
+func accountSacdGrant(s *models.AccountSacd) *grant {
+	return &grant{
+		entry: models.SacdHistory{
+			Account:     null.BytesFrom(s.Account),
+			Grantee:     s.Grantee,
+			Permissions: null.StringFrom(s.Permissions),
+			Source:      null.StringFrom(s.Source),
+			TemplateID:  s.TemplateID,
+			ExpiresAt:   s.ExpiresAt,
+		},
+		grantedAt: s.CreatedAt,
+	}
+}
+
+func connectionSacdGrant(s *models.ConnectionSacd) *grant {
+	return &grant{
+		entry: models.SacdHistory{
+			ConnectionID: null.BytesFrom(s.ConnectionID),
+			Grantee:      s.Grantee,
+			Permissions:  null.StringFrom(s.Permissions),
+			Source:       null.StringFrom(s.Source),
+			TemplateID:   s.TemplateID,
+			ExpiresAt:    s.ExpiresAt,
+		},
+		grantedAt: s.CreatedAt,
+	}
+}
+
+func privilegeGrant(p *models.Privilege) *grant {
+	return &grant{
+		entry: models.SacdHistory{
+			VehicleID:   null.IntFrom(p.TokenID),
+			PrivilegeID: null.IntFrom(p.PrivilegeID),
+			Grantee:     p.UserAddress,
+			ExpiresAt:   p.ExpiresAt,
+		},
+		grantedAt: p.SetAt,
+	}
+}
+
+// recordGrantSet stores a new or updated grant. If it replaces one that had already lapsed, the
+// lapse is stored first.
+func (c *ContractsEventsConsumer) recordGrantSet(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, prev, g *grant) error {
+	if prev != nil {
+		if err := c.recordGrantLapse(ctx, tx, e, prev); err != nil {
+			return err
+		}
+	}
+
+	return c.insertGrantChange(ctx, tx, e, g, sacdCauseSet)
+}
+
+// recordGrantRemoved stores the removal of a grant, by a renouncement or a transfer of the asset.
+func (c *ContractsEventsConsumer) recordGrantRemoved(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, g *grant, cause string) error {
+	if err := c.recordGrantLapse(ctx, tx, e, g); err != nil {
+		return err
+	}
+
+	return c.insertGrantChange(ctx, tx, e, g, cause)
+}
+
+// recordGrantLapse stores the expiry of g if it expired before e.
+func (c *ContractsEventsConsumer) recordGrantLapse(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, g *grant) error {
+	if g.entry.ExpiresAt.After(e.Block.Time) {
+		return nil
+	}
+
+	h := g.entry
+	h.Cause = sacdCauseExpired
+	h.ChangedAt = g.entry.ExpiresAt
+	// Grants can be set with an expiration in the past.
+	if h.ChangedAt.Before(g.grantedAt) {
+		h.ChangedAt = g.grantedAt
+	}
+
+	if err := h.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record grant expiry: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ContractsEventsConsumer) insertGrantChange(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData, g *grant, cause string) error {
+	h := g.entry
+	h.Cause = cause
+	h.ChangedAt = e.Block.Time
+	h.BlockNumber = null.Int64From(e.Block.Number.Int64())
+	h.TransactionHash = null.BytesFrom(e.TransactionHash.Bytes())
+
+	if err := h.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to record grant change: %w", err)
+	}
+
+	return nil
+}
+
+// removeVehicleGrants deletes the privileges and SACDs of the vehicle, which the contracts drop
+// whenever it changes hands, and records each in the history.
+func (c *ContractsEventsConsumer) removeVehicleGrants(ctx context.Context, tx *sql.Tx, vehicleID int, e *cmodels.ContractEventData) error {
+	privs, err := models.Privileges(models.PrivilegeWhere.TokenID.EQ(vehicleID)).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range privs {
+		if err := c.recordGrantRemoved(ctx, tx, e, privilegeGrant(p), sacdCauseTransfer); err != nil {
+			return err
+		}
+	}
+
+	if _, err := privs.DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete associated privileges: %w", err)
+	}
+
+	sacds, err := models.VehicleSacds(models.VehicleSacdWhere.VehicleID.EQ(vehicleID)).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sacds {
+		if err := c.recordGrantRemoved(ctx, tx, e, vehicleSacdGrant(s), sacdCauseTransfer); err != nil {
+			return err
+		}
+	}
+
+	if _, err := sacds.DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete associated SACDs: %w", err)
+	}
+
+	return nil
+}
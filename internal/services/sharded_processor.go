@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"hash/fnv"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	workerEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "identity_api_consumer_worker_events_total",
+		Help: "Contract events applied by each consumer worker, by result.",
+	}, []string{"worker", "result"})
+	workerEventDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "identity_api_consumer_worker_event_duration_seconds",
+		Help:    "Time each consumer worker spends applying a contract event.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"worker"})
+	workerQueueLength = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "identity_api_consumer_worker_queue_length",
+		Help: "Contract events waiting for each consumer worker.",
+	}, []string{"worker"})
+)
+
+type shardedJob struct {
+	event *cloudevent.RawEvent
+	msg   kafka.Message
+	// fromKafka is set if msg identifies the message that delivered the event.
+	fromKafka bool
+}
+
+// ShardedProcessor applies contract events on a fixed number of workers. Events are assigned to
+// workers by EntityKey, so events for the same vehicle, device or license keep their order while
+// events for different ones run concurrently. Each worker has a bounded queue, and Process blocks
+// while the target queue is full.
+//
+// Workers finish out of order, so Kafka offsets aren't claimed per event. Instead, an offset is
+// stored once it and every tracked offset before it in the partition have been applied. After a
+// restart, events past the stored offset are delivered again and skipped as already applied. An
+// event that fails is never finished, so nothing past it is stored.
+type ShardedProcessor struct {
+	consumer *ContractsEventsConsumer
+	process  func(ctx context.Context, event *cloudevent.RawEvent) error
+	queues   []chan shardedJob
+	offsets  offsetTracker
+}
+
+// NewShardedProcessor creates a processor that runs process on the given number of workers, each
+// with room for queueSize waiting events.
+func NewShardedProcessor(consumer *ContractsEventsConsumer, process func(ctx context.Context, event *cloudevent.RawEvent) error, workers, queueSize int) *ShardedProcessor {
+	queues := make([]chan shardedJob, workers)
+	for i := range queues {
+		queues[i] = make(chan shardedJob, queueSize)
+	}
+
+	return &ShardedProcessor{
+		consumer: consumer,
+		process:  process,
+		queues:   queues,
+		offsets:  offsetTracker{partitions: make(map[partitionID]*partitionOffsets)},
+	}
+}
+
+// Start launches the workers. They stop once the context is canceled.
+func (p *ShardedProcessor) Start(ctx context.Context) {
+	// The workers must not claim offsets themselves, and neither must the dead-letter queue.
+	ctx = kafka.WithMessage(ctx, kafka.Message{})
+
+	for i, queue := range p.queues {
+		go p.work(ctx, strconv.Itoa(i), queue)
+	}
+}
+
+// Process queues the event for the worker that owns its entity.
+func (p *ShardedProcessor) Process(ctx context.Context, event *cloudevent.RawEvent) error {
+	// Filter out end-of-block events.
+	if event.Type != contractEventCEType || !p.consumer.isChainEvent(event) {
+		return nil
+	}
+
+	job := shardedJob{event: event}
+	job.msg, job.fromKafka = kafka.MessageFromContext(ctx)
+	if job.fromKafka {
+		p.offsets.start(job.msg)
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(p.consumer.EntityKey(event))) //nolint:errcheck
+	worker := int(h.Sum32() % uint32(len(p.queues)))
+
+	select {
+	case p.queues[worker] <- job:
+		workerQueueLength.WithLabelValues(strconv.Itoa(worker)).Inc()
+		return nil
+	case <-ctx.Done():
+		// The offset stays pending, which holds the partition back until the message is delivered
+		// again.
+		return ctx.Err()
+	}
+}
+
+func (p *ShardedProcessor) work(ctx context.Context, worker string, queue <-chan shardedJob) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-queue:
+			workerQueueLength.WithLabelValues(worker).Dec()
+
+			start := time.Now()
+			err := p.process(ctx, job.event)
+			workerEventDuration.WithLabelValues(worker).Observe(time.Since(start).Seconds())
+
+			if err != nil {
+				// The event wasn't applied or stored for a retry, so the offset stays pending. That
+				// holds the partition back until the message is delivered again after a restart.
+				workerEvents.WithLabelValues(worker, "error").Inc()
+				p.consumer.log.Err(err).Str("worker", worker).Str("id", job.event.ID).Msg("Failed to process event.")
+				continue
+			}
+			workerEvents.WithLabelValues(worker, "success").Inc()
+
+			if job.fromKafka {
+				if msg, ok := p.offsets.finish(job.msg); ok {
+					if err := p.storeOffset(ctx, msg); err != nil {
+						p.consumer.log.Err(err).Str("topic", msg.Topic).Int32("partition", msg.Partition).Int64("offset", msg.Offset).Msg("Failed to store consumer offset.")
+					}
+				}
+			}
+		}
+	}
+}
+
+func (p *ShardedProcessor) storeOffset(ctx context.Context, msg kafka.Message) error {
+	tx, err := p.consumer.dbs.DBS().Writer.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if _, err := kafka.ClaimOffset(ctx, tx, msg); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type partitionID struct {
+	topic     string
+	partition int32
+}
+
+type partitionOffsets struct {
+	pending map[int64]struct{}
+	// done holds applied offsets that haven't been stored yet.
+	done map[int64]struct{}
+}
+
+// offsetTracker follows the in-flight offsets of each partition.
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[partitionID]*partitionOffsets
+}
+
+func (t *offsetTracker) start(msg kafka.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := partitionID{msg.Topic, msg.Partition}
+	po, ok := t.partitions[id]
+	if !ok {
+		po = &partitionOffsets{pending: make(map[int64]struct{}), done: make(map[int64]struct{})}
+		t.partitions[id] = po
+	}
+
+	po.pending[msg.Offset] = struct{}{}
+}
+
+// finish marks the message as applied. It returns the newest applied offset that no pending
+// offset precedes, if there is one that hasn't been returned before.
+func (t *offsetTracker) finish(msg kafka.Message) (kafka.Message, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	po := t.partitions[partitionID{msg.Topic, msg.Partition}]
+	delete(po.pending, msg.Offset)
+	po.done[msg.Offset] = struct{}{}
+
+	oldest := int64(math.MaxInt64)
+	if len(po.pending) != 0 {
+		oldest = slices.Min(slices.Collect(maps.Keys(po.pending)))
+	}
+
+	safe := int64(-1)
+	for o := range po.done {
+		if o < oldest {
+			safe = max(safe, o)
+			delete(po.done, o)
+		}
+	}
+
+	if safe < 0 {
+		return kafka.Message{}, false
+	}
+
+	return kafka.Message{Topic: msg.Topic, Partition: msg.Partition, Offset: safe}, true
+}
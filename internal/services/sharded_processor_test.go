@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/kafka"
+	"github.com/DIMO-Network/shared/pkg/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityKey(t *testing.T) {
+	logger := zerolog.Nop()
+	settings := config.Settings{
+		DIMORegistryAddr:      dimoRegistryAddr,
+		VehicleNFTAddr:        vehicleIdAddr,
+		AftermarketDeviceAddr: aftermarketDeviceAddr,
+		SACDAddress:           sacdAddr,
+		DCNRegistryAddr:       "0x374350Ab806E06217e84a0238150E98788cd26ab",
+		ConnectionAddr:        "0x41799E9Dc893722844E771a1C1cAf3BBc2876132",
+		DIMORegistryChainID:   contractEventData.ChainID,
+	}
+	consumer := NewContractsEventsConsumer(db.Store{}, &logger, &settings)
+
+	node := common.HexToHash("0x2a").Bytes()
+	account := common.HexToAddress("0x7C2c8B6e3A5F0E1d4b9A2c3D4e5F60718293a4b5")
+
+	event := func(contract, name string, args any) *cloudevent.RawEvent {
+		ced := contractEventData
+		ced.Contract = common.HexToAddress(contract)
+		ced.EventName = name
+		e := prepareEvent(t, ced, args)
+		return &e
+	}
+
+	cases := []struct {
+		name  string
+		event *cloudevent.RawEvent
+		key   string
+	}{
+		{"vehicle transfer", event(vehicleIdAddr, "Transfer", TransferData{TokenID: big.NewInt(12)}), "vehicle/12"},
+		{"vehicle attribute", event(dimoRegistryAddr, "VehicleAttributeSet", VehicleAttributeSetData{TokenID: big.NewInt(12)}), "vehicle/12"},
+		{"device claim", event(dimoRegistryAddr, "AftermarketDeviceClaimed", AftermarketDeviceClaimedData{AftermarketDeviceNode: big.NewInt(3)}), "device/3"},
+		{"device pairing", event(dimoRegistryAddr, "AftermarketDevicePaired", AftermarketDevicePairData{AftermarketDeviceNode: big.NewInt(3), VehicleNode: big.NewInt(12)}), "vehicle/12"},
+		{"device unpairing", event(dimoRegistryAddr, "AftermarketDeviceUnpaired", AftermarketDevicePairData{AftermarketDeviceNode: big.NewInt(3), VehicleNode: big.NewInt(12)}), "vehicle/12"},
+		{"synthetic device mint", event(dimoRegistryAddr, "SyntheticDeviceNodeMinted", SyntheticDeviceNodeMintedData{SyntheticDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(12)}), "vehicle/12"},
+		{"synthetic device burn", event(dimoRegistryAddr, "SyntheticDeviceNodeBurned", SyntheticDeviceNodeBurnedData{SyntheticDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(12)}), "vehicle/12"},
+		{"device beneficiary", event(dimoRegistryAddr, "BeneficiarySet", BeneficiarySetData{NodeId: big.NewInt(3)}), "device/3"},
+		{"device transfer", event(aftermarketDeviceAddr, "Transfer", TransferData{TokenID: big.NewInt(3)}), "device/3"},
+		{"vehicle SACD", event(sacdAddr, "PermissionsSet", PermissionsSetData{Asset: common.HexToAddress(vehicleIdAddr), TokenId: big.NewInt(12)}), "vehicle/12"},
+		{"DCN mint", event(settings.DCNRegistryAddr, "NewNode", NewDCNNodeData{Node: node}), fmt.Sprintf("dcn/%x", node)},
+		{"DCN transfer", event(settings.DCNRegistryAddr, "Transfer", TransferData{TokenID: big.NewInt(42)}), fmt.Sprintf("dcn/%x", node)},
+		{"account SACD", event(sacdAddr, "PermissionsSet", PermissionsSetData{Asset: account, TokenId: big.NewInt(0)}), "account/" + account.Hex()},
+		{"connection SACD", event(sacdAddr, "PermissionsSet", PermissionsSetData{Asset: common.HexToAddress(settings.ConnectionAddr), TokenId: big.NewInt(5)}), "connection/5"},
+		{"other asset SACD", event(sacdAddr, "PermissionsSet", PermissionsSetData{Asset: account, TokenId: big.NewInt(5)}), "contract/" + common.HexToAddress(sacdAddr).Hex()},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.key, consumer.EntityKey(c.event))
+		})
+	}
+}
+
+func TestShardedProcessor_KeepsEntityOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zerolog.Nop()
+	settings := config.Settings{VehicleNFTAddr: vehicleIdAddr, DIMORegistryChainID: contractEventData.ChainID}
+	consumer := NewContractsEventsConsumer(db.Store{}, &logger, &settings)
+
+	var mu sync.Mutex
+	applied := make(map[string][]string)
+	var wg sync.WaitGroup
+
+	sharded := NewShardedProcessor(consumer, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		defer wg.Done()
+		mu.Lock()
+		defer mu.Unlock()
+		key := consumer.EntityKey(event)
+		applied[key] = append(applied[key], event.ID)
+		return nil
+	}, 4, 1)
+	sharded.Start(ctx)
+
+	ced := contractEventData
+	ced.Contract = common.HexToAddress(vehicleIdAddr)
+	ced.EventName = "Transfer"
+
+	expected := make(map[string][]string)
+	for i := range 50 {
+		e := prepareEvent(t, ced, TransferData{TokenID: big.NewInt(int64(i % 5))})
+		key := consumer.EntityKey(&e)
+		expected[key] = append(expected[key], e.ID)
+
+		wg.Add(1)
+		require.NoError(t, sharded.Process(ctx, &e))
+	}
+
+	// Events from other chains are dropped.
+	other := prepareEvent(t, ced, TransferData{TokenID: big.NewInt(1)})
+	other.Source = "chain/1"
+	require.NoError(t, sharded.Process(ctx, &other))
+
+	wg.Wait()
+	assert.Equal(t, expected, applied)
+}
+
+func TestShardedProcessor_LeavesFailedOffsetPending(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zerolog.Nop()
+	settings := config.Settings{VehicleNFTAddr: vehicleIdAddr, DIMORegistryChainID: contractEventData.ChainID}
+	consumer := NewContractsEventsConsumer(db.Store{}, &logger, &settings)
+
+	processed := make(chan string)
+	sharded := NewShardedProcessor(consumer, func(ctx context.Context, event *cloudevent.RawEvent) error {
+		processed <- event.ID
+		return fmt.Errorf("couldn't store dead letter")
+	}, 2, 1)
+	sharded.Start(ctx)
+
+	ced := contractEventData
+	ced.Contract = common.HexToAddress(vehicleIdAddr)
+	ced.EventName = "Transfer"
+
+	msg := kafka.Message{Topic: "contract-events", Partition: 1, Offset: 10}
+	failed := prepareEvent(t, ced, TransferData{TokenID: big.NewInt(1)})
+	require.NoError(t, sharded.Process(kafka.WithMessage(ctx, msg), &failed))
+
+	// The next event for the vehicle runs on the same worker, after the first one is handled.
+	next := prepareEvent(t, ced, TransferData{TokenID: big.NewInt(1)})
+	require.NoError(t, sharded.Process(ctx, &next))
+
+	assert.Equal(t, failed.ID, <-processed)
+	assert.Equal(t, next.ID, <-processed)
+
+	// 10 was never finished, so a later offset can't be stored past it.
+	sharded.offsets.start(kafka.Message{Topic: msg.Topic, Partition: msg.Partition, Offset: 11})
+	_, ok := sharded.offsets.finish(kafka.Message{Topic: msg.Topic, Partition: msg.Partition, Offset: 11})
+	assert.False(t, ok)
+}
+
+func TestOffsetTracker(t *testing.T) {
+	tracker := offsetTracker{partitions: make(map[partitionID]*partitionOffsets)}
+	msg := func(offset int64) kafka.Message {
+		return kafka.Message{Topic: "contract-events", Partition: 1, Offset: offset}
+	}
+
+	for _, o := range []int64{10, 11, 13} {
+		tracker.start(msg(o))
+	}
+
+	// 10 is still in flight.
+	_, ok := tracker.finish(msg(13))
+	assert.False(t, ok)
+
+	_, ok = tracker.finish(msg(11))
+	assert.False(t, ok)
+
+	// Everything through 13 is done. 12 was never tracked.
+	stored, ok := tracker.finish(msg(10))
+	require.True(t, ok)
+	assert.Equal(t, msg(13), stored)
+
+	tracker.start(msg(14))
+	tracker.start(msg(15))
+
+	stored, ok = tracker.finish(msg(14))
+	require.True(t, ok)
+	assert.Equal(t, msg(14), stored)
+}
@@ -3,6 +3,7 @@ package staking
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"math/big"
 
@@ -56,7 +57,7 @@ type Transfer struct {
 	TokenId *big.Int       `json:"tokenId"`
 }
 
-func (h *Handler) HandleEvent(ctx context.Context, event *models.ContractEventData) error {
+func (h *Handler) HandleEvent(ctx context.Context, tx *sql.Tx, event *models.ContractEventData) error {
 	switch event.EventSignature {
 	case StakedEventID:
 		var args Staked
@@ -64,42 +65,42 @@ func (h *Handler) HandleEvent(ctx context.Context, event *models.ContractEventDa
 		if err != nil {
 			return err
 		}
-		return h.HandleStaked(ctx, event, &args)
+		return h.HandleStaked(ctx, tx, event, &args)
 	case WithdrawnEventID:
 		var args Withdrawn
 		err := json.Unmarshal(event.Arguments, &args)
 		if err != nil {
 			return err
 		}
-		return h.HandleWithdrawn(ctx, event, &args)
+		return h.HandleWithdrawn(ctx, tx, event, &args)
 	case StakingExtendedEventID:
 		var args StakingExtended
 		err := json.Unmarshal(event.Arguments, &args)
 		if err != nil {
 			return err
 		}
-		return h.HandleStakingExtended(ctx, event, &args)
+		return h.HandleStakingExtended(ctx, tx, event, &args)
 	case VehicleAttachedEventID:
 		var args VehicleAttached
 		err := json.Unmarshal(event.Arguments, &args)
 		if err != nil {
 			return err
 		}
-		return h.HandleVehicleAttached(ctx, event, &args)
+		return h.HandleVehicleAttached(ctx, tx, event, &args)
 	case VehicleDetachedEventID:
 		var args VehicleDetached
 		err := json.Unmarshal(event.Arguments, &args)
 		if err != nil {
 			return err
 		}
-		return h.HandleVehicleDetached(ctx, event, &args)
+		return h.HandleVehicleDetached(ctx, tx, event, &args)
 	case TransferEventID:
 		var args Transfer
 		err := json.Unmarshal(event.Arguments, &args)
 		if err != nil {
 			return err
 		}
-		return h.HandleTransfer(ctx, event, &args)
+		return h.HandleTransfer(ctx, tx, event, &args)
 	}
 
 	return nil
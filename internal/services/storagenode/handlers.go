@@ -2,12 +2,12 @@ package storagenode
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 
 	"github.com/DIMO-Network/identity-api/internal/helpers"
 	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
 	dmodels "github.com/DIMO-Network/identity-api/models"
-	"github.com/DIMO-Network/shared/pkg/db"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/ethereum/go-ethereum/common"
@@ -17,26 +17,25 @@ import (
 //go:generate go tool eventgen eventcfg.yaml -p storagenode -o events.go
 
 type Handler struct {
-	DBS    db.Store
 	Logger *zerolog.Logger
 }
 
-func (h *Handler) Handle(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) Handle(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	switch ev.EventSignature {
 	case StorageNodeAnchorMintedEventID:
-		return h.HandleStorageNodeAnchorMinted(ctx, ev)
+		return h.HandleStorageNodeAnchorMinted(ctx, tx, ev)
 	case TransferEventID:
-		return h.HandleTransfer(ctx, ev)
+		return h.HandleTransfer(ctx, tx, ev)
 	case NodeUriUpdatedEventID:
-		return h.HandleNodeUriUpdated(ctx, ev)
+		return h.HandleNodeUriUpdated(ctx, tx, ev)
 	case NodeSetForVehicleEventID:
-		return h.HandleNodeSetForVehicle(ctx, ev)
+		return h.HandleNodeSetForVehicle(ctx, tx, ev)
 	default:
 		return nil
 	}
 }
 
-func (h *Handler) HandleStorageNodeAnchorMinted(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) HandleStorageNodeAnchorMinted(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	var snam StorageNodeAnchorMinted
 	err := json.Unmarshal(ev.Arguments, &snam)
 	if err != nil {
@@ -61,10 +60,10 @@ func (h *Handler) HandleStorageNodeAnchorMinted(ctx context.Context, ev *cmodels
 
 	h.Logger.Info().Str("label", snam.NodeAnchorLabel).Msg("Storage node minted.")
 
-	return sn.Insert(ctx, h.DBS.DBS().Writer, boil.Infer())
+	return sn.Insert(ctx, tx, boil.Infer())
 }
 
-func (h *Handler) HandleTransfer(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) HandleTransfer(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	var t Transfer
 	err := json.Unmarshal(ev.Arguments, &t)
 	if err != nil {
@@ -86,11 +85,11 @@ func (h *Handler) HandleTransfer(ctx context.Context, ev *cmodels.ContractEventD
 		Owner: t.To.Bytes(),
 	}
 
-	_, err = sn.Update(ctx, h.DBS.DBS().Writer, boil.Whitelist(dmodels.StorageNodeColumns.Owner))
+	_, err = sn.Update(ctx, tx, boil.Whitelist(dmodels.StorageNodeColumns.Owner))
 	return err
 }
 
-func (h *Handler) HandleNodeUriUpdated(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) HandleNodeUriUpdated(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	var nuu NodeUriUpdated
 	err := json.Unmarshal(ev.Arguments, &nuu)
 	if err != nil {
@@ -107,11 +106,11 @@ func (h *Handler) HandleNodeUriUpdated(ctx context.Context, ev *cmodels.Contract
 		URI: nuu.NewNodeUri,
 	}
 
-	_, err = sn.Update(ctx, h.DBS.DBS().Writer, boil.Whitelist(dmodels.StorageNodeColumns.URI))
+	_, err = sn.Update(ctx, tx, boil.Whitelist(dmodels.StorageNodeColumns.URI))
 	return err
 }
 
-func (h *Handler) HandleNodeSetForVehicle(ctx context.Context, ev *cmodels.ContractEventData) error {
+func (h *Handler) HandleNodeSetForVehicle(ctx context.Context, tx *sql.Tx, ev *cmodels.ContractEventData) error {
 	var nsfv NodeSetForVehicle
 	err := json.Unmarshal(ev.Arguments, &nsfv)
 	if err != nil {
@@ -130,7 +129,7 @@ func (h *Handler) HandleNodeSetForVehicle(ctx context.Context, ev *cmodels.Contr
 		StorageNodeID: null.BytesFrom(anchorID),
 	}
 
-	_, err = sn.Update(ctx, h.DBS.DBS().Writer, boil.Whitelist(dmodels.VehicleColumns.StorageNodeID))
+	_, err = sn.Update(ctx, tx, boil.Whitelist(dmodels.VehicleColumns.StorageNodeID))
 	return err
 }
 
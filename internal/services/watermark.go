@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	cmodels "github.com/DIMO-Network/identity-api/internal/services/models"
+	"github.com/aarondl/null/v8"
+)
+
+// setWatermark tells the database triggers the chain position of the event about to be applied.
+// Mutable rows (vehicles, aftermarket devices, SACDs, privileges, stakes and DCNs) are stamped
+// with it, and writes to rows that were last modified by a later event are silently dropped, so
+// that an out-of-order or replayed event can't overwrite newer state.
+func setWatermark(ctx context.Context, tx *sql.Tx, e *cmodels.ContractEventData) error {
+	var logIndex string
+	if e.LogIndex != nil {
+		logIndex = strconv.FormatUint(uint64(*e.LogIndex), 10)
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('identity_api.event_block', $1, true), set_config('identity_api.event_log_index', $2, true)",
+		e.Block.Number.String(), logIndex); err != nil {
+		return fmt.Errorf("failed to set event position: %w", err)
+	}
+
+	return nil
+}
+
+// logIndex returns the log index of the event, if the producer supplied one.
+func logIndex(e *cmodels.ContractEventData) null.Int {
+	if e.LogIndex == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(*e.LogIndex))
+}
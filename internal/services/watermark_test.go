@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/identity-api/internal/config"
+	"github.com/DIMO-Network/identity-api/internal/helpers"
+	"github.com/DIMO-Network/identity-api/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Watermark_RejectsStaleUpdates(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("app", helpers.DBSettings.Name).Logger()
+
+	settings := config.Settings{
+		AftermarketDeviceAddr: aftermarketDeviceAddr,
+		DIMORegistryChainID:   contractEventData.ChainID,
+	}
+
+	pdb, _ := helpers.StartContainerDatabase(ctx, t, migrationsDirRelPath)
+	contractEventConsumer := NewContractsEventsConsumer(pdb, &logger, &settings)
+
+	mfr := models.Manufacturer{ID: 137, Owner: common.FromHex("46a3A41bd932244Dd08186e4c19F1a7E48cbcDff"), Name: "AutoPi", MintedAt: time.Now(), Slug: "autopi"}
+	require.NoError(t, mfr.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	owner := common.HexToAddress("0x22a3A41bd932244Dd08186e4c19F1a7E48cbcDf4")
+	ad := models.AftermarketDevice{ID: 100, ManufacturerID: 137, Owner: owner.Bytes(), Beneficiary: owner.Bytes(), Address: common.FromHex("0xaba3A41bd932244Dd08186e4c19F1a7E48cbcDf4")}
+	require.NoError(t, ad.Insert(ctx, pdb.DBS().Writer, boil.Infer()))
+
+	setBeneficiary := func(blockNumber int64, logIndex *uint, beneficiary common.Address) {
+		ced := contractEventData
+		ced.EventName = "BeneficiarySet"
+		ced.Contract = common.HexToAddress(aftermarketDeviceAddr)
+		ced.Block.Number = big.NewInt(blockNumber)
+		ced.LogIndex = logIndex
+
+		e := prepareEvent(t, ced, BeneficiarySetData{IdProxyAddress: ced.Contract, Beneficiary: beneficiary, NodeId: big.NewInt(100)})
+		require.NoError(t, contractEventConsumer.Process(ctx, &e))
+	}
+
+	index := func(i uint) *uint { return &i }
+
+	newest := common.HexToAddress("0x55b6D41bd932244Dd08186e4c19F1a7E48cbcDf3")
+	setBeneficiary(10, index(2), newest)
+
+	// An earlier block, and an earlier log in the same block.
+	setBeneficiary(5, nil, common.HexToAddress("0x11b6D41bd932244Dd08186e4c19F1a7E48cbcDf3"))
+	setBeneficiary(10, index(1), common.HexToAddress("0x12b6D41bd932244Dd08186e4c19F1a7E48cbcDf3"))
+
+	require.NoError(t, ad.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, newest.Bytes(), ad.Beneficiary)
+	assert.EqualValues(t, 10, ad.LastBlockNumber.Int64)
+	assert.EqualValues(t, 2, ad.LastLogIndex.Int)
+
+	// Without a log index, events in the same block are applied in arrival order.
+	sameBlock := common.HexToAddress("0x13b6D41bd932244Dd08186e4c19F1a7E48cbcDf3")
+	setBeneficiary(10, nil, sameBlock)
+
+	require.NoError(t, ad.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, sameBlock.Bytes(), ad.Beneficiary)
+	assert.False(t, ad.LastLogIndex.Valid)
+
+	later := common.HexToAddress("0x14b6D41bd932244Dd08186e4c19F1a7E48cbcDf3")
+	setBeneficiary(11, index(0), later)
+
+	require.NoError(t, ad.Reload(ctx, pdb.DBS().Reader))
+	assert.Equal(t, later.Bytes(), ad.Beneficiary)
+	assert.EqualValues(t, 11, ad.LastBlockNumber.Int64)
+}
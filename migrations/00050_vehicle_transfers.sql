@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per transfer of a vehicle, including mints and burns. A token can change hands more
+-- than once in a transaction, so transfers are told apart by log index. Events from the Kafka
+-- producer don't carry one; for those the id, which follows processing order, breaks ties.
+CREATE TABLE vehicle_transfers (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT vehicle_transfers_pkey PRIMARY KEY,
+    vehicle_id int NOT NULL,
+    from_address bytea NOT NULL CONSTRAINT vehicle_transfers_from_address_check CHECK (length(from_address) = 20),
+    to_address bytea NOT NULL CONSTRAINT vehicle_transfers_to_address_check CHECK (length(to_address) = 20),
+    block_number bigint NOT NULL,
+    block_time TIMESTAMPTZ NOT NULL,
+    transaction_hash bytea NOT NULL CONSTRAINT vehicle_transfers_transaction_hash_check CHECK (length(transaction_hash) = 32),
+    log_index int,
+
+    CONSTRAINT vehicle_transfers_log_key UNIQUE (vehicle_id, transaction_hash, log_index)
+);
+
+CREATE INDEX vehicle_transfers_vehicle_id_idx ON vehicle_transfers (vehicle_id, block_number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vehicle_transfers;
+-- +goose StatementEnd
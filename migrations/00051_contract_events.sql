@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE contract_events (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT contract_events_pkey PRIMARY KEY,
+    cloud_event_id text NOT NULL CONSTRAINT contract_events_cloud_event_id_key UNIQUE,
+    chain_id bigint NOT NULL,
+    contract bytea NOT NULL CONSTRAINT contract_events_contract_check CHECK (length(contract) = 20),
+    event_name text NOT NULL,
+    event_signature bytea NOT NULL CONSTRAINT contract_events_event_signature_check CHECK (length(event_signature) = 32),
+    transaction_hash bytea NOT NULL CONSTRAINT contract_events_transaction_hash_check CHECK (length(transaction_hash) = 32),
+    block_number bigint NOT NULL,
+    block_hash bytea NOT NULL CONSTRAINT contract_events_block_hash_check CHECK (length(block_hash) = 32),
+    block_time TIMESTAMPTZ NOT NULL,
+    arguments jsonb NOT NULL,
+    token_id bytea CONSTRAINT contract_events_token_id_check CHECK (length(token_id) = 32),
+    -- Not every producer supplies the log index. Events without one sort first in their block,
+    -- in the order they were processed.
+    log_index int
+);
+
+CREATE INDEX contract_events_contract_event_name_idx ON contract_events (contract, event_name);
+CREATE INDEX contract_events_transaction_hash_idx ON contract_events (transaction_hash);
+CREATE INDEX contract_events_block_number_idx ON contract_events (block_number, log_index, id);
+CREATE INDEX contract_events_token_id_idx ON contract_events (token_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE contract_events;
+-- +goose StatementEnd
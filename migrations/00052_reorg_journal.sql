@@ -0,0 +1,153 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE processed_blocks (
+    number bigint CONSTRAINT processed_blocks_pkey PRIMARY KEY,
+    hash bytea NOT NULL CONSTRAINT processed_blocks_hash_check CHECK (length(hash) = 32)
+);
+
+-- Holds the block whose events the consumer is currently applying. The triggers below only do
+-- anything while this row exists.
+CREATE TABLE current_block (
+    singleton boolean DEFAULT TRUE CONSTRAINT current_block_pkey PRIMARY KEY CONSTRAINT current_block_singleton_check CHECK (singleton),
+    number bigint NOT NULL,
+    hash bytea NOT NULL CONSTRAINT current_block_hash_check CHECK (length(hash) = 32)
+);
+
+CREATE TABLE block_journal (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT block_journal_pkey PRIMARY KEY,
+    block_number bigint NOT NULL,
+    table_name text NOT NULL,
+    operation text NOT NULL,
+    old_row jsonb,
+    new_row jsonb
+);
+
+CREATE INDEX block_journal_block_number_idx ON block_journal (block_number);
+
+CREATE FUNCTION stamp_block_hash() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    h bytea;
+BEGIN
+    SELECT hash INTO h FROM identity_api.current_block;
+    IF FOUND THEN
+        NEW.last_block_hash := h;
+    END IF;
+    RETURN NEW;
+END
+$$;
+
+CREATE FUNCTION journal_row_change() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    n bigint;
+BEGIN
+    SELECT number INTO n FROM identity_api.current_block;
+    IF FOUND THEN
+        INSERT INTO identity_api.block_journal (block_number, table_name, operation, old_row, new_row)
+        VALUES (
+            n,
+            TG_TABLE_NAME,
+            TG_OP,
+            CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END,
+            CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END
+        );
+    END IF;
+    RETURN NULL;
+END
+$$;
+
+-- Undoes every journaled write from the given block onward, newest first.
+--
+-- The journal trigger sorts after the "RI_ConstraintTrigger" triggers that implement cascades,
+-- so a cascaded child delete is journaled before its parent and gets restored after it.
+CREATE FUNCTION revert_blocks(from_block bigint) RETURNS void LANGUAGE plpgsql AS $$
+DECLARE
+    j record;
+    rel regclass;
+    cols text;
+    pk_cond text;
+BEGIN
+    DELETE FROM identity_api.current_block;
+
+    FOR j IN SELECT * FROM identity_api.block_journal WHERE block_number >= from_block ORDER BY id DESC LOOP
+        rel := format('identity_api.%I', j.table_name)::regclass;
+
+        SELECT string_agg(format('t.%1$I = r.%1$I', a.attname), ' AND ')
+        INTO pk_cond
+        FROM pg_index i
+        JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+        WHERE i.indrelid = rel AND i.indisprimary;
+
+        CASE j.operation
+        WHEN 'INSERT' THEN
+            EXECUTE format('DELETE FROM %1$s t USING jsonb_populate_record(NULL::%1$s, $1) r WHERE %2$s', rel, pk_cond)
+            USING j.new_row;
+        WHEN 'DELETE' THEN
+            EXECUTE format('INSERT INTO %1$s SELECT * FROM jsonb_populate_record(NULL::%1$s, $1)', rel)
+            USING j.old_row;
+        WHEN 'UPDATE' THEN
+            SELECT string_agg(quote_ident(attname), ', ' ORDER BY attnum)
+            INTO cols
+            FROM pg_attribute
+            WHERE attrelid = rel AND attnum > 0 AND NOT attisdropped;
+
+            EXECUTE format('UPDATE %1$s t SET (%2$s) = (SELECT %2$s FROM jsonb_populate_record(NULL::%1$s, $1)) FROM jsonb_populate_record(NULL::%1$s, $2) r WHERE %3$s', rel, cols, pk_cond)
+            USING j.old_row, j.new_row;
+        END CASE;
+    END LOOP;
+
+    DELETE FROM identity_api.block_journal WHERE block_number >= from_block;
+    DELETE FROM identity_api.processed_blocks WHERE number >= from_block;
+END
+$$;
+
+DO $$
+DECLARE
+    t text;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'account_sacds', 'aftermarket_devices', 'connection_sacds', 'connections', 'dcns', 'developer_licenses',
+        'manufacturers', 'privileges', 'redirect_uris', 'rewards', 'signers', 'stakes', 'storage_nodes',
+        'synthetic_devices', 'templates', 'vehicle_sacds', 'vehicles'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %1$I ADD COLUMN last_block_hash bytea CONSTRAINT %2$I CHECK (length(last_block_hash) = 32)', t, t || '_last_block_hash_check');
+        EXECUTE format('CREATE TRIGGER stamp_block_hash BEFORE INSERT OR UPDATE ON %I FOR EACH ROW EXECUTE FUNCTION stamp_block_hash()', t);
+        EXECUTE format('CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON %I FOR EACH ROW EXECUTE FUNCTION journal_row_change()', t);
+    END LOOP;
+END
+$$;
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON vehicle_transfers FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+
+-- Ledger entries from an abandoned fork are reverted along with the state they produced.
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON contract_events FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER journal_row_change ON contract_events;
+DROP TRIGGER journal_row_change ON vehicle_transfers;
+
+DO $$
+DECLARE
+    t text;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'account_sacds', 'aftermarket_devices', 'connection_sacds', 'connections', 'dcns', 'developer_licenses',
+        'manufacturers', 'privileges', 'redirect_uris', 'rewards', 'signers', 'stakes', 'storage_nodes',
+        'synthetic_devices', 'templates', 'vehicle_sacds', 'vehicles'
+    ] LOOP
+        EXECUTE format('DROP TRIGGER journal_row_change ON %I', t);
+        EXECUTE format('DROP TRIGGER stamp_block_hash ON %I', t);
+        EXECUTE format('ALTER TABLE %I DROP COLUMN last_block_hash', t);
+    END LOOP;
+END
+$$;
+
+DROP FUNCTION revert_blocks;
+DROP FUNCTION journal_row_change;
+DROP FUNCTION stamp_block_hash;
+
+DROP TABLE block_journal;
+DROP TABLE current_block;
+DROP TABLE processed_blocks;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE dead_letters (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT dead_letters_pkey PRIMARY KEY,
+    cloud_event_id text NOT NULL CONSTRAINT dead_letters_cloud_event_id_key UNIQUE,
+    -- The entity the event writes to, as given by EntityKey. Empty if there isn't one.
+    entity_key text NOT NULL,
+    event jsonb NOT NULL,
+    error text NOT NULL,
+    attempts int NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    last_attempted_at TIMESTAMPTZ NOT NULL,
+    -- Null once automatic retries are exhausted.
+    next_attempt_at TIMESTAMPTZ
+);
+
+CREATE INDEX dead_letters_next_attempt_at_idx ON dead_letters (next_attempt_at);
+CREATE INDEX dead_letters_entity_key_idx ON dead_letters (entity_key, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dead_letters;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE indexer_checkpoints (
+    chain_id bigint CONSTRAINT indexer_checkpoints_pkey PRIMARY KEY,
+    -- Last block whose logs have all been processed.
+    block_number bigint NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE indexer_checkpoints;
+-- +goose StatementEnd
@@ -0,0 +1,159 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The block being applied now comes from transaction-local settings instead of the current_block
+-- table, so that concurrent transactions don't see each other's block.
+CREATE OR REPLACE FUNCTION stamp_block_hash() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    h text := nullif(current_setting('identity_api.block_hash', true), '');
+BEGIN
+    IF h IS NOT NULL THEN
+        NEW.last_block_hash := decode(h, 'hex');
+    END IF;
+    RETURN NEW;
+END
+$$;
+
+CREATE OR REPLACE FUNCTION journal_row_change() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    n text := nullif(current_setting('identity_api.block_number', true), '');
+BEGIN
+    IF n IS NOT NULL THEN
+        INSERT INTO identity_api.block_journal (block_number, table_name, operation, old_row, new_row)
+        VALUES (
+            n::bigint,
+            TG_TABLE_NAME,
+            TG_OP,
+            CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END,
+            CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END
+        );
+    END IF;
+    RETURN NULL;
+END
+$$;
+
+-- Same as before, minus clearing current_block. The reverted writes mustn't be journaled again,
+-- so the settings are cleared for the duration of the revert.
+CREATE OR REPLACE FUNCTION revert_blocks(from_block bigint) RETURNS void LANGUAGE plpgsql AS $$
+DECLARE
+    j record;
+    rel regclass;
+    cols text;
+    pk_cond text;
+BEGIN
+    PERFORM set_config('identity_api.block_number', '', true), set_config('identity_api.block_hash', '', true);
+
+    FOR j IN SELECT * FROM identity_api.block_journal WHERE block_number >= from_block ORDER BY id DESC LOOP
+        rel := format('identity_api.%I', j.table_name)::regclass;
+
+        SELECT string_agg(format('t.%1$I = r.%1$I', a.attname), ' AND ')
+        INTO pk_cond
+        FROM pg_index i
+        JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+        WHERE i.indrelid = rel AND i.indisprimary;
+
+        CASE j.operation
+        WHEN 'INSERT' THEN
+            EXECUTE format('DELETE FROM %1$s t USING jsonb_populate_record(NULL::%1$s, $1) r WHERE %2$s', rel, pk_cond)
+            USING j.new_row;
+        WHEN 'DELETE' THEN
+            EXECUTE format('INSERT INTO %1$s SELECT * FROM jsonb_populate_record(NULL::%1$s, $1)', rel)
+            USING j.old_row;
+        WHEN 'UPDATE' THEN
+            SELECT string_agg(quote_ident(attname), ', ' ORDER BY attnum)
+            INTO cols
+            FROM pg_attribute
+            WHERE attrelid = rel AND attnum > 0 AND NOT attisdropped;
+
+            EXECUTE format('UPDATE %1$s t SET (%2$s) = (SELECT %2$s FROM jsonb_populate_record(NULL::%1$s, $1)) FROM jsonb_populate_record(NULL::%1$s, $2) r WHERE %3$s', rel, cols, pk_cond)
+            USING j.old_row, j.new_row;
+        END CASE;
+    END LOOP;
+
+    DELETE FROM identity_api.block_journal WHERE block_number >= from_block;
+    DELETE FROM identity_api.processed_blocks WHERE number >= from_block;
+END
+$$;
+
+DROP TABLE current_block;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE current_block (
+    singleton boolean DEFAULT TRUE CONSTRAINT current_block_pkey PRIMARY KEY CONSTRAINT current_block_singleton_check CHECK (singleton),
+    number bigint NOT NULL,
+    hash bytea NOT NULL CONSTRAINT current_block_hash_check CHECK (length(hash) = 32)
+);
+
+CREATE OR REPLACE FUNCTION stamp_block_hash() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    h bytea;
+BEGIN
+    SELECT hash INTO h FROM identity_api.current_block;
+    IF FOUND THEN
+        NEW.last_block_hash := h;
+    END IF;
+    RETURN NEW;
+END
+$$;
+
+CREATE OR REPLACE FUNCTION journal_row_change() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    n bigint;
+BEGIN
+    SELECT number INTO n FROM identity_api.current_block;
+    IF FOUND THEN
+        INSERT INTO identity_api.block_journal (block_number, table_name, operation, old_row, new_row)
+        VALUES (
+            n,
+            TG_TABLE_NAME,
+            TG_OP,
+            CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END,
+            CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END
+        );
+    END IF;
+    RETURN NULL;
+END
+$$;
+
+CREATE OR REPLACE FUNCTION revert_blocks(from_block bigint) RETURNS void LANGUAGE plpgsql AS $$
+DECLARE
+    j record;
+    rel regclass;
+    cols text;
+    pk_cond text;
+BEGIN
+    DELETE FROM identity_api.current_block;
+
+    FOR j IN SELECT * FROM identity_api.block_journal WHERE block_number >= from_block ORDER BY id DESC LOOP
+        rel := format('identity_api.%I', j.table_name)::regclass;
+
+        SELECT string_agg(format('t.%1$I = r.%1$I', a.attname), ' AND ')
+        INTO pk_cond
+        FROM pg_index i
+        JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+        WHERE i.indrelid = rel AND i.indisprimary;
+
+        CASE j.operation
+        WHEN 'INSERT' THEN
+            EXECUTE format('DELETE FROM %1$s t USING jsonb_populate_record(NULL::%1$s, $1) r WHERE %2$s', rel, pk_cond)
+            USING j.new_row;
+        WHEN 'DELETE' THEN
+            EXECUTE format('INSERT INTO %1$s SELECT * FROM jsonb_populate_record(NULL::%1$s, $1)', rel)
+            USING j.old_row;
+        WHEN 'UPDATE' THEN
+            SELECT string_agg(quote_ident(attname), ', ' ORDER BY attnum)
+            INTO cols
+            FROM pg_attribute
+            WHERE attrelid = rel AND attnum > 0 AND NOT attisdropped;
+
+            EXECUTE format('UPDATE %1$s t SET (%2$s) = (SELECT %2$s FROM jsonb_populate_record(NULL::%1$s, $1)) FROM jsonb_populate_record(NULL::%1$s, $2) r WHERE %3$s', rel, cols, pk_cond)
+            USING j.old_row, j.new_row;
+        END CASE;
+    END LOOP;
+
+    DELETE FROM identity_api.block_journal WHERE block_number >= from_block;
+    DELETE FROM identity_api.processed_blocks WHERE number >= from_block;
+END
+$$;
+-- +goose StatementEnd
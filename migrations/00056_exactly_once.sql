@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per applied contract event, keyed by the log it came from, so that a log is applied
+-- once however many times, and under whatever cloud event id, it's delivered. Events from the
+-- Kafka producer carry no log index; for those the cloud event id is the key. Rows are journaled
+-- like entity writes, so reverting a block also forgets that its events were applied.
+CREATE TABLE processed_events (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT processed_events_pkey PRIMARY KEY,
+    cloud_event_id text NOT NULL,
+    transaction_hash bytea NOT NULL CONSTRAINT processed_events_transaction_hash_check CHECK (length(transaction_hash) = 32),
+    log_index int,
+    block_number bigint NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT processed_events_log_key UNIQUE (transaction_hash, log_index)
+);
+
+CREATE UNIQUE INDEX processed_events_cloud_event_id_idx ON processed_events (cloud_event_id) WHERE log_index IS NULL;
+CREATE INDEX processed_events_block_number_idx ON processed_events (block_number);
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON processed_events FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+
+-- Last Kafka offset applied for each partition, written in the same transaction as the writes
+-- it caused.
+CREATE TABLE consumer_offsets (
+    topic text,
+    partition int,
+    last_offset bigint NOT NULL,
+    CONSTRAINT consumer_offsets_pkey PRIMARY KEY (topic, partition)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE consumer_offsets;
+DROP TABLE processed_events;
+-- +goose StatementEnd
@@ -0,0 +1,149 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Stamps rows with the position of the event that last wrote them, and drops writes from events
+-- that come before that position. Within a block, events without a log index are applied in
+-- arrival order. Cascaded writes are let through, since cancelling them breaks the foreign key.
+CREATE FUNCTION stamp_watermark() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+    b bigint := nullif(current_setting('identity_api.event_block', true), '')::bigint;
+    i int := nullif(current_setting('identity_api.event_log_index', true), '')::int;
+BEGIN
+    IF b IS NULL OR pg_trigger_depth() > 1 THEN
+        IF TG_OP = 'DELETE' THEN
+            RETURN OLD;
+        END IF;
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP <> 'INSERT' AND (OLD.last_block_number > b OR (OLD.last_block_number = b AND OLD.last_log_index > i)) THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+
+    NEW.last_block_number := b;
+    NEW.last_log_index := i;
+    RETURN NEW;
+END
+$$;
+
+-- Same as before, but also clears the event position, so that the restored rows keep their
+-- journaled watermarks.
+CREATE OR REPLACE FUNCTION revert_blocks(from_block bigint) RETURNS void LANGUAGE plpgsql AS $$
+DECLARE
+    j record;
+    rel regclass;
+    cols text;
+    pk_cond text;
+BEGIN
+    PERFORM set_config('identity_api.block_number', '', true), set_config('identity_api.block_hash', '', true),
+        set_config('identity_api.event_block', '', true), set_config('identity_api.event_log_index', '', true);
+
+    FOR j IN SELECT * FROM identity_api.block_journal WHERE block_number >= from_block ORDER BY id DESC LOOP
+        rel := format('identity_api.%I', j.table_name)::regclass;
+
+        SELECT string_agg(format('t.%1$I = r.%1$I', a.attname), ' AND ')
+        INTO pk_cond
+        FROM pg_index i
+        JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+        WHERE i.indrelid = rel AND i.indisprimary;
+
+        CASE j.operation
+        WHEN 'INSERT' THEN
+            EXECUTE format('DELETE FROM %1$s t USING jsonb_populate_record(NULL::%1$s, $1) r WHERE %2$s', rel, pk_cond)
+            USING j.new_row;
+        WHEN 'DELETE' THEN
+            EXECUTE format('INSERT INTO %1$s SELECT * FROM jsonb_populate_record(NULL::%1$s, $1)', rel)
+            USING j.old_row;
+        WHEN 'UPDATE' THEN
+            SELECT string_agg(quote_ident(attname), ', ' ORDER BY attnum)
+            INTO cols
+            FROM pg_attribute
+            WHERE attrelid = rel AND attnum > 0 AND NOT attisdropped;
+
+            EXECUTE format('UPDATE %1$s t SET (%2$s) = (SELECT %2$s FROM jsonb_populate_record(NULL::%1$s, $1)) FROM jsonb_populate_record(NULL::%1$s, $2) r WHERE %3$s', rel, cols, pk_cond)
+            USING j.old_row, j.new_row;
+        END CASE;
+    END LOOP;
+
+    DELETE FROM identity_api.block_journal WHERE block_number >= from_block;
+    DELETE FROM identity_api.processed_blocks WHERE number >= from_block;
+END
+$$;
+
+DO $$
+DECLARE
+    t text;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'account_sacds', 'aftermarket_devices', 'connection_sacds', 'dcns', 'privileges', 'stakes', 'vehicle_sacds',
+        'vehicles'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN last_block_number bigint, ADD COLUMN last_log_index int', t);
+        EXECUTE format('CREATE TRIGGER stamp_watermark BEFORE INSERT OR UPDATE OR DELETE ON %I FOR EACH ROW EXECUTE FUNCTION stamp_watermark()', t);
+    END LOOP;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DO $$
+DECLARE
+    t text;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'account_sacds', 'aftermarket_devices', 'connection_sacds', 'dcns', 'privileges', 'stakes', 'vehicle_sacds',
+        'vehicles'
+    ] LOOP
+        EXECUTE format('DROP TRIGGER stamp_watermark ON %I', t);
+        EXECUTE format('ALTER TABLE %I DROP COLUMN last_block_number, DROP COLUMN last_log_index', t);
+    END LOOP;
+END
+$$;
+
+CREATE OR REPLACE FUNCTION revert_blocks(from_block bigint) RETURNS void LANGUAGE plpgsql AS $$
+DECLARE
+    j record;
+    rel regclass;
+    cols text;
+    pk_cond text;
+BEGIN
+    PERFORM set_config('identity_api.block_number', '', true), set_config('identity_api.block_hash', '', true);
+
+    FOR j IN SELECT * FROM identity_api.block_journal WHERE block_number >= from_block ORDER BY id DESC LOOP
+        rel := format('identity_api.%I', j.table_name)::regclass;
+
+        SELECT string_agg(format('t.%1$I = r.%1$I', a.attname), ' AND ')
+        INTO pk_cond
+        FROM pg_index i
+        JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+        WHERE i.indrelid = rel AND i.indisprimary;
+
+        CASE j.operation
+        WHEN 'INSERT' THEN
+            EXECUTE format('DELETE FROM %1$s t USING jsonb_populate_record(NULL::%1$s, $1) r WHERE %2$s', rel, pk_cond)
+            USING j.new_row;
+        WHEN 'DELETE' THEN
+            EXECUTE format('INSERT INTO %1$s SELECT * FROM jsonb_populate_record(NULL::%1$s, $1)', rel)
+            USING j.old_row;
+        WHEN 'UPDATE' THEN
+            SELECT string_agg(quote_ident(attname), ', ' ORDER BY attnum)
+            INTO cols
+            FROM pg_attribute
+            WHERE attrelid = rel AND attnum > 0 AND NOT attisdropped;
+
+            EXECUTE format('UPDATE %1$s t SET (%2$s) = (SELECT %2$s FROM jsonb_populate_record(NULL::%1$s, $1)) FROM jsonb_populate_record(NULL::%1$s, $2) r WHERE %3$s', rel, cols, pk_cond)
+            USING j.old_row, j.new_row;
+        END CASE;
+    END LOOP;
+
+    DELETE FROM identity_api.block_journal WHERE block_number >= from_block;
+    DELETE FROM identity_api.processed_blocks WHERE number >= from_block;
+END
+$$;
+
+DROP FUNCTION stamp_watermark;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Transfers are told apart and ordered as in vehicle_transfers.
+CREATE TABLE dcn_transfers (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT dcn_transfers_pkey PRIMARY KEY,
+    node bytea NOT NULL CONSTRAINT dcn_transfers_node_check CHECK (length(node) = 32),
+    from_address bytea NOT NULL CONSTRAINT dcn_transfers_from_address_check CHECK (length(from_address) = 20),
+    to_address bytea NOT NULL CONSTRAINT dcn_transfers_to_address_check CHECK (length(to_address) = 20),
+    block_number bigint NOT NULL,
+    block_time TIMESTAMPTZ NOT NULL,
+    transaction_hash bytea NOT NULL CONSTRAINT dcn_transfers_transaction_hash_check CHECK (length(transaction_hash) = 32),
+    log_index int,
+
+    CONSTRAINT dcn_transfers_log_key UNIQUE (node, transaction_hash, log_index)
+);
+
+CREATE INDEX dcn_transfers_node_idx ON dcn_transfers (node, block_number);
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON dcn_transfers FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dcn_transfers;
+-- +goose StatementEnd
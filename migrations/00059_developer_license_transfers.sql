@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Transfers are told apart and ordered as in vehicle_transfers.
+CREATE TABLE developer_license_transfers (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT developer_license_transfers_pkey PRIMARY KEY,
+    developer_license_id int NOT NULL,
+    from_address bytea NOT NULL CONSTRAINT developer_license_transfers_from_address_check CHECK (length(from_address) = 20),
+    to_address bytea NOT NULL CONSTRAINT developer_license_transfers_to_address_check CHECK (length(to_address) = 20),
+    block_number bigint NOT NULL,
+    block_time TIMESTAMPTZ NOT NULL,
+    transaction_hash bytea NOT NULL CONSTRAINT developer_license_transfers_transaction_hash_check CHECK (length(transaction_hash) = 32),
+    log_index int,
+
+    CONSTRAINT developer_license_transfers_log_key UNIQUE (developer_license_id, transaction_hash, log_index)
+);
+
+CREATE INDEX developer_license_transfers_developer_license_id_idx ON developer_license_transfers (developer_license_id, block_number);
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON developer_license_transfers FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+
+-- Burned licenses keep their row, like burned vehicles, so that their signer and redirect URI
+-- history survives.
+ALTER TABLE developer_licenses ADD COLUMN burned_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM developer_licenses WHERE burned_at IS NOT NULL;
+
+ALTER TABLE developer_licenses DROP COLUMN burned_at;
+
+DROP TABLE developer_license_transfers;
+-- +goose StatementEnd
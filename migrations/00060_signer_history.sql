@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A signer or redirect URI can be enabled again after it's disabled, so each row is now one
+-- enabled interval. At most one interval per value may be open.
+ALTER TABLE signers ADD COLUMN disabled_at timestamptz;
+ALTER TABLE signers DROP CONSTRAINT signers_pkey;
+ALTER TABLE signers ADD CONSTRAINT signers_pkey PRIMARY KEY (developer_license_id, signer, enabled_at);
+CREATE UNIQUE INDEX signers_active_idx ON signers (developer_license_id, signer) WHERE disabled_at IS NULL;
+
+ALTER TABLE redirect_uris ADD COLUMN disabled_at timestamptz;
+ALTER TABLE redirect_uris DROP CONSTRAINT redirect_uris_pkey;
+ALTER TABLE redirect_uris ADD CONSTRAINT redirect_uris_pkey PRIMARY KEY (developer_license_id, uri, enabled_at);
+CREATE UNIQUE INDEX redirect_uris_active_idx ON redirect_uris (developer_license_id, uri) WHERE disabled_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM redirect_uris WHERE disabled_at IS NOT NULL;
+DROP INDEX redirect_uris_active_idx;
+ALTER TABLE redirect_uris DROP CONSTRAINT redirect_uris_pkey;
+ALTER TABLE redirect_uris ADD CONSTRAINT redirect_uris_pkey PRIMARY KEY (developer_license_id, uri);
+ALTER TABLE redirect_uris DROP COLUMN disabled_at;
+
+DELETE FROM signers WHERE disabled_at IS NOT NULL;
+DROP INDEX signers_active_idx;
+ALTER TABLE signers DROP CONSTRAINT signers_pkey;
+ALTER TABLE signers ADD CONSTRAINT signers_pkey PRIMARY KEY (developer_license_id, signer);
+ALTER TABLE signers DROP COLUMN disabled_at;
+-- +goose StatementEnd
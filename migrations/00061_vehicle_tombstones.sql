@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Burned vehicles keep their row, with owner_address left as the last owner, so that rewards
+-- and stakes that point at them still resolve.
+ALTER TABLE vehicles
+    ADD COLUMN burned_at timestamptz,
+    ADD COLUMN burn_transaction_hash bytea CONSTRAINT vehicles_burn_transaction_hash_check CHECK (length(burn_transaction_hash) = 32);
+
+CREATE INDEX vehicles_burned_at_idx ON vehicles (burned_at) WHERE burned_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM vehicles WHERE burned_at IS NOT NULL;
+
+ALTER TABLE vehicles
+    DROP COLUMN burn_transaction_hash,
+    DROP COLUMN burned_at;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per claim, unclaim, pair, unpair or address reset of an aftermarket device. Like the
+-- transfer tables there are no foreign keys, so the history outlives the device and vehicle. The
+-- id follows processing order, which breaks ties within a block.
+CREATE TABLE aftermarket_device_history (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT aftermarket_device_history_pkey PRIMARY KEY,
+    aftermarket_device_id int NOT NULL,
+    event_name text NOT NULL CONSTRAINT aftermarket_device_history_event_name_check CHECK (event_name IN (
+        'AftermarketDeviceClaimed', 'AftermarketDeviceUnclaimed', 'AftermarketDevicePaired',
+        'AftermarketDeviceUnpaired', 'AftermarketDeviceAddressReset'
+    )),
+    vehicle_id int,
+    owner bytea CONSTRAINT aftermarket_device_history_owner_check CHECK (length(owner) = 20),
+    address bytea CONSTRAINT aftermarket_device_history_address_check CHECK (length(address) = 20),
+    block_number bigint NOT NULL,
+    block_time timestamptz NOT NULL,
+    transaction_hash bytea NOT NULL CONSTRAINT aftermarket_device_history_transaction_hash_check CHECK (length(transaction_hash) = 32),
+
+    CONSTRAINT aftermarket_device_history_event_key UNIQUE (aftermarket_device_id, block_number, transaction_hash, event_name)
+);
+
+CREATE INDEX aftermarket_device_history_vehicle_id_idx ON aftermarket_device_history (vehicle_id, block_number) WHERE vehicle_id IS NOT NULL;
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON aftermarket_device_history FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE aftermarket_device_history;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per change to the beneficiary of an aftermarket device. Minting and transferring a
+-- device also reset the beneficiary to the owner, so they get rows too, and the latest row at
+-- or before a given time gives the beneficiary in effect then.
+CREATE TABLE aftermarket_device_beneficiaries (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT aftermarket_device_beneficiaries_pkey PRIMARY KEY,
+    aftermarket_device_id int NOT NULL,
+    event_name text NOT NULL CONSTRAINT aftermarket_device_beneficiaries_event_name_check CHECK (event_name IN (
+        'AftermarketDeviceNodeMinted', 'BeneficiarySet', 'Transfer'
+    )),
+    beneficiary bytea NOT NULL CONSTRAINT aftermarket_device_beneficiaries_beneficiary_check CHECK (length(beneficiary) = 20),
+    explicit boolean NOT NULL,
+    block_number bigint NOT NULL,
+    block_time timestamptz NOT NULL,
+    transaction_hash bytea NOT NULL CONSTRAINT aftermarket_device_beneficiaries_transaction_hash_check CHECK (length(transaction_hash) = 32),
+
+    CONSTRAINT aftermarket_device_beneficiaries_event_key UNIQUE (aftermarket_device_id, block_number, transaction_hash, event_name)
+);
+
+CREATE INDEX aftermarket_device_beneficiaries_block_time_idx ON aftermarket_device_beneficiaries (aftermarket_device_id, block_time);
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON aftermarket_device_beneficiaries FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE aftermarket_device_beneficiaries;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every change to a vehicle, account or connection SACD, or to a legacy vehicle privilege. Rows
+-- are only ever added, so the history survives renouncements and the wipes done on transfer.
+--
+-- A grant that lapses isn't an event, so its expiry is written when the grant is next replaced
+-- or removed, with changed_at set to the moment it lapsed and no block.
+CREATE TABLE sacd_history (
+    id bigint GENERATED BY DEFAULT AS IDENTITY CONSTRAINT sacd_history_pkey PRIMARY KEY,
+    vehicle_id int,
+    account bytea CONSTRAINT sacd_history_account_check CHECK (length(account) = 20),
+    connection_id bytea CONSTRAINT sacd_history_connection_id_check CHECK (length(connection_id) = 32),
+    privilege_id int,
+    grantee bytea NOT NULL CONSTRAINT sacd_history_grantee_check CHECK (length(grantee) = 20),
+    cause text NOT NULL CONSTRAINT sacd_history_cause_check CHECK (cause IN ('set', 'renounced', 'transfer', 'expired')),
+    permissions text,
+    source text,
+    template_id bytea,
+    expires_at timestamptz NOT NULL,
+    changed_at timestamptz NOT NULL,
+    block_number bigint,
+    transaction_hash bytea CONSTRAINT sacd_history_transaction_hash_check CHECK (length(transaction_hash) = 32),
+
+    CONSTRAINT sacd_history_asset_check CHECK (num_nonnulls(vehicle_id, account, connection_id) = 1),
+    CONSTRAINT sacd_history_privilege_check CHECK (privilege_id IS NULL OR vehicle_id IS NOT NULL),
+    CONSTRAINT sacd_history_block_check CHECK ((cause = 'expired') = (block_number IS NULL))
+);
+
+CREATE INDEX sacd_history_vehicle_id_idx ON sacd_history (vehicle_id, changed_at) WHERE vehicle_id IS NOT NULL;
+CREATE INDEX sacd_history_account_idx ON sacd_history (account, changed_at) WHERE account IS NOT NULL;
+CREATE INDEX sacd_history_connection_id_idx ON sacd_history (connection_id, changed_at) WHERE connection_id IS NOT NULL;
+
+CREATE TRIGGER journal_row_change AFTER INSERT OR UPDATE OR DELETE ON sacd_history FOR EACH ROW EXECUTE FUNCTION journal_row_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sacd_history;
+-- +goose StatementEnd
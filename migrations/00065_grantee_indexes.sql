@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX account_sacds_grantee_idx ON account_sacds (grantee);
+CREATE INDEX connection_sacds_grantee_idx ON connection_sacds (grantee);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX connection_sacds_grantee_idx;
+DROP INDEX account_sacds_grantee_idx;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Burned names keep their row, with owner_address left as the last owner, like burned vehicles.
+ALTER TABLE dcns
+    ADD COLUMN burned_at timestamptz,
+    ADD COLUMN burn_transaction_hash bytea CONSTRAINT dcns_burn_transaction_hash_check CHECK (length(burn_transaction_hash) = 32);
+
+CREATE INDEX dcns_burned_at_idx ON dcns (burned_at) WHERE burned_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM dcns WHERE burned_at IS NOT NULL;
+
+ALTER TABLE dcns
+    DROP COLUMN burn_transaction_hash,
+    DROP COLUMN burned_at;
+-- +goose StatementEnd
@@ -24,52 +24,67 @@ import (
 
 // AccountSacd is an object representing the database table.
 type AccountSacd struct {
-	Account     []byte     `boil:"account" json:"account" toml:"account" yaml:"account"`
-	Grantee     []byte     `boil:"grantee" json:"grantee" toml:"grantee" yaml:"grantee"`
-	Permissions string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
-	Source      string     `boil:"source" json:"source" toml:"source" yaml:"source"`
-	TemplateID  null.Bytes `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
-	CreatedAt   time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	ExpiresAt   time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	Account         []byte     `boil:"account" json:"account" toml:"account" yaml:"account"`
+	Grantee         []byte     `boil:"grantee" json:"grantee" toml:"grantee" yaml:"grantee"`
+	Permissions     string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
+	Source          string     `boil:"source" json:"source" toml:"source" yaml:"source"`
+	TemplateID      null.Bytes `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt       time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	LastBlockHash   null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber null.Int64 `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex    null.Int   `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
 
 	R *accountSacdR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accountSacdL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccountSacdColumns = struct {
-	Account     string
-	Grantee     string
-	Permissions string
-	Source      string
-	TemplateID  string
-	CreatedAt   string
-	ExpiresAt   string
+	Account         string
+	Grantee         string
+	Permissions     string
+	Source          string
+	TemplateID      string
+	CreatedAt       string
+	ExpiresAt       string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	Account:     "account",
-	Grantee:     "grantee",
-	Permissions: "permissions",
-	Source:      "source",
-	TemplateID:  "template_id",
-	CreatedAt:   "created_at",
-	ExpiresAt:   "expires_at",
+	Account:         "account",
+	Grantee:         "grantee",
+	Permissions:     "permissions",
+	Source:          "source",
+	TemplateID:      "template_id",
+	CreatedAt:       "created_at",
+	ExpiresAt:       "expires_at",
+	LastBlockHash:   "last_block_hash",
+	LastBlockNumber: "last_block_number",
+	LastLogIndex:    "last_log_index",
 }
 
 var AccountSacdTableColumns = struct {
-	Account     string
-	Grantee     string
-	Permissions string
-	Source      string
-	TemplateID  string
-	CreatedAt   string
-	ExpiresAt   string
+	Account         string
+	Grantee         string
+	Permissions     string
+	Source          string
+	TemplateID      string
+	CreatedAt       string
+	ExpiresAt       string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	Account:     "account_sacds.account",
-	Grantee:     "account_sacds.grantee",
-	Permissions: "account_sacds.permissions",
-	Source:      "account_sacds.source",
-	TemplateID:  "account_sacds.template_id",
-	CreatedAt:   "account_sacds.created_at",
-	ExpiresAt:   "account_sacds.expires_at",
+	Account:         "account_sacds.account",
+	Grantee:         "account_sacds.grantee",
+	Permissions:     "account_sacds.permissions",
+	Source:          "account_sacds.source",
+	TemplateID:      "account_sacds.template_id",
+	CreatedAt:       "account_sacds.created_at",
+	ExpiresAt:       "account_sacds.expires_at",
+	LastBlockHash:   "account_sacds.last_block_hash",
+	LastBlockNumber: "account_sacds.last_block_number",
+	LastLogIndex:    "account_sacds.last_log_index",
 }
 
 // Generated where
@@ -159,22 +174,104 @@ func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var AccountSacdWhere = struct {
-	Account     whereHelper__byte
-	Grantee     whereHelper__byte
-	Permissions whereHelperstring
-	Source      whereHelperstring
-	TemplateID  whereHelpernull_Bytes
-	CreatedAt   whereHelpertime_Time
-	ExpiresAt   whereHelpertime_Time
+	Account         whereHelper__byte
+	Grantee         whereHelper__byte
+	Permissions     whereHelperstring
+	Source          whereHelperstring
+	TemplateID      whereHelpernull_Bytes
+	CreatedAt       whereHelpertime_Time
+	ExpiresAt       whereHelpertime_Time
+	LastBlockHash   whereHelpernull_Bytes
+	LastBlockNumber whereHelpernull_Int64
+	LastLogIndex    whereHelpernull_Int
 }{
-	Account:     whereHelper__byte{field: "\"identity_api\".\"account_sacds\".\"account\""},
-	Grantee:     whereHelper__byte{field: "\"identity_api\".\"account_sacds\".\"grantee\""},
-	Permissions: whereHelperstring{field: "\"identity_api\".\"account_sacds\".\"permissions\""},
-	Source:      whereHelperstring{field: "\"identity_api\".\"account_sacds\".\"source\""},
-	TemplateID:  whereHelpernull_Bytes{field: "\"identity_api\".\"account_sacds\".\"template_id\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"identity_api\".\"account_sacds\".\"created_at\""},
-	ExpiresAt:   whereHelpertime_Time{field: "\"identity_api\".\"account_sacds\".\"expires_at\""},
+	Account:         whereHelper__byte{field: "\"identity_api\".\"account_sacds\".\"account\""},
+	Grantee:         whereHelper__byte{field: "\"identity_api\".\"account_sacds\".\"grantee\""},
+	Permissions:     whereHelperstring{field: "\"identity_api\".\"account_sacds\".\"permissions\""},
+	Source:          whereHelperstring{field: "\"identity_api\".\"account_sacds\".\"source\""},
+	TemplateID:      whereHelpernull_Bytes{field: "\"identity_api\".\"account_sacds\".\"template_id\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"identity_api\".\"account_sacds\".\"created_at\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"identity_api\".\"account_sacds\".\"expires_at\""},
+	LastBlockHash:   whereHelpernull_Bytes{field: "\"identity_api\".\"account_sacds\".\"last_block_hash\""},
+	LastBlockNumber: whereHelpernull_Int64{field: "\"identity_api\".\"account_sacds\".\"last_block_number\""},
+	LastLogIndex:    whereHelpernull_Int{field: "\"identity_api\".\"account_sacds\".\"last_log_index\""},
 }
 
 // AccountSacdRels is where relationship names are stored.
@@ -194,9 +291,9 @@ func (*accountSacdR) NewStruct() *accountSacdR {
 type accountSacdL struct{}
 
 var (
-	accountSacdAllColumns            = []string{"account", "grantee", "permissions", "source", "template_id", "created_at", "expires_at"}
+	accountSacdAllColumns            = []string{"account", "grantee", "permissions", "source", "template_id", "created_at", "expires_at", "last_block_hash", "last_block_number", "last_log_index"}
 	accountSacdColumnsWithoutDefault = []string{"account", "grantee", "permissions", "source", "created_at", "expires_at"}
-	accountSacdColumnsWithDefault    = []string{"template_id"}
+	accountSacdColumnsWithDefault    = []string{"template_id", "last_block_hash", "last_block_number", "last_log_index"}
 	accountSacdPrimaryKeyColumns     = []string{"account", "grantee"}
 	accountSacdGeneratedColumns      = []string{}
 )
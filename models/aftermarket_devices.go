@@ -37,6 +37,9 @@ type AftermarketDevice struct {
 	DevEui           null.String `boil:"dev_eui" json:"dev_eui,omitempty" toml:"dev_eui" yaml:"dev_eui,omitempty"`
 	HardwareRevision null.String `boil:"hardware_revision" json:"hardware_revision,omitempty" toml:"hardware_revision" yaml:"hardware_revision,omitempty"`
 	PairedAt         null.Time   `boil:"paired_at" json:"paired_at,omitempty" toml:"paired_at" yaml:"paired_at,omitempty"`
+	LastBlockHash    null.Bytes  `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber  null.Int64  `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex     null.Int    `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
 
 	R *aftermarketDeviceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L aftermarketDeviceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +59,9 @@ var AftermarketDeviceColumns = struct {
 	DevEui           string
 	HardwareRevision string
 	PairedAt         string
+	LastBlockHash    string
+	LastBlockNumber  string
+	LastLogIndex     string
 }{
 	ID:               "id",
 	Address:          "address",
@@ -70,6 +76,9 @@ var AftermarketDeviceColumns = struct {
 	DevEui:           "dev_eui",
 	HardwareRevision: "hardware_revision",
 	PairedAt:         "paired_at",
+	LastBlockHash:    "last_block_hash",
+	LastBlockNumber:  "last_block_number",
+	LastLogIndex:     "last_log_index",
 }
 
 var AftermarketDeviceTableColumns = struct {
@@ -86,6 +95,9 @@ var AftermarketDeviceTableColumns = struct {
 	DevEui           string
 	HardwareRevision string
 	PairedAt         string
+	LastBlockHash    string
+	LastBlockNumber  string
+	LastLogIndex     string
 }{
 	ID:               "aftermarket_devices.id",
 	Address:          "aftermarket_devices.address",
@@ -100,33 +112,13 @@ var AftermarketDeviceTableColumns = struct {
 	DevEui:           "aftermarket_devices.dev_eui",
 	HardwareRevision: "aftermarket_devices.hardware_revision",
 	PairedAt:         "aftermarket_devices.paired_at",
+	LastBlockHash:    "aftermarket_devices.last_block_hash",
+	LastBlockNumber:  "aftermarket_devices.last_block_number",
+	LastLogIndex:     "aftermarket_devices.last_log_index",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_String struct{ field string }
 
 func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
@@ -183,44 +175,6 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 type whereHelpernull_Time struct{ field string }
 
 func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
@@ -259,6 +213,9 @@ var AftermarketDeviceWhere = struct {
 	DevEui           whereHelpernull_String
 	HardwareRevision whereHelpernull_String
 	PairedAt         whereHelpernull_Time
+	LastBlockHash    whereHelpernull_Bytes
+	LastBlockNumber  whereHelpernull_Int64
+	LastLogIndex     whereHelpernull_Int
 }{
 	ID:               whereHelperint{field: "\"identity_api\".\"aftermarket_devices\".\"id\""},
 	Address:          whereHelper__byte{field: "\"identity_api\".\"aftermarket_devices\".\"address\""},
@@ -273,6 +230,9 @@ var AftermarketDeviceWhere = struct {
 	DevEui:           whereHelpernull_String{field: "\"identity_api\".\"aftermarket_devices\".\"dev_eui\""},
 	HardwareRevision: whereHelpernull_String{field: "\"identity_api\".\"aftermarket_devices\".\"hardware_revision\""},
 	PairedAt:         whereHelpernull_Time{field: "\"identity_api\".\"aftermarket_devices\".\"paired_at\""},
+	LastBlockHash:    whereHelpernull_Bytes{field: "\"identity_api\".\"aftermarket_devices\".\"last_block_hash\""},
+	LastBlockNumber:  whereHelpernull_Int64{field: "\"identity_api\".\"aftermarket_devices\".\"last_block_number\""},
+	LastLogIndex:     whereHelpernull_Int{field: "\"identity_api\".\"aftermarket_devices\".\"last_log_index\""},
 }
 
 // AftermarketDeviceRels is where relationship names are stored.
@@ -350,9 +310,9 @@ func (r *aftermarketDeviceR) GetAftermarketTokenRewards() RewardSlice {
 type aftermarketDeviceL struct{}
 
 var (
-	aftermarketDeviceAllColumns            = []string{"id", "address", "owner", "serial", "imei", "minted_at", "vehicle_id", "beneficiary", "manufacturer_id", "claimed_at", "dev_eui", "hardware_revision", "paired_at"}
+	aftermarketDeviceAllColumns            = []string{"id", "address", "owner", "serial", "imei", "minted_at", "vehicle_id", "beneficiary", "manufacturer_id", "claimed_at", "dev_eui", "hardware_revision", "paired_at", "last_block_hash", "last_block_number", "last_log_index"}
 	aftermarketDeviceColumnsWithoutDefault = []string{"id", "address", "owner", "minted_at", "beneficiary", "manufacturer_id"}
-	aftermarketDeviceColumnsWithDefault    = []string{"serial", "imei", "vehicle_id", "claimed_at", "dev_eui", "hardware_revision", "paired_at"}
+	aftermarketDeviceColumnsWithDefault    = []string{"serial", "imei", "vehicle_id", "claimed_at", "dev_eui", "hardware_revision", "paired_at", "last_block_hash", "last_block_number", "last_log_index"}
 	aftermarketDevicePrimaryKeyColumns     = []string{"id"}
 	aftermarketDeviceGeneratedColumns      = []string{}
 )
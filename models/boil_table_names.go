@@ -4,39 +4,65 @@
 package models
 
 var TableNames = struct {
-	AccountSacds       string
-	AftermarketDevices string
-	ConnectionSacds    string
-	Connections        string
-	DCNS               string
-	DeveloperLicenses  string
-	Manufacturers      string
-	Privileges         string
-	RedirectUris       string
-	Rewards            string
-	Signers            string
-	Stakes             string
-	StorageNodes       string
-	SyntheticDevices   string
-	Templates          string
-	VehicleSacds       string
-	Vehicles           string
+	AccountSacds                   string
+	AftermarketDeviceBeneficiaries string
+	AftermarketDeviceHistory       string
+	AftermarketDevices             string
+	BlockJournal                   string
+	ConnectionSacds                string
+	Connections                    string
+	ConsumerOffsets                string
+	ContractEvents                 string
+	DCNTransfers                   string
+	DCNS                           string
+	DeadLetters                    string
+	DeveloperLicenseTransfers      string
+	DeveloperLicenses              string
+	IndexerCheckpoints             string
+	Manufacturers                  string
+	Privileges                     string
+	ProcessedBlocks                string
+	ProcessedEvents                string
+	RedirectUris                   string
+	Rewards                        string
+	SacdHistory                    string
+	Signers                        string
+	Stakes                         string
+	StorageNodes                   string
+	SyntheticDevices               string
+	Templates                      string
+	VehicleSacds                   string
+	VehicleTransfers               string
+	Vehicles                       string
 }{
-	AccountSacds:       "account_sacds",
-	AftermarketDevices: "aftermarket_devices",
-	ConnectionSacds:    "connection_sacds",
-	Connections:        "connections",
-	DCNS:               "dcns",
-	DeveloperLicenses:  "developer_licenses",
-	Manufacturers:      "manufacturers",
-	Privileges:         "privileges",
-	RedirectUris:       "redirect_uris",
-	Rewards:            "rewards",
-	Signers:            "signers",
-	Stakes:             "stakes",
-	StorageNodes:       "storage_nodes",
-	SyntheticDevices:   "synthetic_devices",
-	Templates:          "templates",
-	VehicleSacds:       "vehicle_sacds",
-	Vehicles:           "vehicles",
+	AccountSacds:                   "account_sacds",
+	AftermarketDeviceBeneficiaries: "aftermarket_device_beneficiaries",
+	AftermarketDeviceHistory:       "aftermarket_device_history",
+	AftermarketDevices:             "aftermarket_devices",
+	BlockJournal:                   "block_journal",
+	ConnectionSacds:                "connection_sacds",
+	Connections:                    "connections",
+	ConsumerOffsets:                "consumer_offsets",
+	ContractEvents:                 "contract_events",
+	DCNTransfers:                   "dcn_transfers",
+	DCNS:                           "dcns",
+	DeadLetters:                    "dead_letters",
+	DeveloperLicenseTransfers:      "developer_license_transfers",
+	DeveloperLicenses:              "developer_licenses",
+	IndexerCheckpoints:             "indexer_checkpoints",
+	Manufacturers:                  "manufacturers",
+	Privileges:                     "privileges",
+	ProcessedBlocks:                "processed_blocks",
+	ProcessedEvents:                "processed_events",
+	RedirectUris:                   "redirect_uris",
+	Rewards:                        "rewards",
+	SacdHistory:                    "sacd_history",
+	Signers:                        "signers",
+	Stakes:                         "stakes",
+	StorageNodes:                   "storage_nodes",
+	SyntheticDevices:               "synthetic_devices",
+	Templates:                      "templates",
+	VehicleSacds:                   "vehicle_sacds",
+	VehicleTransfers:               "vehicle_transfers",
+	Vehicles:                       "vehicles",
 }
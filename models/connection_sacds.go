@@ -24,72 +24,93 @@ import (
 
 // ConnectionSacd is an object representing the database table.
 type ConnectionSacd struct {
-	ConnectionID []byte     `boil:"connection_id" json:"connection_id" toml:"connection_id" yaml:"connection_id"`
-	Grantee      []byte     `boil:"grantee" json:"grantee" toml:"grantee" yaml:"grantee"`
-	Permissions  string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
-	Source       string     `boil:"source" json:"source" toml:"source" yaml:"source"`
-	TemplateID   null.Bytes `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
-	CreatedAt    time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	ExpiresAt    time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	ConnectionID    []byte     `boil:"connection_id" json:"connection_id" toml:"connection_id" yaml:"connection_id"`
+	Grantee         []byte     `boil:"grantee" json:"grantee" toml:"grantee" yaml:"grantee"`
+	Permissions     string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
+	Source          string     `boil:"source" json:"source" toml:"source" yaml:"source"`
+	TemplateID      null.Bytes `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt       time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	LastBlockHash   null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber null.Int64 `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex    null.Int   `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
 
 	R *connectionSacdR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L connectionSacdL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConnectionSacdColumns = struct {
-	ConnectionID string
-	Grantee      string
-	Permissions  string
-	Source       string
-	TemplateID   string
-	CreatedAt    string
-	ExpiresAt    string
+	ConnectionID    string
+	Grantee         string
+	Permissions     string
+	Source          string
+	TemplateID      string
+	CreatedAt       string
+	ExpiresAt       string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	ConnectionID: "connection_id",
-	Grantee:      "grantee",
-	Permissions:  "permissions",
-	Source:       "source",
-	TemplateID:   "template_id",
-	CreatedAt:    "created_at",
-	ExpiresAt:    "expires_at",
+	ConnectionID:    "connection_id",
+	Grantee:         "grantee",
+	Permissions:     "permissions",
+	Source:          "source",
+	TemplateID:      "template_id",
+	CreatedAt:       "created_at",
+	ExpiresAt:       "expires_at",
+	LastBlockHash:   "last_block_hash",
+	LastBlockNumber: "last_block_number",
+	LastLogIndex:    "last_log_index",
 }
 
 var ConnectionSacdTableColumns = struct {
-	ConnectionID string
-	Grantee      string
-	Permissions  string
-	Source       string
-	TemplateID   string
-	CreatedAt    string
-	ExpiresAt    string
+	ConnectionID    string
+	Grantee         string
+	Permissions     string
+	Source          string
+	TemplateID      string
+	CreatedAt       string
+	ExpiresAt       string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	ConnectionID: "connection_sacds.connection_id",
-	Grantee:      "connection_sacds.grantee",
-	Permissions:  "connection_sacds.permissions",
-	Source:       "connection_sacds.source",
-	TemplateID:   "connection_sacds.template_id",
-	CreatedAt:    "connection_sacds.created_at",
-	ExpiresAt:    "connection_sacds.expires_at",
+	ConnectionID:    "connection_sacds.connection_id",
+	Grantee:         "connection_sacds.grantee",
+	Permissions:     "connection_sacds.permissions",
+	Source:          "connection_sacds.source",
+	TemplateID:      "connection_sacds.template_id",
+	CreatedAt:       "connection_sacds.created_at",
+	ExpiresAt:       "connection_sacds.expires_at",
+	LastBlockHash:   "connection_sacds.last_block_hash",
+	LastBlockNumber: "connection_sacds.last_block_number",
+	LastLogIndex:    "connection_sacds.last_log_index",
 }
 
 // Generated where
 
 var ConnectionSacdWhere = struct {
-	ConnectionID whereHelper__byte
-	Grantee      whereHelper__byte
-	Permissions  whereHelperstring
-	Source       whereHelperstring
-	TemplateID   whereHelpernull_Bytes
-	CreatedAt    whereHelpertime_Time
-	ExpiresAt    whereHelpertime_Time
+	ConnectionID    whereHelper__byte
+	Grantee         whereHelper__byte
+	Permissions     whereHelperstring
+	Source          whereHelperstring
+	TemplateID      whereHelpernull_Bytes
+	CreatedAt       whereHelpertime_Time
+	ExpiresAt       whereHelpertime_Time
+	LastBlockHash   whereHelpernull_Bytes
+	LastBlockNumber whereHelpernull_Int64
+	LastLogIndex    whereHelpernull_Int
 }{
-	ConnectionID: whereHelper__byte{field: "\"identity_api\".\"connection_sacds\".\"connection_id\""},
-	Grantee:      whereHelper__byte{field: "\"identity_api\".\"connection_sacds\".\"grantee\""},
-	Permissions:  whereHelperstring{field: "\"identity_api\".\"connection_sacds\".\"permissions\""},
-	Source:       whereHelperstring{field: "\"identity_api\".\"connection_sacds\".\"source\""},
-	TemplateID:   whereHelpernull_Bytes{field: "\"identity_api\".\"connection_sacds\".\"template_id\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"identity_api\".\"connection_sacds\".\"created_at\""},
-	ExpiresAt:    whereHelpertime_Time{field: "\"identity_api\".\"connection_sacds\".\"expires_at\""},
+	ConnectionID:    whereHelper__byte{field: "\"identity_api\".\"connection_sacds\".\"connection_id\""},
+	Grantee:         whereHelper__byte{field: "\"identity_api\".\"connection_sacds\".\"grantee\""},
+	Permissions:     whereHelperstring{field: "\"identity_api\".\"connection_sacds\".\"permissions\""},
+	Source:          whereHelperstring{field: "\"identity_api\".\"connection_sacds\".\"source\""},
+	TemplateID:      whereHelpernull_Bytes{field: "\"identity_api\".\"connection_sacds\".\"template_id\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"identity_api\".\"connection_sacds\".\"created_at\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"identity_api\".\"connection_sacds\".\"expires_at\""},
+	LastBlockHash:   whereHelpernull_Bytes{field: "\"identity_api\".\"connection_sacds\".\"last_block_hash\""},
+	LastBlockNumber: whereHelpernull_Int64{field: "\"identity_api\".\"connection_sacds\".\"last_block_number\""},
+	LastLogIndex:    whereHelpernull_Int{field: "\"identity_api\".\"connection_sacds\".\"last_log_index\""},
 }
 
 // ConnectionSacdRels is where relationship names are stored.
@@ -129,9 +150,9 @@ func (r *connectionSacdR) GetConnection() *Connection {
 type connectionSacdL struct{}
 
 var (
-	connectionSacdAllColumns            = []string{"connection_id", "grantee", "permissions", "source", "template_id", "created_at", "expires_at"}
+	connectionSacdAllColumns            = []string{"connection_id", "grantee", "permissions", "source", "template_id", "created_at", "expires_at", "last_block_hash", "last_block_number", "last_log_index"}
 	connectionSacdColumnsWithoutDefault = []string{"connection_id", "grantee", "permissions", "source", "created_at", "expires_at"}
-	connectionSacdColumnsWithDefault    = []string{"template_id"}
+	connectionSacdColumnsWithDefault    = []string{"template_id", "last_block_hash", "last_block_number", "last_log_index"}
 	connectionSacdPrimaryKeyColumns     = []string{"connection_id", "grantee"}
 	connectionSacdGeneratedColumns      = []string{}
 )
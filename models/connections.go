@@ -24,11 +24,12 @@ import (
 
 // Connection is an object representing the database table.
 type Connection struct {
-	Address         []byte    `boil:"address" json:"address" toml:"address" yaml:"address"`
-	Owner           []byte    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
-	MintedAt        time.Time `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
-	ID              []byte    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	IntegrationNode null.Int  `boil:"integration_node" json:"integration_node,omitempty" toml:"integration_node" yaml:"integration_node,omitempty"`
+	Address         []byte     `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Owner           []byte     `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	MintedAt        time.Time  `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	ID              []byte     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationNode null.Int   `boil:"integration_node" json:"integration_node,omitempty" toml:"integration_node" yaml:"integration_node,omitempty"`
+	LastBlockHash   null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
 
 	R *connectionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L connectionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,12 +41,14 @@ var ConnectionColumns = struct {
 	MintedAt        string
 	ID              string
 	IntegrationNode string
+	LastBlockHash   string
 }{
 	Address:         "address",
 	Owner:           "owner",
 	MintedAt:        "minted_at",
 	ID:              "id",
 	IntegrationNode: "integration_node",
+	LastBlockHash:   "last_block_hash",
 }
 
 var ConnectionTableColumns = struct {
@@ -54,12 +57,14 @@ var ConnectionTableColumns = struct {
 	MintedAt        string
 	ID              string
 	IntegrationNode string
+	LastBlockHash   string
 }{
 	Address:         "connections.address",
 	Owner:           "connections.owner",
 	MintedAt:        "connections.minted_at",
 	ID:              "connections.id",
 	IntegrationNode: "connections.integration_node",
+	LastBlockHash:   "connections.last_block_hash",
 }
 
 // Generated where
@@ -70,12 +75,14 @@ var ConnectionWhere = struct {
 	MintedAt        whereHelpertime_Time
 	ID              whereHelper__byte
 	IntegrationNode whereHelpernull_Int
+	LastBlockHash   whereHelpernull_Bytes
 }{
 	Address:         whereHelper__byte{field: "\"identity_api\".\"connections\".\"address\""},
 	Owner:           whereHelper__byte{field: "\"identity_api\".\"connections\".\"owner\""},
 	MintedAt:        whereHelpertime_Time{field: "\"identity_api\".\"connections\".\"minted_at\""},
 	ID:              whereHelper__byte{field: "\"identity_api\".\"connections\".\"id\""},
 	IntegrationNode: whereHelpernull_Int{field: "\"identity_api\".\"connections\".\"integration_node\""},
+	LastBlockHash:   whereHelpernull_Bytes{field: "\"identity_api\".\"connections\".\"last_block_hash\""},
 }
 
 // ConnectionRels is where relationship names are stored.
@@ -134,9 +141,9 @@ func (r *connectionR) GetSyntheticDevices() SyntheticDeviceSlice {
 type connectionL struct{}
 
 var (
-	connectionAllColumns            = []string{"address", "owner", "minted_at", "id", "integration_node"}
+	connectionAllColumns            = []string{"address", "owner", "minted_at", "id", "integration_node", "last_block_hash"}
 	connectionColumnsWithoutDefault = []string{"address", "owner", "minted_at", "id"}
-	connectionColumnsWithDefault    = []string{"integration_node"}
+	connectionColumnsWithDefault    = []string{"integration_node", "last_block_hash"}
 	connectionPrimaryKeyColumns     = []string{"id"}
 	connectionGeneratedColumns      = []string{}
 )
@@ -24,65 +24,100 @@ import (
 
 // DCN is an object representing the database table.
 type DCN struct {
-	Node         []byte      `boil:"node" json:"node" toml:"node" yaml:"node"`
-	OwnerAddress []byte      `boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
-	Expiration   null.Time   `boil:"expiration" json:"expiration,omitempty" toml:"expiration" yaml:"expiration,omitempty"`
-	Name         null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
-	VehicleID    null.Int    `boil:"vehicle_id" json:"vehicle_id,omitempty" toml:"vehicle_id" yaml:"vehicle_id,omitempty"`
-	MintedAt     time.Time   `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	Node                []byte      `boil:"node" json:"node" toml:"node" yaml:"node"`
+	OwnerAddress        []byte      `boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
+	Expiration          null.Time   `boil:"expiration" json:"expiration,omitempty" toml:"expiration" yaml:"expiration,omitempty"`
+	Name                null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	VehicleID           null.Int    `boil:"vehicle_id" json:"vehicle_id,omitempty" toml:"vehicle_id" yaml:"vehicle_id,omitempty"`
+	MintedAt            time.Time   `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	LastBlockHash       null.Bytes  `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber     null.Int64  `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex        null.Int    `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
+	BurnedAt            null.Time   `boil:"burned_at" json:"burned_at,omitempty" toml:"burned_at" yaml:"burned_at,omitempty"`
+	BurnTransactionHash null.Bytes  `boil:"burn_transaction_hash" json:"burn_transaction_hash,omitempty" toml:"burn_transaction_hash" yaml:"burn_transaction_hash,omitempty"`
 
 	R *dcnR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L dcnL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var DCNColumns = struct {
-	Node         string
-	OwnerAddress string
-	Expiration   string
-	Name         string
-	VehicleID    string
-	MintedAt     string
+	Node                string
+	OwnerAddress        string
+	Expiration          string
+	Name                string
+	VehicleID           string
+	MintedAt            string
+	LastBlockHash       string
+	LastBlockNumber     string
+	LastLogIndex        string
+	BurnedAt            string
+	BurnTransactionHash string
 }{
-	Node:         "node",
-	OwnerAddress: "owner_address",
-	Expiration:   "expiration",
-	Name:         "name",
-	VehicleID:    "vehicle_id",
-	MintedAt:     "minted_at",
+	Node:                "node",
+	OwnerAddress:        "owner_address",
+	Expiration:          "expiration",
+	Name:                "name",
+	VehicleID:           "vehicle_id",
+	MintedAt:            "minted_at",
+	LastBlockHash:       "last_block_hash",
+	LastBlockNumber:     "last_block_number",
+	LastLogIndex:        "last_log_index",
+	BurnedAt:            "burned_at",
+	BurnTransactionHash: "burn_transaction_hash",
 }
 
 var DCNTableColumns = struct {
-	Node         string
-	OwnerAddress string
-	Expiration   string
-	Name         string
-	VehicleID    string
-	MintedAt     string
+	Node                string
+	OwnerAddress        string
+	Expiration          string
+	Name                string
+	VehicleID           string
+	MintedAt            string
+	LastBlockHash       string
+	LastBlockNumber     string
+	LastLogIndex        string
+	BurnedAt            string
+	BurnTransactionHash string
 }{
-	Node:         "dcns.node",
-	OwnerAddress: "dcns.owner_address",
-	Expiration:   "dcns.expiration",
-	Name:         "dcns.name",
-	VehicleID:    "dcns.vehicle_id",
-	MintedAt:     "dcns.minted_at",
+	Node:                "dcns.node",
+	OwnerAddress:        "dcns.owner_address",
+	Expiration:          "dcns.expiration",
+	Name:                "dcns.name",
+	VehicleID:           "dcns.vehicle_id",
+	MintedAt:            "dcns.minted_at",
+	LastBlockHash:       "dcns.last_block_hash",
+	LastBlockNumber:     "dcns.last_block_number",
+	LastLogIndex:        "dcns.last_log_index",
+	BurnedAt:            "dcns.burned_at",
+	BurnTransactionHash: "dcns.burn_transaction_hash",
 }
 
 // Generated where
 
 var DCNWhere = struct {
-	Node         whereHelper__byte
-	OwnerAddress whereHelper__byte
-	Expiration   whereHelpernull_Time
-	Name         whereHelpernull_String
-	VehicleID    whereHelpernull_Int
-	MintedAt     whereHelpertime_Time
+	Node                whereHelper__byte
+	OwnerAddress        whereHelper__byte
+	Expiration          whereHelpernull_Time
+	Name                whereHelpernull_String
+	VehicleID           whereHelpernull_Int
+	MintedAt            whereHelpertime_Time
+	LastBlockHash       whereHelpernull_Bytes
+	LastBlockNumber     whereHelpernull_Int64
+	LastLogIndex        whereHelpernull_Int
+	BurnedAt            whereHelpernull_Time
+	BurnTransactionHash whereHelpernull_Bytes
 }{
-	Node:         whereHelper__byte{field: "\"identity_api\".\"dcns\".\"node\""},
-	OwnerAddress: whereHelper__byte{field: "\"identity_api\".\"dcns\".\"owner_address\""},
-	Expiration:   whereHelpernull_Time{field: "\"identity_api\".\"dcns\".\"expiration\""},
-	Name:         whereHelpernull_String{field: "\"identity_api\".\"dcns\".\"name\""},
-	VehicleID:    whereHelpernull_Int{field: "\"identity_api\".\"dcns\".\"vehicle_id\""},
-	MintedAt:     whereHelpertime_Time{field: "\"identity_api\".\"dcns\".\"minted_at\""},
+	Node:                whereHelper__byte{field: "\"identity_api\".\"dcns\".\"node\""},
+	OwnerAddress:        whereHelper__byte{field: "\"identity_api\".\"dcns\".\"owner_address\""},
+	Expiration:          whereHelpernull_Time{field: "\"identity_api\".\"dcns\".\"expiration\""},
+	Name:                whereHelpernull_String{field: "\"identity_api\".\"dcns\".\"name\""},
+	VehicleID:           whereHelpernull_Int{field: "\"identity_api\".\"dcns\".\"vehicle_id\""},
+	MintedAt:            whereHelpertime_Time{field: "\"identity_api\".\"dcns\".\"minted_at\""},
+	LastBlockHash:       whereHelpernull_Bytes{field: "\"identity_api\".\"dcns\".\"last_block_hash\""},
+	LastBlockNumber:     whereHelpernull_Int64{field: "\"identity_api\".\"dcns\".\"last_block_number\""},
+	LastLogIndex:        whereHelpernull_Int{field: "\"identity_api\".\"dcns\".\"last_log_index\""},
+	BurnedAt:            whereHelpernull_Time{field: "\"identity_api\".\"dcns\".\"burned_at\""},
+	BurnTransactionHash: whereHelpernull_Bytes{field: "\"identity_api\".\"dcns\".\"burn_transaction_hash\""},
 }
 
 // DCNRels is where relationship names are stored.
@@ -122,9 +157,9 @@ func (r *dcnR) GetVehicle() *Vehicle {
 type dcnL struct{}
 
 var (
-	dcnAllColumns            = []string{"node", "owner_address", "expiration", "name", "vehicle_id", "minted_at"}
+	dcnAllColumns            = []string{"node", "owner_address", "expiration", "name", "vehicle_id", "minted_at", "last_block_hash", "last_block_number", "last_log_index", "burned_at", "burn_transaction_hash"}
 	dcnColumnsWithoutDefault = []string{"node", "owner_address", "minted_at"}
-	dcnColumnsWithDefault    = []string{"expiration", "name", "vehicle_id"}
+	dcnColumnsWithDefault    = []string{"expiration", "name", "vehicle_id", "last_block_hash", "last_block_number", "last_log_index", "burned_at", "burn_transaction_hash"}
 	dcnPrimaryKeyColumns     = []string{"node"}
 	dcnGeneratedColumns      = []string{}
 )
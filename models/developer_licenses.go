@@ -24,58 +24,72 @@ import (
 
 // DeveloperLicense is an object representing the database table.
 type DeveloperLicense struct {
-	ID       int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Owner    []byte      `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
-	ClientID []byte      `boil:"client_id" json:"client_id" toml:"client_id" yaml:"client_id"`
-	Alias    null.String `boil:"alias" json:"alias,omitempty" toml:"alias" yaml:"alias,omitempty"`
-	MintedAt time.Time   `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	ID            int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Owner         []byte      `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	ClientID      []byte      `boil:"client_id" json:"client_id" toml:"client_id" yaml:"client_id"`
+	Alias         null.String `boil:"alias" json:"alias,omitempty" toml:"alias" yaml:"alias,omitempty"`
+	MintedAt      time.Time   `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	LastBlockHash null.Bytes  `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	BurnedAt      null.Time   `boil:"burned_at" json:"burned_at,omitempty" toml:"burned_at" yaml:"burned_at,omitempty"`
 
 	R *developerLicenseR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L developerLicenseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var DeveloperLicenseColumns = struct {
-	ID       string
-	Owner    string
-	ClientID string
-	Alias    string
-	MintedAt string
+	ID            string
+	Owner         string
+	ClientID      string
+	Alias         string
+	MintedAt      string
+	LastBlockHash string
+	BurnedAt      string
 }{
-	ID:       "id",
-	Owner:    "owner",
-	ClientID: "client_id",
-	Alias:    "alias",
-	MintedAt: "minted_at",
+	ID:            "id",
+	Owner:         "owner",
+	ClientID:      "client_id",
+	Alias:         "alias",
+	MintedAt:      "minted_at",
+	LastBlockHash: "last_block_hash",
+	BurnedAt:      "burned_at",
 }
 
 var DeveloperLicenseTableColumns = struct {
-	ID       string
-	Owner    string
-	ClientID string
-	Alias    string
-	MintedAt string
+	ID            string
+	Owner         string
+	ClientID      string
+	Alias         string
+	MintedAt      string
+	LastBlockHash string
+	BurnedAt      string
 }{
-	ID:       "developer_licenses.id",
-	Owner:    "developer_licenses.owner",
-	ClientID: "developer_licenses.client_id",
-	Alias:    "developer_licenses.alias",
-	MintedAt: "developer_licenses.minted_at",
+	ID:            "developer_licenses.id",
+	Owner:         "developer_licenses.owner",
+	ClientID:      "developer_licenses.client_id",
+	Alias:         "developer_licenses.alias",
+	MintedAt:      "developer_licenses.minted_at",
+	LastBlockHash: "developer_licenses.last_block_hash",
+	BurnedAt:      "developer_licenses.burned_at",
 }
 
 // Generated where
 
 var DeveloperLicenseWhere = struct {
-	ID       whereHelperint
-	Owner    whereHelper__byte
-	ClientID whereHelper__byte
-	Alias    whereHelpernull_String
-	MintedAt whereHelpertime_Time
+	ID            whereHelperint
+	Owner         whereHelper__byte
+	ClientID      whereHelper__byte
+	Alias         whereHelpernull_String
+	MintedAt      whereHelpertime_Time
+	LastBlockHash whereHelpernull_Bytes
+	BurnedAt      whereHelpernull_Time
 }{
-	ID:       whereHelperint{field: "\"identity_api\".\"developer_licenses\".\"id\""},
-	Owner:    whereHelper__byte{field: "\"identity_api\".\"developer_licenses\".\"owner\""},
-	ClientID: whereHelper__byte{field: "\"identity_api\".\"developer_licenses\".\"client_id\""},
-	Alias:    whereHelpernull_String{field: "\"identity_api\".\"developer_licenses\".\"alias\""},
-	MintedAt: whereHelpertime_Time{field: "\"identity_api\".\"developer_licenses\".\"minted_at\""},
+	ID:            whereHelperint{field: "\"identity_api\".\"developer_licenses\".\"id\""},
+	Owner:         whereHelper__byte{field: "\"identity_api\".\"developer_licenses\".\"owner\""},
+	ClientID:      whereHelper__byte{field: "\"identity_api\".\"developer_licenses\".\"client_id\""},
+	Alias:         whereHelpernull_String{field: "\"identity_api\".\"developer_licenses\".\"alias\""},
+	MintedAt:      whereHelpertime_Time{field: "\"identity_api\".\"developer_licenses\".\"minted_at\""},
+	LastBlockHash: whereHelpernull_Bytes{field: "\"identity_api\".\"developer_licenses\".\"last_block_hash\""},
+	BurnedAt:      whereHelpernull_Time{field: "\"identity_api\".\"developer_licenses\".\"burned_at\""},
 }
 
 // DeveloperLicenseRels is where relationship names are stored.
@@ -134,9 +148,9 @@ func (r *developerLicenseR) GetSigners() SignerSlice {
 type developerLicenseL struct{}
 
 var (
-	developerLicenseAllColumns            = []string{"id", "owner", "client_id", "alias", "minted_at"}
+	developerLicenseAllColumns            = []string{"id", "owner", "client_id", "alias", "minted_at", "last_block_hash", "burned_at"}
 	developerLicenseColumnsWithoutDefault = []string{"id", "owner", "client_id", "minted_at"}
-	developerLicenseColumnsWithDefault    = []string{"alias"}
+	developerLicenseColumnsWithDefault    = []string{"alias", "last_block_hash", "burned_at"}
 	developerLicensePrimaryKeyColumns     = []string{"id"}
 	developerLicenseGeneratedColumns      = []string{}
 )
@@ -718,7 +732,7 @@ func (o *DeveloperLicense) AddRedirectUris(ctx context.Context, exec boil.Contex
 				strmangle.SetParamNames("\"", "\"", 1, []string{"developer_license_id"}),
 				strmangle.WhereClause("\"", "\"", 2, redirectURIPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.DeveloperLicenseID, rel.URI}
+			values := []interface{}{o.ID, rel.DeveloperLicenseID, rel.URI, rel.EnabledAt}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -771,7 +785,7 @@ func (o *DeveloperLicense) AddSigners(ctx context.Context, exec boil.ContextExec
 				strmangle.SetParamNames("\"", "\"", 1, []string{"developer_license_id"}),
 				strmangle.WhereClause("\"", "\"", 2, signerPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.DeveloperLicenseID, rel.Signer}
+			values := []interface{}{o.ID, rel.DeveloperLicenseID, rel.Signer, rel.EnabledAt}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
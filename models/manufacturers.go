@@ -24,65 +24,72 @@ import (
 
 // Manufacturer is an object representing the database table.
 type Manufacturer struct {
-	ID       int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name     string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Owner    []byte    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
-	MintedAt time.Time `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
-	TableID  null.Int  `boil:"table_id" json:"table_id,omitempty" toml:"table_id" yaml:"table_id,omitempty"`
-	Slug     string    `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	ID            int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name          string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Owner         []byte     `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	MintedAt      time.Time  `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	TableID       null.Int   `boil:"table_id" json:"table_id,omitempty" toml:"table_id" yaml:"table_id,omitempty"`
+	Slug          string     `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	LastBlockHash null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
 
 	R *manufacturerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L manufacturerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ManufacturerColumns = struct {
-	ID       string
-	Name     string
-	Owner    string
-	MintedAt string
-	TableID  string
-	Slug     string
+	ID            string
+	Name          string
+	Owner         string
+	MintedAt      string
+	TableID       string
+	Slug          string
+	LastBlockHash string
 }{
-	ID:       "id",
-	Name:     "name",
-	Owner:    "owner",
-	MintedAt: "minted_at",
-	TableID:  "table_id",
-	Slug:     "slug",
+	ID:            "id",
+	Name:          "name",
+	Owner:         "owner",
+	MintedAt:      "minted_at",
+	TableID:       "table_id",
+	Slug:          "slug",
+	LastBlockHash: "last_block_hash",
 }
 
 var ManufacturerTableColumns = struct {
-	ID       string
-	Name     string
-	Owner    string
-	MintedAt string
-	TableID  string
-	Slug     string
+	ID            string
+	Name          string
+	Owner         string
+	MintedAt      string
+	TableID       string
+	Slug          string
+	LastBlockHash string
 }{
-	ID:       "manufacturers.id",
-	Name:     "manufacturers.name",
-	Owner:    "manufacturers.owner",
-	MintedAt: "manufacturers.minted_at",
-	TableID:  "manufacturers.table_id",
-	Slug:     "manufacturers.slug",
+	ID:            "manufacturers.id",
+	Name:          "manufacturers.name",
+	Owner:         "manufacturers.owner",
+	MintedAt:      "manufacturers.minted_at",
+	TableID:       "manufacturers.table_id",
+	Slug:          "manufacturers.slug",
+	LastBlockHash: "manufacturers.last_block_hash",
 }
 
 // Generated where
 
 var ManufacturerWhere = struct {
-	ID       whereHelperint
-	Name     whereHelperstring
-	Owner    whereHelper__byte
-	MintedAt whereHelpertime_Time
-	TableID  whereHelpernull_Int
-	Slug     whereHelperstring
+	ID            whereHelperint
+	Name          whereHelperstring
+	Owner         whereHelper__byte
+	MintedAt      whereHelpertime_Time
+	TableID       whereHelpernull_Int
+	Slug          whereHelperstring
+	LastBlockHash whereHelpernull_Bytes
 }{
-	ID:       whereHelperint{field: "\"identity_api\".\"manufacturers\".\"id\""},
-	Name:     whereHelperstring{field: "\"identity_api\".\"manufacturers\".\"name\""},
-	Owner:    whereHelper__byte{field: "\"identity_api\".\"manufacturers\".\"owner\""},
-	MintedAt: whereHelpertime_Time{field: "\"identity_api\".\"manufacturers\".\"minted_at\""},
-	TableID:  whereHelpernull_Int{field: "\"identity_api\".\"manufacturers\".\"table_id\""},
-	Slug:     whereHelperstring{field: "\"identity_api\".\"manufacturers\".\"slug\""},
+	ID:            whereHelperint{field: "\"identity_api\".\"manufacturers\".\"id\""},
+	Name:          whereHelperstring{field: "\"identity_api\".\"manufacturers\".\"name\""},
+	Owner:         whereHelper__byte{field: "\"identity_api\".\"manufacturers\".\"owner\""},
+	MintedAt:      whereHelpertime_Time{field: "\"identity_api\".\"manufacturers\".\"minted_at\""},
+	TableID:       whereHelpernull_Int{field: "\"identity_api\".\"manufacturers\".\"table_id\""},
+	Slug:          whereHelperstring{field: "\"identity_api\".\"manufacturers\".\"slug\""},
+	LastBlockHash: whereHelpernull_Bytes{field: "\"identity_api\".\"manufacturers\".\"last_block_hash\""},
 }
 
 // ManufacturerRels is where relationship names are stored.
@@ -141,9 +148,9 @@ func (r *manufacturerR) GetVehicles() VehicleSlice {
 type manufacturerL struct{}
 
 var (
-	manufacturerAllColumns            = []string{"id", "name", "owner", "minted_at", "table_id", "slug"}
+	manufacturerAllColumns            = []string{"id", "name", "owner", "minted_at", "table_id", "slug", "last_block_hash"}
 	manufacturerColumnsWithoutDefault = []string{"id", "name", "owner", "minted_at", "slug"}
-	manufacturerColumnsWithDefault    = []string{"table_id"}
+	manufacturerColumnsWithDefault    = []string{"table_id", "last_block_hash"}
 	manufacturerPrimaryKeyColumns     = []string{"id"}
 	manufacturerGeneratedColumns      = []string{}
 )
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,58 +24,79 @@ import (
 
 // Privilege is an object representing the database table.
 type Privilege struct {
-	TokenID     int       `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
-	PrivilegeID int       `boil:"privilege_id" json:"privilege_id" toml:"privilege_id" yaml:"privilege_id"`
-	UserAddress []byte    `boil:"user_address" json:"user_address" toml:"user_address" yaml:"user_address"`
-	SetAt       time.Time `boil:"set_at" json:"set_at" toml:"set_at" yaml:"set_at"`
-	ExpiresAt   time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	TokenID         int        `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	PrivilegeID     int        `boil:"privilege_id" json:"privilege_id" toml:"privilege_id" yaml:"privilege_id"`
+	UserAddress     []byte     `boil:"user_address" json:"user_address" toml:"user_address" yaml:"user_address"`
+	SetAt           time.Time  `boil:"set_at" json:"set_at" toml:"set_at" yaml:"set_at"`
+	ExpiresAt       time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	LastBlockHash   null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber null.Int64 `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex    null.Int   `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
 
 	R *privilegeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L privilegeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PrivilegeColumns = struct {
-	TokenID     string
-	PrivilegeID string
-	UserAddress string
-	SetAt       string
-	ExpiresAt   string
+	TokenID         string
+	PrivilegeID     string
+	UserAddress     string
+	SetAt           string
+	ExpiresAt       string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	TokenID:     "token_id",
-	PrivilegeID: "privilege_id",
-	UserAddress: "user_address",
-	SetAt:       "set_at",
-	ExpiresAt:   "expires_at",
+	TokenID:         "token_id",
+	PrivilegeID:     "privilege_id",
+	UserAddress:     "user_address",
+	SetAt:           "set_at",
+	ExpiresAt:       "expires_at",
+	LastBlockHash:   "last_block_hash",
+	LastBlockNumber: "last_block_number",
+	LastLogIndex:    "last_log_index",
 }
 
 var PrivilegeTableColumns = struct {
-	TokenID     string
-	PrivilegeID string
-	UserAddress string
-	SetAt       string
-	ExpiresAt   string
+	TokenID         string
+	PrivilegeID     string
+	UserAddress     string
+	SetAt           string
+	ExpiresAt       string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	TokenID:     "privileges.token_id",
-	PrivilegeID: "privileges.privilege_id",
-	UserAddress: "privileges.user_address",
-	SetAt:       "privileges.set_at",
-	ExpiresAt:   "privileges.expires_at",
+	TokenID:         "privileges.token_id",
+	PrivilegeID:     "privileges.privilege_id",
+	UserAddress:     "privileges.user_address",
+	SetAt:           "privileges.set_at",
+	ExpiresAt:       "privileges.expires_at",
+	LastBlockHash:   "privileges.last_block_hash",
+	LastBlockNumber: "privileges.last_block_number",
+	LastLogIndex:    "privileges.last_log_index",
 }
 
 // Generated where
 
 var PrivilegeWhere = struct {
-	TokenID     whereHelperint
-	PrivilegeID whereHelperint
-	UserAddress whereHelper__byte
-	SetAt       whereHelpertime_Time
-	ExpiresAt   whereHelpertime_Time
+	TokenID         whereHelperint
+	PrivilegeID     whereHelperint
+	UserAddress     whereHelper__byte
+	SetAt           whereHelpertime_Time
+	ExpiresAt       whereHelpertime_Time
+	LastBlockHash   whereHelpernull_Bytes
+	LastBlockNumber whereHelpernull_Int64
+	LastLogIndex    whereHelpernull_Int
 }{
-	TokenID:     whereHelperint{field: "\"identity_api\".\"privileges\".\"token_id\""},
-	PrivilegeID: whereHelperint{field: "\"identity_api\".\"privileges\".\"privilege_id\""},
-	UserAddress: whereHelper__byte{field: "\"identity_api\".\"privileges\".\"user_address\""},
-	SetAt:       whereHelpertime_Time{field: "\"identity_api\".\"privileges\".\"set_at\""},
-	ExpiresAt:   whereHelpertime_Time{field: "\"identity_api\".\"privileges\".\"expires_at\""},
+	TokenID:         whereHelperint{field: "\"identity_api\".\"privileges\".\"token_id\""},
+	PrivilegeID:     whereHelperint{field: "\"identity_api\".\"privileges\".\"privilege_id\""},
+	UserAddress:     whereHelper__byte{field: "\"identity_api\".\"privileges\".\"user_address\""},
+	SetAt:           whereHelpertime_Time{field: "\"identity_api\".\"privileges\".\"set_at\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"identity_api\".\"privileges\".\"expires_at\""},
+	LastBlockHash:   whereHelpernull_Bytes{field: "\"identity_api\".\"privileges\".\"last_block_hash\""},
+	LastBlockNumber: whereHelpernull_Int64{field: "\"identity_api\".\"privileges\".\"last_block_number\""},
+	LastLogIndex:    whereHelpernull_Int{field: "\"identity_api\".\"privileges\".\"last_log_index\""},
 }
 
 // PrivilegeRels is where relationship names are stored.
@@ -114,9 +136,9 @@ func (r *privilegeR) GetToken() *Vehicle {
 type privilegeL struct{}
 
 var (
-	privilegeAllColumns            = []string{"token_id", "privilege_id", "user_address", "set_at", "expires_at"}
+	privilegeAllColumns            = []string{"token_id", "privilege_id", "user_address", "set_at", "expires_at", "last_block_hash", "last_block_number", "last_log_index"}
 	privilegeColumnsWithoutDefault = []string{"token_id", "privilege_id", "user_address", "set_at", "expires_at"}
-	privilegeColumnsWithDefault    = []string{}
+	privilegeColumnsWithDefault    = []string{"last_block_hash", "last_block_number", "last_log_index"}
 	privilegePrimaryKeyColumns     = []string{"token_id", "privilege_id", "user_address"}
 	privilegeGeneratedColumns      = []string{}
 )
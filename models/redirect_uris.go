@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,9 +24,11 @@ import (
 
 // RedirectURI is an object representing the database table.
 type RedirectURI struct {
-	DeveloperLicenseID int       `boil:"developer_license_id" json:"developer_license_id" toml:"developer_license_id" yaml:"developer_license_id"`
-	URI                string    `boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
-	EnabledAt          time.Time `boil:"enabled_at" json:"enabled_at" toml:"enabled_at" yaml:"enabled_at"`
+	DeveloperLicenseID int        `boil:"developer_license_id" json:"developer_license_id" toml:"developer_license_id" yaml:"developer_license_id"`
+	URI                string     `boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
+	EnabledAt          time.Time  `boil:"enabled_at" json:"enabled_at" toml:"enabled_at" yaml:"enabled_at"`
+	LastBlockHash      null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	DisabledAt         null.Time  `boil:"disabled_at" json:"disabled_at,omitempty" toml:"disabled_at" yaml:"disabled_at,omitempty"`
 
 	R *redirectURIR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L redirectURIL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -35,20 +38,28 @@ var RedirectURIColumns = struct {
 	DeveloperLicenseID string
 	URI                string
 	EnabledAt          string
+	LastBlockHash      string
+	DisabledAt         string
 }{
 	DeveloperLicenseID: "developer_license_id",
 	URI:                "uri",
 	EnabledAt:          "enabled_at",
+	LastBlockHash:      "last_block_hash",
+	DisabledAt:         "disabled_at",
 }
 
 var RedirectURITableColumns = struct {
 	DeveloperLicenseID string
 	URI                string
 	EnabledAt          string
+	LastBlockHash      string
+	DisabledAt         string
 }{
 	DeveloperLicenseID: "redirect_uris.developer_license_id",
 	URI:                "redirect_uris.uri",
 	EnabledAt:          "redirect_uris.enabled_at",
+	LastBlockHash:      "redirect_uris.last_block_hash",
+	DisabledAt:         "redirect_uris.disabled_at",
 }
 
 // Generated where
@@ -57,10 +68,14 @@ var RedirectURIWhere = struct {
 	DeveloperLicenseID whereHelperint
 	URI                whereHelperstring
 	EnabledAt          whereHelpertime_Time
+	LastBlockHash      whereHelpernull_Bytes
+	DisabledAt         whereHelpernull_Time
 }{
 	DeveloperLicenseID: whereHelperint{field: "\"identity_api\".\"redirect_uris\".\"developer_license_id\""},
 	URI:                whereHelperstring{field: "\"identity_api\".\"redirect_uris\".\"uri\""},
 	EnabledAt:          whereHelpertime_Time{field: "\"identity_api\".\"redirect_uris\".\"enabled_at\""},
+	LastBlockHash:      whereHelpernull_Bytes{field: "\"identity_api\".\"redirect_uris\".\"last_block_hash\""},
+	DisabledAt:         whereHelpernull_Time{field: "\"identity_api\".\"redirect_uris\".\"disabled_at\""},
 }
 
 // RedirectURIRels is where relationship names are stored.
@@ -100,10 +115,10 @@ func (r *redirectURIR) GetDeveloperLicense() *DeveloperLicense {
 type redirectURIL struct{}
 
 var (
-	redirectURIAllColumns            = []string{"developer_license_id", "uri", "enabled_at"}
+	redirectURIAllColumns            = []string{"developer_license_id", "uri", "enabled_at", "last_block_hash", "disabled_at"}
 	redirectURIColumnsWithoutDefault = []string{"developer_license_id", "uri", "enabled_at"}
-	redirectURIColumnsWithDefault    = []string{}
-	redirectURIPrimaryKeyColumns     = []string{"developer_license_id", "uri"}
+	redirectURIColumnsWithDefault    = []string{"last_block_hash", "disabled_at"}
+	redirectURIPrimaryKeyColumns     = []string{"developer_license_id", "uri", "enabled_at"}
 	redirectURIGeneratedColumns      = []string{}
 )
 
@@ -559,7 +574,7 @@ func (o *RedirectURI) SetDeveloperLicense(ctx context.Context, exec boil.Context
 		strmangle.SetParamNames("\"", "\"", 1, []string{"developer_license_id"}),
 		strmangle.WhereClause("\"", "\"", 2, redirectURIPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.DeveloperLicenseID, o.URI}
+	values := []interface{}{related.ID, o.DeveloperLicenseID, o.URI, o.EnabledAt}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -603,7 +618,7 @@ func RedirectUris(mods ...qm.QueryMod) redirectURIQuery {
 
 // FindRedirectURI retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindRedirectURI(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, uRI string, selectCols ...string) (*RedirectURI, error) {
+func FindRedirectURI(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, uRI string, enabledAt time.Time, selectCols ...string) (*RedirectURI, error) {
 	redirectURIObj := &RedirectURI{}
 
 	sel := "*"
@@ -611,10 +626,10 @@ func FindRedirectURI(ctx context.Context, exec boil.ContextExecutor, developerLi
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"identity_api\".\"redirect_uris\" where \"developer_license_id\"=$1 AND \"uri\"=$2", sel,
+		"select %s from \"identity_api\".\"redirect_uris\" where \"developer_license_id\"=$1 AND \"uri\"=$2 AND \"enabled_at\"=$3", sel,
 	)
 
-	q := queries.Raw(query, developerLicenseID, uRI)
+	q := queries.Raw(query, developerLicenseID, uRI, enabledAt)
 
 	err := q.Bind(ctx, exec, redirectURIObj)
 	if err != nil {
@@ -972,7 +987,7 @@ func (o *RedirectURI) Delete(ctx context.Context, exec boil.ContextExecutor) (in
 	}
 
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), redirectURIPrimaryKeyMapping)
-	sql := "DELETE FROM \"identity_api\".\"redirect_uris\" WHERE \"developer_license_id\"=$1 AND \"uri\"=$2"
+	sql := "DELETE FROM \"identity_api\".\"redirect_uris\" WHERE \"developer_license_id\"=$1 AND \"uri\"=$2 AND \"enabled_at\"=$3"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1069,7 +1084,7 @@ func (o RedirectURISlice) DeleteAll(ctx context.Context, exec boil.ContextExecut
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *RedirectURI) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindRedirectURI(ctx, exec, o.DeveloperLicenseID, o.URI)
+	ret, err := FindRedirectURI(ctx, exec, o.DeveloperLicenseID, o.URI, o.EnabledAt)
 	if err != nil {
 		return err
 	}
@@ -1108,16 +1123,16 @@ func (o *RedirectURISlice) ReloadAll(ctx context.Context, exec boil.ContextExecu
 }
 
 // RedirectURIExists checks if the RedirectURI row exists.
-func RedirectURIExists(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, uRI string) (bool, error) {
+func RedirectURIExists(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, uRI string, enabledAt time.Time) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"identity_api\".\"redirect_uris\" where \"developer_license_id\"=$1 AND \"uri\"=$2 limit 1)"
+	sql := "select exists(select 1 from \"identity_api\".\"redirect_uris\" where \"developer_license_id\"=$1 AND \"uri\"=$2 AND \"enabled_at\"=$3 limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, developerLicenseID, uRI)
+		fmt.Fprintln(writer, developerLicenseID, uRI, enabledAt)
 	}
-	row := exec.QueryRowContext(ctx, sql, developerLicenseID, uRI)
+	row := exec.QueryRowContext(ctx, sql, developerLicenseID, uRI, enabledAt)
 
 	err := row.Scan(&exists)
 	if err != nil {
@@ -1129,5 +1144,5 @@ func RedirectURIExists(ctx context.Context, exec boil.ContextExecutor, developer
 
 // Exists checks if the RedirectURI row exists.
 func (o *RedirectURI) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	return RedirectURIExists(ctx, exec, o.DeveloperLicenseID, o.URI)
+	return RedirectURIExists(ctx, exec, o.DeveloperLicenseID, o.URI, o.EnabledAt)
 }
@@ -35,6 +35,7 @@ type Reward struct {
 	SyntheticEarnings   types.Decimal `boil:"synthetic_earnings" json:"synthetic_earnings" toml:"synthetic_earnings" yaml:"synthetic_earnings"`
 	ReceivedByAddress   null.Bytes    `boil:"received_by_address" json:"received_by_address,omitempty" toml:"received_by_address" yaml:"received_by_address,omitempty"`
 	EarnedAt            time.Time     `boil:"earned_at" json:"earned_at" toml:"earned_at" yaml:"earned_at"`
+	LastBlockHash       null.Bytes    `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
 
 	R *rewardR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rewardL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -51,6 +52,7 @@ var RewardColumns = struct {
 	SyntheticEarnings   string
 	ReceivedByAddress   string
 	EarnedAt            string
+	LastBlockHash       string
 }{
 	IssuanceWeek:        "issuance_week",
 	VehicleID:           "vehicle_id",
@@ -62,6 +64,7 @@ var RewardColumns = struct {
 	SyntheticEarnings:   "synthetic_earnings",
 	ReceivedByAddress:   "received_by_address",
 	EarnedAt:            "earned_at",
+	LastBlockHash:       "last_block_hash",
 }
 
 var RewardTableColumns = struct {
@@ -75,6 +78,7 @@ var RewardTableColumns = struct {
 	SyntheticEarnings   string
 	ReceivedByAddress   string
 	EarnedAt            string
+	LastBlockHash       string
 }{
 	IssuanceWeek:        "rewards.issuance_week",
 	VehicleID:           "rewards.vehicle_id",
@@ -86,6 +90,7 @@ var RewardTableColumns = struct {
 	SyntheticEarnings:   "rewards.synthetic_earnings",
 	ReceivedByAddress:   "rewards.received_by_address",
 	EarnedAt:            "rewards.earned_at",
+	LastBlockHash:       "rewards.last_block_hash",
 }
 
 // Generated where
@@ -122,6 +127,7 @@ var RewardWhere = struct {
 	SyntheticEarnings   whereHelpertypes_Decimal
 	ReceivedByAddress   whereHelpernull_Bytes
 	EarnedAt            whereHelpertime_Time
+	LastBlockHash       whereHelpernull_Bytes
 }{
 	IssuanceWeek:        whereHelperint{field: "\"identity_api\".\"rewards\".\"issuance_week\""},
 	VehicleID:           whereHelperint{field: "\"identity_api\".\"rewards\".\"vehicle_id\""},
@@ -133,6 +139,7 @@ var RewardWhere = struct {
 	SyntheticEarnings:   whereHelpertypes_Decimal{field: "\"identity_api\".\"rewards\".\"synthetic_earnings\""},
 	ReceivedByAddress:   whereHelpernull_Bytes{field: "\"identity_api\".\"rewards\".\"received_by_address\""},
 	EarnedAt:            whereHelpertime_Time{field: "\"identity_api\".\"rewards\".\"earned_at\""},
+	LastBlockHash:       whereHelpernull_Bytes{field: "\"identity_api\".\"rewards\".\"last_block_hash\""},
 }
 
 // RewardRels is where relationship names are stored.
@@ -210,9 +217,9 @@ func (r *rewardR) GetVehicle() *Vehicle {
 type rewardL struct{}
 
 var (
-	rewardAllColumns            = []string{"issuance_week", "vehicle_id", "connection_streak", "streak_earnings", "aftermarket_token_id", "aftermarket_earnings", "synthetic_token_id", "synthetic_earnings", "received_by_address", "earned_at"}
+	rewardAllColumns            = []string{"issuance_week", "vehicle_id", "connection_streak", "streak_earnings", "aftermarket_token_id", "aftermarket_earnings", "synthetic_token_id", "synthetic_earnings", "received_by_address", "earned_at", "last_block_hash"}
 	rewardColumnsWithoutDefault = []string{"issuance_week", "vehicle_id", "earned_at"}
-	rewardColumnsWithDefault    = []string{"connection_streak", "streak_earnings", "aftermarket_token_id", "aftermarket_earnings", "synthetic_token_id", "synthetic_earnings", "received_by_address"}
+	rewardColumnsWithDefault    = []string{"connection_streak", "streak_earnings", "aftermarket_token_id", "aftermarket_earnings", "synthetic_token_id", "synthetic_earnings", "received_by_address", "last_block_hash"}
 	rewardPrimaryKeyColumns     = []string{"issuance_week", "vehicle_id"}
 	rewardGeneratedColumns      = []string{}
 )
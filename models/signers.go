@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,9 +24,11 @@ import (
 
 // Signer is an object representing the database table.
 type Signer struct {
-	DeveloperLicenseID int       `boil:"developer_license_id" json:"developer_license_id" toml:"developer_license_id" yaml:"developer_license_id"`
-	Signer             []byte    `boil:"signer" json:"signer" toml:"signer" yaml:"signer"`
-	EnabledAt          time.Time `boil:"enabled_at" json:"enabled_at" toml:"enabled_at" yaml:"enabled_at"`
+	DeveloperLicenseID int        `boil:"developer_license_id" json:"developer_license_id" toml:"developer_license_id" yaml:"developer_license_id"`
+	Signer             []byte     `boil:"signer" json:"signer" toml:"signer" yaml:"signer"`
+	EnabledAt          time.Time  `boil:"enabled_at" json:"enabled_at" toml:"enabled_at" yaml:"enabled_at"`
+	LastBlockHash      null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	DisabledAt         null.Time  `boil:"disabled_at" json:"disabled_at,omitempty" toml:"disabled_at" yaml:"disabled_at,omitempty"`
 
 	R *signerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L signerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -35,20 +38,28 @@ var SignerColumns = struct {
 	DeveloperLicenseID string
 	Signer             string
 	EnabledAt          string
+	LastBlockHash      string
+	DisabledAt         string
 }{
 	DeveloperLicenseID: "developer_license_id",
 	Signer:             "signer",
 	EnabledAt:          "enabled_at",
+	LastBlockHash:      "last_block_hash",
+	DisabledAt:         "disabled_at",
 }
 
 var SignerTableColumns = struct {
 	DeveloperLicenseID string
 	Signer             string
 	EnabledAt          string
+	LastBlockHash      string
+	DisabledAt         string
 }{
 	DeveloperLicenseID: "signers.developer_license_id",
 	Signer:             "signers.signer",
 	EnabledAt:          "signers.enabled_at",
+	LastBlockHash:      "signers.last_block_hash",
+	DisabledAt:         "signers.disabled_at",
 }
 
 // Generated where
@@ -57,10 +68,14 @@ var SignerWhere = struct {
 	DeveloperLicenseID whereHelperint
 	Signer             whereHelper__byte
 	EnabledAt          whereHelpertime_Time
+	LastBlockHash      whereHelpernull_Bytes
+	DisabledAt         whereHelpernull_Time
 }{
 	DeveloperLicenseID: whereHelperint{field: "\"identity_api\".\"signers\".\"developer_license_id\""},
 	Signer:             whereHelper__byte{field: "\"identity_api\".\"signers\".\"signer\""},
 	EnabledAt:          whereHelpertime_Time{field: "\"identity_api\".\"signers\".\"enabled_at\""},
+	LastBlockHash:      whereHelpernull_Bytes{field: "\"identity_api\".\"signers\".\"last_block_hash\""},
+	DisabledAt:         whereHelpernull_Time{field: "\"identity_api\".\"signers\".\"disabled_at\""},
 }
 
 // SignerRels is where relationship names are stored.
@@ -100,10 +115,10 @@ func (r *signerR) GetDeveloperLicense() *DeveloperLicense {
 type signerL struct{}
 
 var (
-	signerAllColumns            = []string{"developer_license_id", "signer", "enabled_at"}
+	signerAllColumns            = []string{"developer_license_id", "signer", "enabled_at", "last_block_hash", "disabled_at"}
 	signerColumnsWithoutDefault = []string{"developer_license_id", "signer", "enabled_at"}
-	signerColumnsWithDefault    = []string{}
-	signerPrimaryKeyColumns     = []string{"developer_license_id", "signer"}
+	signerColumnsWithDefault    = []string{"last_block_hash", "disabled_at"}
+	signerPrimaryKeyColumns     = []string{"developer_license_id", "signer", "enabled_at"}
 	signerGeneratedColumns      = []string{}
 )
 
@@ -559,7 +574,7 @@ func (o *Signer) SetDeveloperLicense(ctx context.Context, exec boil.ContextExecu
 		strmangle.SetParamNames("\"", "\"", 1, []string{"developer_license_id"}),
 		strmangle.WhereClause("\"", "\"", 2, signerPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.DeveloperLicenseID, o.Signer}
+	values := []interface{}{related.ID, o.DeveloperLicenseID, o.Signer, o.EnabledAt}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -603,7 +618,7 @@ func Signers(mods ...qm.QueryMod) signerQuery {
 
 // FindSigner retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindSigner(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, signer []byte, selectCols ...string) (*Signer, error) {
+func FindSigner(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, signer []byte, enabledAt time.Time, selectCols ...string) (*Signer, error) {
 	signerObj := &Signer{}
 
 	sel := "*"
@@ -611,10 +626,10 @@ func FindSigner(ctx context.Context, exec boil.ContextExecutor, developerLicense
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"identity_api\".\"signers\" where \"developer_license_id\"=$1 AND \"signer\"=$2", sel,
+		"select %s from \"identity_api\".\"signers\" where \"developer_license_id\"=$1 AND \"signer\"=$2 AND \"enabled_at\"=$3", sel,
 	)
 
-	q := queries.Raw(query, developerLicenseID, signer)
+	q := queries.Raw(query, developerLicenseID, signer, enabledAt)
 
 	err := q.Bind(ctx, exec, signerObj)
 	if err != nil {
@@ -972,7 +987,7 @@ func (o *Signer) Delete(ctx context.Context, exec boil.ContextExecutor) (int64,
 	}
 
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), signerPrimaryKeyMapping)
-	sql := "DELETE FROM \"identity_api\".\"signers\" WHERE \"developer_license_id\"=$1 AND \"signer\"=$2"
+	sql := "DELETE FROM \"identity_api\".\"signers\" WHERE \"developer_license_id\"=$1 AND \"signer\"=$2 AND \"enabled_at\"=$3"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1069,7 +1084,7 @@ func (o SignerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *Signer) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindSigner(ctx, exec, o.DeveloperLicenseID, o.Signer)
+	ret, err := FindSigner(ctx, exec, o.DeveloperLicenseID, o.Signer, o.EnabledAt)
 	if err != nil {
 		return err
 	}
@@ -1108,16 +1123,16 @@ func (o *SignerSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor)
 }
 
 // SignerExists checks if the Signer row exists.
-func SignerExists(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, signer []byte) (bool, error) {
+func SignerExists(ctx context.Context, exec boil.ContextExecutor, developerLicenseID int, signer []byte, enabledAt time.Time) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"identity_api\".\"signers\" where \"developer_license_id\"=$1 AND \"signer\"=$2 limit 1)"
+	sql := "select exists(select 1 from \"identity_api\".\"signers\" where \"developer_license_id\"=$1 AND \"signer\"=$2 AND \"enabled_at\"=$3 limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, developerLicenseID, signer)
+		fmt.Fprintln(writer, developerLicenseID, signer, enabledAt)
 	}
-	row := exec.QueryRowContext(ctx, sql, developerLicenseID, signer)
+	row := exec.QueryRowContext(ctx, sql, developerLicenseID, signer, enabledAt)
 
 	err := row.Scan(&exists)
 	if err != nil {
@@ -1129,5 +1144,5 @@ func SignerExists(ctx context.Context, exec boil.ContextExecutor, developerLicen
 
 // Exists checks if the Signer row exists.
 func (o *Signer) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	return SignerExists(ctx, exec, o.DeveloperLicenseID, o.Signer)
+	return SignerExists(ctx, exec, o.DeveloperLicenseID, o.Signer, o.EnabledAt)
 }
@@ -25,86 +25,107 @@ import (
 
 // Stake is an object representing the database table.
 type Stake struct {
-	ID          int           `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Owner       []byte        `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
-	Level       int           `boil:"level" json:"level" toml:"level" yaml:"level"`
-	Points      int           `boil:"points" json:"points" toml:"points" yaml:"points"`
-	Amount      types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	VehicleID   null.Int      `boil:"vehicle_id" json:"vehicle_id,omitempty" toml:"vehicle_id" yaml:"vehicle_id,omitempty"`
-	StakedAt    time.Time     `boil:"staked_at" json:"staked_at" toml:"staked_at" yaml:"staked_at"`
-	EndsAt      time.Time     `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
-	WithdrawnAt null.Time     `boil:"withdrawn_at" json:"withdrawn_at,omitempty" toml:"withdrawn_at" yaml:"withdrawn_at,omitempty"`
+	ID              int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Owner           []byte        `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	Level           int           `boil:"level" json:"level" toml:"level" yaml:"level"`
+	Points          int           `boil:"points" json:"points" toml:"points" yaml:"points"`
+	Amount          types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	VehicleID       null.Int      `boil:"vehicle_id" json:"vehicle_id,omitempty" toml:"vehicle_id" yaml:"vehicle_id,omitempty"`
+	StakedAt        time.Time     `boil:"staked_at" json:"staked_at" toml:"staked_at" yaml:"staked_at"`
+	EndsAt          time.Time     `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	WithdrawnAt     null.Time     `boil:"withdrawn_at" json:"withdrawn_at,omitempty" toml:"withdrawn_at" yaml:"withdrawn_at,omitempty"`
+	LastBlockHash   null.Bytes    `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber null.Int64    `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex    null.Int      `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
 
 	R *stakeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L stakeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var StakeColumns = struct {
-	ID          string
-	Owner       string
-	Level       string
-	Points      string
-	Amount      string
-	VehicleID   string
-	StakedAt    string
-	EndsAt      string
-	WithdrawnAt string
+	ID              string
+	Owner           string
+	Level           string
+	Points          string
+	Amount          string
+	VehicleID       string
+	StakedAt        string
+	EndsAt          string
+	WithdrawnAt     string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	ID:          "id",
-	Owner:       "owner",
-	Level:       "level",
-	Points:      "points",
-	Amount:      "amount",
-	VehicleID:   "vehicle_id",
-	StakedAt:    "staked_at",
-	EndsAt:      "ends_at",
-	WithdrawnAt: "withdrawn_at",
+	ID:              "id",
+	Owner:           "owner",
+	Level:           "level",
+	Points:          "points",
+	Amount:          "amount",
+	VehicleID:       "vehicle_id",
+	StakedAt:        "staked_at",
+	EndsAt:          "ends_at",
+	WithdrawnAt:     "withdrawn_at",
+	LastBlockHash:   "last_block_hash",
+	LastBlockNumber: "last_block_number",
+	LastLogIndex:    "last_log_index",
 }
 
 var StakeTableColumns = struct {
-	ID          string
-	Owner       string
-	Level       string
-	Points      string
-	Amount      string
-	VehicleID   string
-	StakedAt    string
-	EndsAt      string
-	WithdrawnAt string
+	ID              string
+	Owner           string
+	Level           string
+	Points          string
+	Amount          string
+	VehicleID       string
+	StakedAt        string
+	EndsAt          string
+	WithdrawnAt     string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	ID:          "stakes.id",
-	Owner:       "stakes.owner",
-	Level:       "stakes.level",
-	Points:      "stakes.points",
-	Amount:      "stakes.amount",
-	VehicleID:   "stakes.vehicle_id",
-	StakedAt:    "stakes.staked_at",
-	EndsAt:      "stakes.ends_at",
-	WithdrawnAt: "stakes.withdrawn_at",
+	ID:              "stakes.id",
+	Owner:           "stakes.owner",
+	Level:           "stakes.level",
+	Points:          "stakes.points",
+	Amount:          "stakes.amount",
+	VehicleID:       "stakes.vehicle_id",
+	StakedAt:        "stakes.staked_at",
+	EndsAt:          "stakes.ends_at",
+	WithdrawnAt:     "stakes.withdrawn_at",
+	LastBlockHash:   "stakes.last_block_hash",
+	LastBlockNumber: "stakes.last_block_number",
+	LastLogIndex:    "stakes.last_log_index",
 }
 
 // Generated where
 
 var StakeWhere = struct {
-	ID          whereHelperint
-	Owner       whereHelper__byte
-	Level       whereHelperint
-	Points      whereHelperint
-	Amount      whereHelpertypes_Decimal
-	VehicleID   whereHelpernull_Int
-	StakedAt    whereHelpertime_Time
-	EndsAt      whereHelpertime_Time
-	WithdrawnAt whereHelpernull_Time
+	ID              whereHelperint
+	Owner           whereHelper__byte
+	Level           whereHelperint
+	Points          whereHelperint
+	Amount          whereHelpertypes_Decimal
+	VehicleID       whereHelpernull_Int
+	StakedAt        whereHelpertime_Time
+	EndsAt          whereHelpertime_Time
+	WithdrawnAt     whereHelpernull_Time
+	LastBlockHash   whereHelpernull_Bytes
+	LastBlockNumber whereHelpernull_Int64
+	LastLogIndex    whereHelpernull_Int
 }{
-	ID:          whereHelperint{field: "\"identity_api\".\"stakes\".\"id\""},
-	Owner:       whereHelper__byte{field: "\"identity_api\".\"stakes\".\"owner\""},
-	Level:       whereHelperint{field: "\"identity_api\".\"stakes\".\"level\""},
-	Points:      whereHelperint{field: "\"identity_api\".\"stakes\".\"points\""},
-	Amount:      whereHelpertypes_Decimal{field: "\"identity_api\".\"stakes\".\"amount\""},
-	VehicleID:   whereHelpernull_Int{field: "\"identity_api\".\"stakes\".\"vehicle_id\""},
-	StakedAt:    whereHelpertime_Time{field: "\"identity_api\".\"stakes\".\"staked_at\""},
-	EndsAt:      whereHelpertime_Time{field: "\"identity_api\".\"stakes\".\"ends_at\""},
-	WithdrawnAt: whereHelpernull_Time{field: "\"identity_api\".\"stakes\".\"withdrawn_at\""},
+	ID:              whereHelperint{field: "\"identity_api\".\"stakes\".\"id\""},
+	Owner:           whereHelper__byte{field: "\"identity_api\".\"stakes\".\"owner\""},
+	Level:           whereHelperint{field: "\"identity_api\".\"stakes\".\"level\""},
+	Points:          whereHelperint{field: "\"identity_api\".\"stakes\".\"points\""},
+	Amount:          whereHelpertypes_Decimal{field: "\"identity_api\".\"stakes\".\"amount\""},
+	VehicleID:       whereHelpernull_Int{field: "\"identity_api\".\"stakes\".\"vehicle_id\""},
+	StakedAt:        whereHelpertime_Time{field: "\"identity_api\".\"stakes\".\"staked_at\""},
+	EndsAt:          whereHelpertime_Time{field: "\"identity_api\".\"stakes\".\"ends_at\""},
+	WithdrawnAt:     whereHelpernull_Time{field: "\"identity_api\".\"stakes\".\"withdrawn_at\""},
+	LastBlockHash:   whereHelpernull_Bytes{field: "\"identity_api\".\"stakes\".\"last_block_hash\""},
+	LastBlockNumber: whereHelpernull_Int64{field: "\"identity_api\".\"stakes\".\"last_block_number\""},
+	LastLogIndex:    whereHelpernull_Int{field: "\"identity_api\".\"stakes\".\"last_log_index\""},
 }
 
 // StakeRels is where relationship names are stored.
@@ -144,9 +165,9 @@ func (r *stakeR) GetVehicle() *Vehicle {
 type stakeL struct{}
 
 var (
-	stakeAllColumns            = []string{"id", "owner", "level", "points", "amount", "vehicle_id", "staked_at", "ends_at", "withdrawn_at"}
+	stakeAllColumns            = []string{"id", "owner", "level", "points", "amount", "vehicle_id", "staked_at", "ends_at", "withdrawn_at", "last_block_hash", "last_block_number", "last_log_index"}
 	stakeColumnsWithoutDefault = []string{"id", "owner", "level", "points", "amount", "staked_at", "ends_at"}
-	stakeColumnsWithDefault    = []string{"vehicle_id", "withdrawn_at"}
+	stakeColumnsWithDefault    = []string{"vehicle_id", "withdrawn_at", "last_block_hash", "last_block_number", "last_log_index"}
 	stakePrimaryKeyColumns     = []string{"id"}
 	stakeGeneratedColumns      = []string{}
 )
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,65 +24,72 @@ import (
 
 // StorageNode is an object representing the database table.
 type StorageNode struct {
-	ID       []byte    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Label    string    `boil:"label" json:"label" toml:"label" yaml:"label"`
-	Address  []byte    `boil:"address" json:"address" toml:"address" yaml:"address"`
-	Owner    []byte    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
-	URI      string    `boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
-	MintedAt time.Time `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	ID            []byte     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Label         string     `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Address       []byte     `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Owner         []byte     `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	URI           string     `boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
+	MintedAt      time.Time  `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	LastBlockHash null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
 
 	R *storageNodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L storageNodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var StorageNodeColumns = struct {
-	ID       string
-	Label    string
-	Address  string
-	Owner    string
-	URI      string
-	MintedAt string
+	ID            string
+	Label         string
+	Address       string
+	Owner         string
+	URI           string
+	MintedAt      string
+	LastBlockHash string
 }{
-	ID:       "id",
-	Label:    "label",
-	Address:  "address",
-	Owner:    "owner",
-	URI:      "uri",
-	MintedAt: "minted_at",
+	ID:            "id",
+	Label:         "label",
+	Address:       "address",
+	Owner:         "owner",
+	URI:           "uri",
+	MintedAt:      "minted_at",
+	LastBlockHash: "last_block_hash",
 }
 
 var StorageNodeTableColumns = struct {
-	ID       string
-	Label    string
-	Address  string
-	Owner    string
-	URI      string
-	MintedAt string
+	ID            string
+	Label         string
+	Address       string
+	Owner         string
+	URI           string
+	MintedAt      string
+	LastBlockHash string
 }{
-	ID:       "storage_nodes.id",
-	Label:    "storage_nodes.label",
-	Address:  "storage_nodes.address",
-	Owner:    "storage_nodes.owner",
-	URI:      "storage_nodes.uri",
-	MintedAt: "storage_nodes.minted_at",
+	ID:            "storage_nodes.id",
+	Label:         "storage_nodes.label",
+	Address:       "storage_nodes.address",
+	Owner:         "storage_nodes.owner",
+	URI:           "storage_nodes.uri",
+	MintedAt:      "storage_nodes.minted_at",
+	LastBlockHash: "storage_nodes.last_block_hash",
 }
 
 // Generated where
 
 var StorageNodeWhere = struct {
-	ID       whereHelper__byte
-	Label    whereHelperstring
-	Address  whereHelper__byte
-	Owner    whereHelper__byte
-	URI      whereHelperstring
-	MintedAt whereHelpertime_Time
+	ID            whereHelper__byte
+	Label         whereHelperstring
+	Address       whereHelper__byte
+	Owner         whereHelper__byte
+	URI           whereHelperstring
+	MintedAt      whereHelpertime_Time
+	LastBlockHash whereHelpernull_Bytes
 }{
-	ID:       whereHelper__byte{field: "\"identity_api\".\"storage_nodes\".\"id\""},
-	Label:    whereHelperstring{field: "\"identity_api\".\"storage_nodes\".\"label\""},
-	Address:  whereHelper__byte{field: "\"identity_api\".\"storage_nodes\".\"address\""},
-	Owner:    whereHelper__byte{field: "\"identity_api\".\"storage_nodes\".\"owner\""},
-	URI:      whereHelperstring{field: "\"identity_api\".\"storage_nodes\".\"uri\""},
-	MintedAt: whereHelpertime_Time{field: "\"identity_api\".\"storage_nodes\".\"minted_at\""},
+	ID:            whereHelper__byte{field: "\"identity_api\".\"storage_nodes\".\"id\""},
+	Label:         whereHelperstring{field: "\"identity_api\".\"storage_nodes\".\"label\""},
+	Address:       whereHelper__byte{field: "\"identity_api\".\"storage_nodes\".\"address\""},
+	Owner:         whereHelper__byte{field: "\"identity_api\".\"storage_nodes\".\"owner\""},
+	URI:           whereHelperstring{field: "\"identity_api\".\"storage_nodes\".\"uri\""},
+	MintedAt:      whereHelpertime_Time{field: "\"identity_api\".\"storage_nodes\".\"minted_at\""},
+	LastBlockHash: whereHelpernull_Bytes{field: "\"identity_api\".\"storage_nodes\".\"last_block_hash\""},
 }
 
 // StorageNodeRels is where relationship names are stored.
@@ -121,9 +129,9 @@ func (r *storageNodeR) GetVehicles() VehicleSlice {
 type storageNodeL struct{}
 
 var (
-	storageNodeAllColumns            = []string{"id", "label", "address", "owner", "uri", "minted_at"}
+	storageNodeAllColumns            = []string{"id", "label", "address", "owner", "uri", "minted_at", "last_block_hash"}
 	storageNodeColumnsWithoutDefault = []string{"id", "label", "address", "owner", "uri", "minted_at"}
-	storageNodeColumnsWithDefault    = []string{}
+	storageNodeColumnsWithDefault    = []string{"last_block_hash"}
 	storageNodePrimaryKeyColumns     = []string{"id"}
 	storageNodeGeneratedColumns      = []string{}
 )
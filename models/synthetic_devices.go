@@ -30,6 +30,7 @@ type SyntheticDevice struct {
 	DeviceAddress []byte     `boil:"device_address" json:"device_address" toml:"device_address" yaml:"device_address"`
 	MintedAt      time.Time  `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
 	ConnectionID  null.Bytes `boil:"connection_id" json:"connection_id,omitempty" toml:"connection_id" yaml:"connection_id,omitempty"`
+	LastBlockHash null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
 
 	R *syntheticDeviceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L syntheticDeviceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +43,7 @@ var SyntheticDeviceColumns = struct {
 	DeviceAddress string
 	MintedAt      string
 	ConnectionID  string
+	LastBlockHash string
 }{
 	ID:            "id",
 	IntegrationID: "integration_id",
@@ -49,6 +51,7 @@ var SyntheticDeviceColumns = struct {
 	DeviceAddress: "device_address",
 	MintedAt:      "minted_at",
 	ConnectionID:  "connection_id",
+	LastBlockHash: "last_block_hash",
 }
 
 var SyntheticDeviceTableColumns = struct {
@@ -58,6 +61,7 @@ var SyntheticDeviceTableColumns = struct {
 	DeviceAddress string
 	MintedAt      string
 	ConnectionID  string
+	LastBlockHash string
 }{
 	ID:            "synthetic_devices.id",
 	IntegrationID: "synthetic_devices.integration_id",
@@ -65,6 +69,7 @@ var SyntheticDeviceTableColumns = struct {
 	DeviceAddress: "synthetic_devices.device_address",
 	MintedAt:      "synthetic_devices.minted_at",
 	ConnectionID:  "synthetic_devices.connection_id",
+	LastBlockHash: "synthetic_devices.last_block_hash",
 }
 
 // Generated where
@@ -76,6 +81,7 @@ var SyntheticDeviceWhere = struct {
 	DeviceAddress whereHelper__byte
 	MintedAt      whereHelpertime_Time
 	ConnectionID  whereHelpernull_Bytes
+	LastBlockHash whereHelpernull_Bytes
 }{
 	ID:            whereHelperint{field: "\"identity_api\".\"synthetic_devices\".\"id\""},
 	IntegrationID: whereHelperint{field: "\"identity_api\".\"synthetic_devices\".\"integration_id\""},
@@ -83,6 +89,7 @@ var SyntheticDeviceWhere = struct {
 	DeviceAddress: whereHelper__byte{field: "\"identity_api\".\"synthetic_devices\".\"device_address\""},
 	MintedAt:      whereHelpertime_Time{field: "\"identity_api\".\"synthetic_devices\".\"minted_at\""},
 	ConnectionID:  whereHelpernull_Bytes{field: "\"identity_api\".\"synthetic_devices\".\"connection_id\""},
+	LastBlockHash: whereHelpernull_Bytes{field: "\"identity_api\".\"synthetic_devices\".\"last_block_hash\""},
 }
 
 // SyntheticDeviceRels is where relationship names are stored.
@@ -160,9 +167,9 @@ func (r *syntheticDeviceR) GetSyntheticTokenRewards() RewardSlice {
 type syntheticDeviceL struct{}
 
 var (
-	syntheticDeviceAllColumns            = []string{"id", "integration_id", "vehicle_id", "device_address", "minted_at", "connection_id"}
+	syntheticDeviceAllColumns            = []string{"id", "integration_id", "vehicle_id", "device_address", "minted_at", "connection_id", "last_block_hash"}
 	syntheticDeviceColumnsWithoutDefault = []string{"id", "integration_id", "vehicle_id", "device_address", "minted_at"}
-	syntheticDeviceColumnsWithDefault    = []string{"connection_id"}
+	syntheticDeviceColumnsWithDefault    = []string{"connection_id", "last_block_hash"}
 	syntheticDevicePrimaryKeyColumns     = []string{"id"}
 	syntheticDeviceGeneratedColumns      = []string{}
 )
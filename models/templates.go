@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,65 +24,72 @@ import (
 
 // Template is an object representing the database table.
 type Template struct {
-	ID          []byte    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Creator     []byte    `boil:"creator" json:"creator" toml:"creator" yaml:"creator"`
-	Asset       []byte    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
-	Permissions string    `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
-	Cid         string    `boil:"cid" json:"cid" toml:"cid" yaml:"cid"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID            []byte     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Creator       []byte     `boil:"creator" json:"creator" toml:"creator" yaml:"creator"`
+	Asset         []byte     `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Permissions   string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
+	Cid           string     `boil:"cid" json:"cid" toml:"cid" yaml:"cid"`
+	CreatedAt     time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LastBlockHash null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
 
 	R *templateR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L templateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TemplateColumns = struct {
-	ID          string
-	Creator     string
-	Asset       string
-	Permissions string
-	Cid         string
-	CreatedAt   string
+	ID            string
+	Creator       string
+	Asset         string
+	Permissions   string
+	Cid           string
+	CreatedAt     string
+	LastBlockHash string
 }{
-	ID:          "id",
-	Creator:     "creator",
-	Asset:       "asset",
-	Permissions: "permissions",
-	Cid:         "cid",
-	CreatedAt:   "created_at",
+	ID:            "id",
+	Creator:       "creator",
+	Asset:         "asset",
+	Permissions:   "permissions",
+	Cid:           "cid",
+	CreatedAt:     "created_at",
+	LastBlockHash: "last_block_hash",
 }
 
 var TemplateTableColumns = struct {
-	ID          string
-	Creator     string
-	Asset       string
-	Permissions string
-	Cid         string
-	CreatedAt   string
+	ID            string
+	Creator       string
+	Asset         string
+	Permissions   string
+	Cid           string
+	CreatedAt     string
+	LastBlockHash string
 }{
-	ID:          "templates.id",
-	Creator:     "templates.creator",
-	Asset:       "templates.asset",
-	Permissions: "templates.permissions",
-	Cid:         "templates.cid",
-	CreatedAt:   "templates.created_at",
+	ID:            "templates.id",
+	Creator:       "templates.creator",
+	Asset:         "templates.asset",
+	Permissions:   "templates.permissions",
+	Cid:           "templates.cid",
+	CreatedAt:     "templates.created_at",
+	LastBlockHash: "templates.last_block_hash",
 }
 
 // Generated where
 
 var TemplateWhere = struct {
-	ID          whereHelper__byte
-	Creator     whereHelper__byte
-	Asset       whereHelper__byte
-	Permissions whereHelperstring
-	Cid         whereHelperstring
-	CreatedAt   whereHelpertime_Time
+	ID            whereHelper__byte
+	Creator       whereHelper__byte
+	Asset         whereHelper__byte
+	Permissions   whereHelperstring
+	Cid           whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	LastBlockHash whereHelpernull_Bytes
 }{
-	ID:          whereHelper__byte{field: "\"identity_api\".\"templates\".\"id\""},
-	Creator:     whereHelper__byte{field: "\"identity_api\".\"templates\".\"creator\""},
-	Asset:       whereHelper__byte{field: "\"identity_api\".\"templates\".\"asset\""},
-	Permissions: whereHelperstring{field: "\"identity_api\".\"templates\".\"permissions\""},
-	Cid:         whereHelperstring{field: "\"identity_api\".\"templates\".\"cid\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"identity_api\".\"templates\".\"created_at\""},
+	ID:            whereHelper__byte{field: "\"identity_api\".\"templates\".\"id\""},
+	Creator:       whereHelper__byte{field: "\"identity_api\".\"templates\".\"creator\""},
+	Asset:         whereHelper__byte{field: "\"identity_api\".\"templates\".\"asset\""},
+	Permissions:   whereHelperstring{field: "\"identity_api\".\"templates\".\"permissions\""},
+	Cid:           whereHelperstring{field: "\"identity_api\".\"templates\".\"cid\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"identity_api\".\"templates\".\"created_at\""},
+	LastBlockHash: whereHelpernull_Bytes{field: "\"identity_api\".\"templates\".\"last_block_hash\""},
 }
 
 // TemplateRels is where relationship names are stored.
@@ -121,9 +129,9 @@ func (r *templateR) GetVehicleSacds() VehicleSacdSlice {
 type templateL struct{}
 
 var (
-	templateAllColumns            = []string{"id", "creator", "asset", "permissions", "cid", "created_at"}
+	templateAllColumns            = []string{"id", "creator", "asset", "permissions", "cid", "created_at", "last_block_hash"}
 	templateColumnsWithoutDefault = []string{"id", "creator", "asset", "permissions", "cid", "created_at"}
-	templateColumnsWithDefault    = []string{}
+	templateColumnsWithDefault    = []string{"last_block_hash"}
 	templatePrimaryKeyColumns     = []string{"id"}
 	templateGeneratedColumns      = []string{}
 )
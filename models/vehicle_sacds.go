@@ -24,72 +24,93 @@ import (
 
 // VehicleSacd is an object representing the database table.
 type VehicleSacd struct {
-	VehicleID   int        `boil:"vehicle_id" json:"vehicle_id" toml:"vehicle_id" yaml:"vehicle_id"`
-	Grantee     []byte     `boil:"grantee" json:"grantee" toml:"grantee" yaml:"grantee"`
-	Permissions string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
-	Source      string     `boil:"source" json:"source" toml:"source" yaml:"source"`
-	CreatedAt   time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	ExpiresAt   time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
-	TemplateID  null.Bytes `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
+	VehicleID       int        `boil:"vehicle_id" json:"vehicle_id" toml:"vehicle_id" yaml:"vehicle_id"`
+	Grantee         []byte     `boil:"grantee" json:"grantee" toml:"grantee" yaml:"grantee"`
+	Permissions     string     `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
+	Source          string     `boil:"source" json:"source" toml:"source" yaml:"source"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt       time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	TemplateID      null.Bytes `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
+	LastBlockHash   null.Bytes `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber null.Int64 `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex    null.Int   `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
 
 	R *vehicleSacdR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L vehicleSacdL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var VehicleSacdColumns = struct {
-	VehicleID   string
-	Grantee     string
-	Permissions string
-	Source      string
-	CreatedAt   string
-	ExpiresAt   string
-	TemplateID  string
+	VehicleID       string
+	Grantee         string
+	Permissions     string
+	Source          string
+	CreatedAt       string
+	ExpiresAt       string
+	TemplateID      string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	VehicleID:   "vehicle_id",
-	Grantee:     "grantee",
-	Permissions: "permissions",
-	Source:      "source",
-	CreatedAt:   "created_at",
-	ExpiresAt:   "expires_at",
-	TemplateID:  "template_id",
+	VehicleID:       "vehicle_id",
+	Grantee:         "grantee",
+	Permissions:     "permissions",
+	Source:          "source",
+	CreatedAt:       "created_at",
+	ExpiresAt:       "expires_at",
+	TemplateID:      "template_id",
+	LastBlockHash:   "last_block_hash",
+	LastBlockNumber: "last_block_number",
+	LastLogIndex:    "last_log_index",
 }
 
 var VehicleSacdTableColumns = struct {
-	VehicleID   string
-	Grantee     string
-	Permissions string
-	Source      string
-	CreatedAt   string
-	ExpiresAt   string
-	TemplateID  string
+	VehicleID       string
+	Grantee         string
+	Permissions     string
+	Source          string
+	CreatedAt       string
+	ExpiresAt       string
+	TemplateID      string
+	LastBlockHash   string
+	LastBlockNumber string
+	LastLogIndex    string
 }{
-	VehicleID:   "vehicle_sacds.vehicle_id",
-	Grantee:     "vehicle_sacds.grantee",
-	Permissions: "vehicle_sacds.permissions",
-	Source:      "vehicle_sacds.source",
-	CreatedAt:   "vehicle_sacds.created_at",
-	ExpiresAt:   "vehicle_sacds.expires_at",
-	TemplateID:  "vehicle_sacds.template_id",
+	VehicleID:       "vehicle_sacds.vehicle_id",
+	Grantee:         "vehicle_sacds.grantee",
+	Permissions:     "vehicle_sacds.permissions",
+	Source:          "vehicle_sacds.source",
+	CreatedAt:       "vehicle_sacds.created_at",
+	ExpiresAt:       "vehicle_sacds.expires_at",
+	TemplateID:      "vehicle_sacds.template_id",
+	LastBlockHash:   "vehicle_sacds.last_block_hash",
+	LastBlockNumber: "vehicle_sacds.last_block_number",
+	LastLogIndex:    "vehicle_sacds.last_log_index",
 }
 
 // Generated where
 
 var VehicleSacdWhere = struct {
-	VehicleID   whereHelperint
-	Grantee     whereHelper__byte
-	Permissions whereHelperstring
-	Source      whereHelperstring
-	CreatedAt   whereHelpertime_Time
-	ExpiresAt   whereHelpertime_Time
-	TemplateID  whereHelpernull_Bytes
+	VehicleID       whereHelperint
+	Grantee         whereHelper__byte
+	Permissions     whereHelperstring
+	Source          whereHelperstring
+	CreatedAt       whereHelpertime_Time
+	ExpiresAt       whereHelpertime_Time
+	TemplateID      whereHelpernull_Bytes
+	LastBlockHash   whereHelpernull_Bytes
+	LastBlockNumber whereHelpernull_Int64
+	LastLogIndex    whereHelpernull_Int
 }{
-	VehicleID:   whereHelperint{field: "\"identity_api\".\"vehicle_sacds\".\"vehicle_id\""},
-	Grantee:     whereHelper__byte{field: "\"identity_api\".\"vehicle_sacds\".\"grantee\""},
-	Permissions: whereHelperstring{field: "\"identity_api\".\"vehicle_sacds\".\"permissions\""},
-	Source:      whereHelperstring{field: "\"identity_api\".\"vehicle_sacds\".\"source\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"identity_api\".\"vehicle_sacds\".\"created_at\""},
-	ExpiresAt:   whereHelpertime_Time{field: "\"identity_api\".\"vehicle_sacds\".\"expires_at\""},
-	TemplateID:  whereHelpernull_Bytes{field: "\"identity_api\".\"vehicle_sacds\".\"template_id\""},
+	VehicleID:       whereHelperint{field: "\"identity_api\".\"vehicle_sacds\".\"vehicle_id\""},
+	Grantee:         whereHelper__byte{field: "\"identity_api\".\"vehicle_sacds\".\"grantee\""},
+	Permissions:     whereHelperstring{field: "\"identity_api\".\"vehicle_sacds\".\"permissions\""},
+	Source:          whereHelperstring{field: "\"identity_api\".\"vehicle_sacds\".\"source\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"identity_api\".\"vehicle_sacds\".\"created_at\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"identity_api\".\"vehicle_sacds\".\"expires_at\""},
+	TemplateID:      whereHelpernull_Bytes{field: "\"identity_api\".\"vehicle_sacds\".\"template_id\""},
+	LastBlockHash:   whereHelpernull_Bytes{field: "\"identity_api\".\"vehicle_sacds\".\"last_block_hash\""},
+	LastBlockNumber: whereHelpernull_Int64{field: "\"identity_api\".\"vehicle_sacds\".\"last_block_number\""},
+	LastLogIndex:    whereHelpernull_Int{field: "\"identity_api\".\"vehicle_sacds\".\"last_log_index\""},
 }
 
 // VehicleSacdRels is where relationship names are stored.
@@ -148,9 +169,9 @@ func (r *vehicleSacdR) GetVehicle() *Vehicle {
 type vehicleSacdL struct{}
 
 var (
-	vehicleSacdAllColumns            = []string{"vehicle_id", "grantee", "permissions", "source", "created_at", "expires_at", "template_id"}
+	vehicleSacdAllColumns            = []string{"vehicle_id", "grantee", "permissions", "source", "created_at", "expires_at", "template_id", "last_block_hash", "last_block_number", "last_log_index"}
 	vehicleSacdColumnsWithoutDefault = []string{"vehicle_id", "grantee", "permissions", "source", "created_at", "expires_at"}
-	vehicleSacdColumnsWithDefault    = []string{"template_id"}
+	vehicleSacdColumnsWithDefault    = []string{"template_id", "last_block_hash", "last_block_number", "last_log_index"}
 	vehicleSacdPrimaryKeyColumns     = []string{"vehicle_id", "grantee"}
 	vehicleSacdGeneratedColumns      = []string{}
 )
@@ -24,93 +24,128 @@ import (
 
 // Vehicle is an object representing the database table.
 type Vehicle struct {
-	ID                 int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	OwnerAddress       []byte      `boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
-	Make               null.String `boil:"make" json:"make,omitempty" toml:"make" yaml:"make,omitempty"`
-	Model              null.String `boil:"model" json:"model,omitempty" toml:"model" yaml:"model,omitempty"`
-	Year               null.Int    `boil:"year" json:"year,omitempty" toml:"year" yaml:"year,omitempty"`
-	MintedAt           time.Time   `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
-	ManufacturerID     int         `boil:"manufacturer_id" json:"manufacturer_id" toml:"manufacturer_id" yaml:"manufacturer_id"`
-	ImageURI           null.String `boil:"image_uri" json:"image_uri,omitempty" toml:"image_uri" yaml:"image_uri,omitempty"`
-	DeviceDefinitionID null.String `boil:"device_definition_id" json:"device_definition_id,omitempty" toml:"device_definition_id" yaml:"device_definition_id,omitempty"`
-	StorageNodeID      null.Bytes  `boil:"storage_node_id" json:"storage_node_id,omitempty" toml:"storage_node_id" yaml:"storage_node_id,omitempty"`
+	ID                  int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OwnerAddress        []byte      `boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
+	Make                null.String `boil:"make" json:"make,omitempty" toml:"make" yaml:"make,omitempty"`
+	Model               null.String `boil:"model" json:"model,omitempty" toml:"model" yaml:"model,omitempty"`
+	Year                null.Int    `boil:"year" json:"year,omitempty" toml:"year" yaml:"year,omitempty"`
+	MintedAt            time.Time   `boil:"minted_at" json:"minted_at" toml:"minted_at" yaml:"minted_at"`
+	ManufacturerID      int         `boil:"manufacturer_id" json:"manufacturer_id" toml:"manufacturer_id" yaml:"manufacturer_id"`
+	ImageURI            null.String `boil:"image_uri" json:"image_uri,omitempty" toml:"image_uri" yaml:"image_uri,omitempty"`
+	DeviceDefinitionID  null.String `boil:"device_definition_id" json:"device_definition_id,omitempty" toml:"device_definition_id" yaml:"device_definition_id,omitempty"`
+	StorageNodeID       null.Bytes  `boil:"storage_node_id" json:"storage_node_id,omitempty" toml:"storage_node_id" yaml:"storage_node_id,omitempty"`
+	LastBlockHash       null.Bytes  `boil:"last_block_hash" json:"last_block_hash,omitempty" toml:"last_block_hash" yaml:"last_block_hash,omitempty"`
+	LastBlockNumber     null.Int64  `boil:"last_block_number" json:"last_block_number,omitempty" toml:"last_block_number" yaml:"last_block_number,omitempty"`
+	LastLogIndex        null.Int    `boil:"last_log_index" json:"last_log_index,omitempty" toml:"last_log_index" yaml:"last_log_index,omitempty"`
+	BurnedAt            null.Time   `boil:"burned_at" json:"burned_at,omitempty" toml:"burned_at" yaml:"burned_at,omitempty"`
+	BurnTransactionHash null.Bytes  `boil:"burn_transaction_hash" json:"burn_transaction_hash,omitempty" toml:"burn_transaction_hash" yaml:"burn_transaction_hash,omitempty"`
 
 	R *vehicleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L vehicleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var VehicleColumns = struct {
-	ID                 string
-	OwnerAddress       string
-	Make               string
-	Model              string
-	Year               string
-	MintedAt           string
-	ManufacturerID     string
-	ImageURI           string
-	DeviceDefinitionID string
-	StorageNodeID      string
+	ID                  string
+	OwnerAddress        string
+	Make                string
+	Model               string
+	Year                string
+	MintedAt            string
+	ManufacturerID      string
+	ImageURI            string
+	DeviceDefinitionID  string
+	StorageNodeID       string
+	LastBlockHash       string
+	LastBlockNumber     string
+	LastLogIndex        string
+	BurnedAt            string
+	BurnTransactionHash string
 }{
-	ID:                 "id",
-	OwnerAddress:       "owner_address",
-	Make:               "make",
-	Model:              "model",
-	Year:               "year",
-	MintedAt:           "minted_at",
-	ManufacturerID:     "manufacturer_id",
-	ImageURI:           "image_uri",
-	DeviceDefinitionID: "device_definition_id",
-	StorageNodeID:      "storage_node_id",
+	ID:                  "id",
+	OwnerAddress:        "owner_address",
+	Make:                "make",
+	Model:               "model",
+	Year:                "year",
+	MintedAt:            "minted_at",
+	ManufacturerID:      "manufacturer_id",
+	ImageURI:            "image_uri",
+	DeviceDefinitionID:  "device_definition_id",
+	StorageNodeID:       "storage_node_id",
+	LastBlockHash:       "last_block_hash",
+	LastBlockNumber:     "last_block_number",
+	LastLogIndex:        "last_log_index",
+	BurnedAt:            "burned_at",
+	BurnTransactionHash: "burn_transaction_hash",
 }
 
 var VehicleTableColumns = struct {
-	ID                 string
-	OwnerAddress       string
-	Make               string
-	Model              string
-	Year               string
-	MintedAt           string
-	ManufacturerID     string
-	ImageURI           string
-	DeviceDefinitionID string
-	StorageNodeID      string
+	ID                  string
+	OwnerAddress        string
+	Make                string
+	Model               string
+	Year                string
+	MintedAt            string
+	ManufacturerID      string
+	ImageURI            string
+	DeviceDefinitionID  string
+	StorageNodeID       string
+	LastBlockHash       string
+	LastBlockNumber     string
+	LastLogIndex        string
+	BurnedAt            string
+	BurnTransactionHash string
 }{
-	ID:                 "vehicles.id",
-	OwnerAddress:       "vehicles.owner_address",
-	Make:               "vehicles.make",
-	Model:              "vehicles.model",
-	Year:               "vehicles.year",
-	MintedAt:           "vehicles.minted_at",
-	ManufacturerID:     "vehicles.manufacturer_id",
-	ImageURI:           "vehicles.image_uri",
-	DeviceDefinitionID: "vehicles.device_definition_id",
-	StorageNodeID:      "vehicles.storage_node_id",
+	ID:                  "vehicles.id",
+	OwnerAddress:        "vehicles.owner_address",
+	Make:                "vehicles.make",
+	Model:               "vehicles.model",
+	Year:                "vehicles.year",
+	MintedAt:            "vehicles.minted_at",
+	ManufacturerID:      "vehicles.manufacturer_id",
+	ImageURI:            "vehicles.image_uri",
+	DeviceDefinitionID:  "vehicles.device_definition_id",
+	StorageNodeID:       "vehicles.storage_node_id",
+	LastBlockHash:       "vehicles.last_block_hash",
+	LastBlockNumber:     "vehicles.last_block_number",
+	LastLogIndex:        "vehicles.last_log_index",
+	BurnedAt:            "vehicles.burned_at",
+	BurnTransactionHash: "vehicles.burn_transaction_hash",
 }
 
 // Generated where
 
 var VehicleWhere = struct {
-	ID                 whereHelperint
-	OwnerAddress       whereHelper__byte
-	Make               whereHelpernull_String
-	Model              whereHelpernull_String
-	Year               whereHelpernull_Int
-	MintedAt           whereHelpertime_Time
-	ManufacturerID     whereHelperint
-	ImageURI           whereHelpernull_String
-	DeviceDefinitionID whereHelpernull_String
-	StorageNodeID      whereHelpernull_Bytes
+	ID                  whereHelperint
+	OwnerAddress        whereHelper__byte
+	Make                whereHelpernull_String
+	Model               whereHelpernull_String
+	Year                whereHelpernull_Int
+	MintedAt            whereHelpertime_Time
+	ManufacturerID      whereHelperint
+	ImageURI            whereHelpernull_String
+	DeviceDefinitionID  whereHelpernull_String
+	StorageNodeID       whereHelpernull_Bytes
+	LastBlockHash       whereHelpernull_Bytes
+	LastBlockNumber     whereHelpernull_Int64
+	LastLogIndex        whereHelpernull_Int
+	BurnedAt            whereHelpernull_Time
+	BurnTransactionHash whereHelpernull_Bytes
 }{
-	ID:                 whereHelperint{field: "\"identity_api\".\"vehicles\".\"id\""},
-	OwnerAddress:       whereHelper__byte{field: "\"identity_api\".\"vehicles\".\"owner_address\""},
-	Make:               whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"make\""},
-	Model:              whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"model\""},
-	Year:               whereHelpernull_Int{field: "\"identity_api\".\"vehicles\".\"year\""},
-	MintedAt:           whereHelpertime_Time{field: "\"identity_api\".\"vehicles\".\"minted_at\""},
-	ManufacturerID:     whereHelperint{field: "\"identity_api\".\"vehicles\".\"manufacturer_id\""},
-	ImageURI:           whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"image_uri\""},
-	DeviceDefinitionID: whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"device_definition_id\""},
-	StorageNodeID:      whereHelpernull_Bytes{field: "\"identity_api\".\"vehicles\".\"storage_node_id\""},
+	ID:                  whereHelperint{field: "\"identity_api\".\"vehicles\".\"id\""},
+	OwnerAddress:        whereHelper__byte{field: "\"identity_api\".\"vehicles\".\"owner_address\""},
+	Make:                whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"make\""},
+	Model:               whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"model\""},
+	Year:                whereHelpernull_Int{field: "\"identity_api\".\"vehicles\".\"year\""},
+	MintedAt:            whereHelpertime_Time{field: "\"identity_api\".\"vehicles\".\"minted_at\""},
+	ManufacturerID:      whereHelperint{field: "\"identity_api\".\"vehicles\".\"manufacturer_id\""},
+	ImageURI:            whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"image_uri\""},
+	DeviceDefinitionID:  whereHelpernull_String{field: "\"identity_api\".\"vehicles\".\"device_definition_id\""},
+	StorageNodeID:       whereHelpernull_Bytes{field: "\"identity_api\".\"vehicles\".\"storage_node_id\""},
+	LastBlockHash:       whereHelpernull_Bytes{field: "\"identity_api\".\"vehicles\".\"last_block_hash\""},
+	LastBlockNumber:     whereHelpernull_Int64{field: "\"identity_api\".\"vehicles\".\"last_block_number\""},
+	LastLogIndex:        whereHelpernull_Int{field: "\"identity_api\".\"vehicles\".\"last_log_index\""},
+	BurnedAt:            whereHelpernull_Time{field: "\"identity_api\".\"vehicles\".\"burned_at\""},
+	BurnTransactionHash: whereHelpernull_Bytes{field: "\"identity_api\".\"vehicles\".\"burn_transaction_hash\""},
 }
 
 // VehicleRels is where relationship names are stored.
@@ -302,9 +337,9 @@ func (r *vehicleR) GetVehicleSacds() VehicleSacdSlice {
 type vehicleL struct{}
 
 var (
-	vehicleAllColumns            = []string{"id", "owner_address", "make", "model", "year", "minted_at", "manufacturer_id", "image_uri", "device_definition_id", "storage_node_id"}
+	vehicleAllColumns            = []string{"id", "owner_address", "make", "model", "year", "minted_at", "manufacturer_id", "image_uri", "device_definition_id", "storage_node_id", "last_block_hash", "last_block_number", "last_log_index", "burned_at", "burn_transaction_hash"}
 	vehicleColumnsWithoutDefault = []string{"id", "owner_address", "minted_at", "manufacturer_id"}
-	vehicleColumnsWithDefault    = []string{"make", "model", "year", "image_uri", "device_definition_id", "storage_node_id"}
+	vehicleColumnsWithDefault    = []string{"make", "model", "year", "image_uri", "device_definition_id", "storage_node_id", "last_block_hash", "last_block_number", "last_log_index", "burned_at", "burn_transaction_hash"}
 	vehiclePrimaryKeyColumns     = []string{"id"}
 	vehicleGeneratedColumns      = []string{}
 )